@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/annotate"
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	annotateRemove      bool
+	annotateMinSeverity string
+)
+
+// newAnnotateCmd 이슈를 소스 주석으로 삽입/제거하는 명령
+func newAnnotateCmd() *cobra.Command {
+	annotateCmd := &cobra.Command{
+		Use:   "annotate [path]",
+		Short: "이슈를 소스 코드에 CQC[규칙 ID] 주석으로 삽입 (--remove로 제거)",
+		Long: `분석한 이슈마다 해당 라인 위에 "// CQC[java-system-out]: Logger를 사용하세요" 형태의 주석을 삽입합니다.
+오프라인으로 리팩터링할 때 편집기 안에서 이슈를 보며 작업하는 용도이며, 작업 사본을 직접 수정하므로
+git 저장소의 기본 브랜치(main/master)에서는 실행하지 않습니다. 작업 브랜치를 만든 뒤 사용하세요.
+--remove는 삽입한 주석 라인만 찾아 모두 제거합니다.
+
+사용 예시:
+  git switch -c refactor/cqc
+  cqc annotate ./src --min-severity high
+  cqc annotate ./src --remove`,
+		Args: cobra.ExactArgs(1),
+		Run:  runAnnotate,
+	}
+	annotateCmd.Flags().BoolVar(&annotateRemove, "remove", false, "삽입한 CQC 주석 제거")
+	annotateCmd.Flags().StringVarP(&annotateMinSeverity, "min-severity", "s", "low", "주석을 달 최소 심각도 (low/medium/high/critical)")
+
+	return annotateCmd
+}
+
+func runAnnotate(cmd *cobra.Command, args []string) {
+	targetPath := args[0]
+
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	if annotateRemove {
+		stats := removeAnnotations(cfg, targetPath)
+		fmt.Println(i18n.T("annotate.removed", stats.Files, stats.Comments))
+		return
+	}
+
+	if err := annotate.CheckBranch(targetPath); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+
+	// 이전에 삽입한 주석이 분석 결과에 섞이지 않도록 (CSS 셀렉터 등) 먼저 제거한 뒤 다시 삽입
+	removeAnnotations(cfg, targetPath)
+	cfg.Analysis.Cache = false
+	cfg.FilterBySeverity(config.ParseSeverity(annotateMinSeverity))
+	result, err := analyzer.New(cfg).Analyze(targetPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
+		os.Exit(1)
+	}
+
+	stats, err := annotate.Insert(result.Issues)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(i18n.T("annotate.inserted", stats.Files, stats.Comments))
+	if stats.Skipped > 0 {
+		fmt.Println(i18n.T("annotate.skipped", stats.Skipped))
+	}
+	fmt.Println(i18n.T("annotate.undo", targetPath))
+}
+
+// removeAnnotations 분석 대상 파일에서 삽입한 주석 제거
+func removeAnnotations(cfg *config.Config, targetPath string) annotate.Stats {
+	files, _, err := analyzer.New(cfg).ListFiles(targetPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.collect-failed", err))
+		os.Exit(1)
+	}
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path)
+	}
+	stats, err := annotate.Remove(paths)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	return stats
+}
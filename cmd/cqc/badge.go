@@ -0,0 +1,103 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/badge"
+	"code-quality-checker/internal/history"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	badgeOut    string
+	badgeInput  string
+	badgeDB     string
+	badgeMetric string
+	badgeLabel  string
+)
+
+// newBadgeCmd 품질 배지 생성 명령
+func newBadgeCmd() *cobra.Command {
+	badgeCmd := &cobra.Command{
+		Use:   "badge [path]",
+		Short: "README에 삽입할 품질 배지(SVG) 생성",
+		Long: `분석 결과로 shields 스타일의 SVG 배지를 생성합니다.
+--input으로 JSON 결과 파일(cqc -o json)을 지정하면 그 결과를, --history로 실행 기록(SQLite)을 지정하면 마지막 실행의 요약을 사용하고, 경로를 지정하면 직접 분석합니다.
+
+사용 예시:
+  cqc ./src -o json --output-file result.json
+  cqc badge --input result.json --out badge.svg
+  cqc badge ./src --metric issues --out badge.svg
+  cqc badge --history quality.sqlite --out badge.svg
+  cqc badge --input result.json --metric critical --label "shop-api" --out critical.svg`,
+		Args: cobra.MaximumNArgs(1),
+		Run:  runBadge,
+	}
+	badgeCmd.Flags().StringVar(&badgeOut, "out", "badge.svg", "배지 파일 경로")
+	badgeCmd.Flags().StringVar(&badgeInput, "input", "", "JSON 분석 결과 파일")
+	badgeCmd.Flags().StringVar(&badgeDB, "history", "", "마지막 실행을 배지로 만들 실행 기록 SQLite 파일 (cqc --history로 기록)")
+	badgeCmd.Flags().StringVar(&badgeMetric, "metric", badge.MetricGrade, "배지 종류 (grade/issues/critical)")
+	badgeCmd.Flags().StringVar(&badgeLabel, "label", "", "배지 왼쪽 라벨 (기본값: 배지 종류별 라벨)")
+
+	return badgeCmd
+}
+
+func runBadge(cmd *cobra.Command, args []string) {
+	var result *types.AnalysisResult
+	var err error
+
+	switch {
+	case badgeInput != "":
+		result, err = types.LoadResult(badgeInput)
+	case badgeDB != "":
+		result, err = latestRun(badgeDB)
+	case len(args) == 1:
+		result, err = analyzePath(args[0])
+	default:
+		err = errors.New(i18n.T("badge.no-input"))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.result-load-failed", err))
+		os.Exit(1)
+	}
+
+	svg, err := badge.Generate(result, badgeMetric, badgeLabel)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("badge.failed", err))
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(badgeOut, []byte(svg), 0644); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("badge.save-failed", err))
+		os.Exit(1)
+	}
+
+	fmt.Println(i18n.T("badge.created", badgeOut))
+}
+
+// latestRun 실행 기록의 마지막 실행 요약
+func latestRun(path string) (*types.AnalysisResult, error) {
+	store, err := history.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+	return store.Latest()
+}
+
+// analyzePath 설정 파일을 적용하여 경로 분석
+func analyzePath(path string) (*types.AnalysisResult, error) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		return nil, err
+	}
+	a := analyzer.New(cfg)
+	a.SetVersion(version)
+	return a.Analyze(path)
+}
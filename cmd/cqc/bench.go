@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/bench"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/reporter"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	benchRuns      int
+	benchWarmup    int
+	benchFormat    string
+	benchBaseline  string
+	benchSave      bool
+	benchThreshold float64
+)
+
+// benchMinDuration 이보다 짧은 단계는 측정 오차가 커서 회귀 판정에서 제외
+const benchMinDuration = time.Millisecond
+
+// newBenchCmd 분석 성능 벤치마크 명령
+func newBenchCmd() *cobra.Command {
+	benchCmd := &cobra.Command{
+		Use:   "bench [path]",
+		Short: "분석을 여러 번 실행해 단계별 소요 시간(p50/p95) 측정",
+		Long: `규칙 엔진을 한 번 만든 뒤(워밍업 실행 포함) 같은 경로를 여러 번 분석해
+단계별(collect, parse, rules, report) p50/p95 소요 시간을 출력합니다.
+기준값 파일이 있으면 p95를 비교해 threshold(%)를 넘게 느려진 단계가 있을 때 종료 코드 1을 반환합니다.
+결과 캐시는 측정을 왜곡하므로 사용하지 않습니다.
+
+사용 예시:
+  cqc bench ./src --save                 # 기준값 저장 (.cqc/bench.json)
+  cqc bench ./src --runs 10              # 기준값과 비교
+  cqc bench ./src --threshold 10 -o html # HTML 리포트 생성까지 측정`,
+		Args: cobra.ExactArgs(1),
+		Run:  runBench,
+	}
+	benchCmd.Flags().IntVar(&benchRuns, "runs", 5, "측정할 실행 횟수")
+	benchCmd.Flags().IntVar(&benchWarmup, "warmup", 1, "측정 전 워밍업 실행 횟수")
+	benchCmd.Flags().StringVarP(&benchFormat, "output", "o", "json", "report 단계에서 생성할 리포트 형식")
+	benchCmd.Flags().StringVar(&benchBaseline, "baseline", bench.DefaultBaselinePath, "벤치마크 기준값 파일")
+	benchCmd.Flags().BoolVar(&benchSave, "save", false, "측정 결과를 기준값 파일로 저장")
+	benchCmd.Flags().Float64Var(&benchThreshold, "threshold", 20, "회귀로 판정할 p95 증가율 (%)")
+
+	return benchCmd
+}
+
+func runBench(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+	cfg.Analysis.Cache = false
+
+	rep, err := reporter.New(benchFormat)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.reporter-failed", err))
+		os.Exit(1)
+	}
+	if benchRuns < 1 {
+		benchRuns = 1
+	}
+
+	a := analyzer.New(cfg)
+	a.SetVersion(version)
+	recorder := bench.NewRecorder()
+	files := 0
+	for i := 0; i < benchWarmup+benchRuns; i++ {
+		stages, result, err := benchRun(a, rep, args[0])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
+			os.Exit(1)
+		}
+		files = result.Summary.TotalFiles
+		if i >= benchWarmup {
+			recorder.Add(stages)
+		}
+	}
+
+	current := recorder.Report(version, benchFormat, files)
+	baseline, err := bench.Load(benchBaseline)
+	if err != nil && !os.IsNotExist(err) {
+		fmt.Fprintln(os.Stderr, i18n.T("bench.baseline-load-failed", err))
+	}
+	printBench(current, baseline)
+
+	if benchSave {
+		if err := bench.Save(benchBaseline, current); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("bench.baseline-save-failed", err))
+			os.Exit(1)
+		}
+		fmt.Println("\n" + i18n.T("bench.baseline-saved", benchBaseline))
+		return
+	}
+
+	if baseline == nil {
+		return
+	}
+	regressions := bench.Compare(baseline, current, benchThreshold, benchMinDuration)
+	for _, regression := range regressions {
+		fmt.Fprintln(os.Stderr, i18n.T("bench.regression",
+			regression.Stage, formatDuration(regression.Baseline), formatDuration(regression.Current), regression.Percent, benchThreshold))
+	}
+	if len(regressions) > 0 {
+		os.Exit(1)
+	}
+}
+
+// benchRun 분석과 리포트 생성을 한 번 실행하고 단계별 소요 시간 반환 (리포트는 버림)
+func benchRun(a *analyzer.Analyzer, rep reporter.Reporter, path string) (map[string]time.Duration, *types.AnalysisResult, error) {
+	start := time.Now()
+	result, err := a.Analyze(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	reportStart := time.Now()
+	if err := rep.Generate(result, os.DevNull); err != nil {
+		return nil, nil, err
+	}
+
+	stages := make(map[string]time.Duration)
+	for stage, elapsed := range result.Summary.Performance.StageTime {
+		stages[stage] = elapsed
+	}
+	stages[types.StageReport] = time.Since(reportStart)
+	stages[bench.StageTotal] = time.Since(start)
+	return stages, result, nil
+}
+
+// printBench 단계별 p50/p95 표 출력 (기준값이 있으면 p95 변화율 포함)
+func printBench(current, baseline *bench.Report) {
+	fmt.Println(i18n.T("bench.header", current.Files, current.Runs) + "\n")
+	if baseline != nil {
+		fmt.Println(i18n.T("bench.columns-baseline"))
+	} else {
+		fmt.Println(i18n.T("bench.columns"))
+	}
+	for _, stage := range bench.Stages {
+		stats := current.Stages[stage]
+		fmt.Printf("%-10s %10s %10s", stage, formatDuration(stats.P50), formatDuration(stats.P95))
+		if baseline != nil {
+			if before, ok := baseline.Stages[stage]; ok {
+				fmt.Printf(" %12s %+8.1f%%", formatDuration(before.P95), bench.Change(before.P95, stats.P95))
+			}
+		}
+		fmt.Println()
+	}
+	if baseline != nil {
+		fmt.Println("\n" + i18n.T("bench.baseline", benchBaseline, baseline.ToolVersion, baseline.CreatedAt.Format("2006-01-02 15:04")))
+		if baseline.Format != current.Format {
+			fmt.Println(i18n.T("bench.format-mismatch", baseline.Format))
+		}
+	}
+}
+
+// formatDuration 밀리초 단위로 표시
+func formatDuration(d time.Duration) string {
+	return fmt.Sprintf("%.2fms", float64(d)/float64(time.Millisecond))
+}
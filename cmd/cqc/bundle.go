@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"code-quality-checker/internal/bundle"
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+// newBundleCmd 규칙 번들 관리 명령
+func newBundleCmd() *cobra.Command {
+	bundleCmd := &cobra.Command{
+		Use:   "bundle",
+		Short: "주제별 규칙 번들 관리",
+	}
+
+	addCmd := &cobra.Command{
+		Use:   "add <name|url>",
+		Short: "규칙 번들을 설치하고 설정 파일에 버전 기록",
+		Long: `주제별 규칙 번들(보안, 성능, 접근성 등)을 설치합니다.
+기본 제공 번들 이름, http(s) URL, 로컬 YAML 파일 경로를 지정할 수 있으며
+설치한 번들과 버전은 설정 파일의 bundles에 기록되어 분석 시 자동으로 병합됩니다.
+
+사용 예시:
+  cqc bundle add security
+  cqc bundle add https://rules.mycorp.com/bundles/payment-pack.yaml
+  cqc bundle add ./team-pack.yaml`,
+		Args: cobra.ExactArgs(1),
+		Run:  runBundleAdd,
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "기본 제공 번들과 설치된 번들 목록",
+		Args:  cobra.NoArgs,
+		Run:   runBundleList,
+	}
+
+	bundleCmd.AddCommand(addCmd, listCmd)
+	return bundleCmd
+}
+
+func runBundleAdd(cmd *cobra.Command, args []string) {
+	pack, previous, err := bundle.Install(configFile, args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("bundle.install-failed", err))
+		os.Exit(1)
+	}
+
+	ruleCount := 0
+	for _, langRules := range pack.Languages {
+		ruleCount += len(langRules.Rules)
+	}
+
+	switch previous {
+	case "":
+		fmt.Println(i18n.T("bundle.installed", pack.Name, pack.Version, ruleCount))
+	case pack.Version:
+		fmt.Println(i18n.T("bundle.reinstalled", pack.Name, pack.Version, ruleCount))
+	default:
+		fmt.Println(i18n.T("bundle.updated", pack.Name, previous, pack.Version, ruleCount))
+	}
+	fmt.Println(i18n.T("bundle.recorded", configFile))
+}
+
+func runBundleList(cmd *cobra.Command, args []string) {
+	packs, err := bundle.Builtin()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("bundle.list-failed", err))
+		os.Exit(1)
+	}
+
+	fmt.Println(i18n.T("bundle.builtin"))
+	for _, pack := range packs {
+		fmt.Printf("  %-20s %-8s %s\n", pack.Name, pack.Version, pack.Description)
+	}
+
+	cfg, err := config.LoadRawConfig(configFile)
+	if err != nil || len(cfg.Bundles) == 0 {
+		return
+	}
+
+	fmt.Println("\n" + i18n.T("bundle.installed-list"))
+	for _, pin := range cfg.Bundles {
+		fmt.Printf("  %-20s %-8s %s\n", pin.Name, pin.Version, pin.Source)
+	}
+}
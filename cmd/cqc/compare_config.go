@@ -0,0 +1,149 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	compareConfigOutput     string
+	compareConfigPersisting bool
+)
+
+// newCompareConfigCmd 두 설정으로 같은 소스를 분석해 이슈 변화를 비교하는 명령
+func newCompareConfigCmd() *cobra.Command {
+	compareCmd := &cobra.Command{
+		Use:   "compare-config <old.yaml> <new.yaml> [path]",
+		Short: "두 설정 파일로 같은 소스를 분석해 설정 변경이 만드는 이슈 변화 비교",
+		Long: `같은 소스를 이전 설정과 새 설정으로 각각 분석한 뒤 cqc diff와 같은 방식으로 신규/해결/유지 이슈를 비교합니다.
+임계값을 낮추거나 규칙을 켜는 설정 변경을 병합하기 전에 이슈가 얼마나 늘어나는지(영향 범위)를 확인하는 용도입니다.
+두 분석 모두 결과 캐시와 심볼 색인을 쓰지 않으므로 설정 차이만 결과에 반영됩니다.
+
+사용 예시:
+  cqc compare-config configs/rules.yaml rules-new.yaml ./src
+  cqc compare-config main-rules.yaml rules.yaml ./src -o json > blast-radius.json`,
+		Args: cobra.ExactArgs(3),
+		Run:  runCompareConfig,
+	}
+	compareCmd.Flags().StringVarP(&compareConfigOutput, "output", "o", "console", "출력 형식 (console/json)")
+	compareCmd.Flags().BoolVar(&compareConfigPersisting, "show-persisting", false, "유지 이슈 목록도 출력")
+
+	return compareCmd
+}
+
+// configComparison compare-config JSON 출력
+type configComparison struct {
+	OldConfig     string `json:"old_config"`
+	NewConfig     string `json:"new_config"`
+	OldIssues     int    `json:"old_issues"`
+	NewIssues     int    `json:"new_issues"`
+	AffectedFiles int    `json:"affected_files"` // 신규 또는 해결 이슈가 있는 파일 수
+	*types.ResultDiff
+	SeverityChanges []severityChange `json:"severity_changes,omitempty"`
+}
+
+// severityChange 두 설정 모두에서 보고되지만 심각도가 바뀐 이슈
+type severityChange struct {
+	From  string      `json:"from"`
+	To    string      `json:"to"`
+	Issue types.Issue `json:"issue"`
+}
+
+func runCompareConfig(cmd *cobra.Command, args []string) {
+	format := strings.ToLower(compareConfigOutput)
+	if format != "console" && format != "json" {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.unsupported-format-choice", compareConfigOutput, "console/json"))
+		os.Exit(1)
+	}
+	oldConfig, newConfig, targetPath := args[0], args[1], args[2]
+
+	previous := analyzeWithConfig(oldConfig, targetPath)
+	current := analyzeWithConfig(newConfig, targetPath)
+	diff := types.DiffResults(previous, current)
+
+	comparison := configComparison{
+		OldConfig:     oldConfig,
+		NewConfig:     newConfig,
+		OldIssues:     len(previous.Issues),
+		NewIssues:     len(current.Issues),
+		AffectedFiles: affectedFiles(diff),
+		ResultDiff:    diff,
+	}
+	comparison.SeverityChanges = severityChanges(previous, diff.Persisting)
+
+	if format == "json" {
+		data, err := json.MarshalIndent(comparison, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.json-failed", err))
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Println(i18n.T("compare-config.header", oldConfig, newConfig, targetPath))
+	fmt.Println(i18n.T("compare-config.summary",
+		comparison.OldIssues, comparison.NewIssues, comparison.NewIssues-comparison.OldIssues, comparison.AffectedFiles))
+	printDiff(diff, compareConfigPersisting)
+
+	if len(comparison.SeverityChanges) > 0 {
+		fmt.Println("\n" + i18n.T("compare-config.severity-changes", len(comparison.SeverityChanges)))
+		for _, change := range comparison.SeverityChanges {
+			fmt.Printf("  [%s → %s] %s:%d %s (%s)\n", strings.ToUpper(change.From), strings.ToUpper(change.To),
+				change.Issue.File, change.Issue.Line, change.Issue.Message, change.Issue.RuleID)
+		}
+	}
+}
+
+// analyzeWithConfig 설정 파일 하나로 분석 (실패하면 종료)
+func analyzeWithConfig(configPath, targetPath string) *types.AnalysisResult {
+	cfg, err := loadConfig(configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("compare-config.load-failed", configPath, err))
+		os.Exit(1)
+	}
+	cfg.Analysis.Cache = false
+	cfg.Analysis.Index = false
+	cfg.Analysis.Preview = 0
+
+	result, err := analyzer.New(cfg).Analyze(targetPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("compare-config.analysis-failed", configPath, err))
+		os.Exit(1)
+	}
+	return result
+}
+
+// severityChanges 유지 이슈 중 이전 설정과 심각도가 다른 이슈
+func severityChanges(previous *types.AnalysisResult, persisting []types.Issue) []severityChange {
+	severities := make(map[string]config.Severity)
+	for _, issue := range previous.Issues {
+		severities[issue.Fingerprint] = issue.Severity
+	}
+
+	var changes []severityChange
+	for _, issue := range persisting {
+		if before, ok := severities[issue.Fingerprint]; ok && before != issue.Severity {
+			changes = append(changes, severityChange{From: before.String(), To: issue.Severity.String(), Issue: issue})
+		}
+	}
+	return changes
+}
+
+// affectedFiles 신규 또는 해결 이슈가 있는 파일 수
+func affectedFiles(diff *types.ResultDiff) int {
+	files := make(map[string]bool)
+	for _, issue := range append(append([]types.Issue{}, diff.New...), diff.Fixed...) {
+		files[issue.File] = true
+	}
+	return len(files)
+}
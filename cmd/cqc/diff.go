@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	diffOutput      string
+	diffMinSeverity string
+	diffPersisting  bool
+)
+
+// newDiffCmd 두 JSON 분석 결과를 비교하는 명령
+func newDiffCmd() *cobra.Command {
+	diffCmd := &cobra.Command{
+		Use:   "diff <old.json> <new.json>",
+		Short: "두 JSON 분석 결과의 신규/해결/유지 이슈 비교",
+		Long: `두 JSON 결과 파일(cqc -o json)의 이슈를 핑거프린트로 짝지어 신규/해결/유지 이슈를 출력합니다.
+핑거프린트는 규칙, 정규화한 파일 경로, 코드의 해시로 계산하므로(코드 스니펫이 없는 파일 단위 이슈만 메시지 사용) 라인 번호나 메시지 문구가 바뀌어도 같은 이슈로 취급합니다.
+--min-severity 이상인 신규 이슈가 있으면 종료 코드 1을 반환합니다.
+
+사용 예시:
+  cqc diff main.json feature.json
+  cqc diff main.json feature.json --min-severity high
+  cqc diff main.json feature.json -o json > diff.json`,
+		Args: cobra.ExactArgs(2),
+		Run:  runDiff,
+	}
+	diffCmd.Flags().StringVarP(&diffOutput, "output", "o", "console", "출력 형식 (console/json)")
+	diffCmd.Flags().StringVarP(&diffMinSeverity, "min-severity", "s", "low", "종료 코드에 반영할 신규 이슈의 최소 심각도 (low/medium/high/critical)")
+	diffCmd.Flags().BoolVar(&diffPersisting, "show-persisting", false, "유지 이슈 목록도 출력")
+
+	return diffCmd
+}
+
+func runDiff(cmd *cobra.Command, args []string) {
+	format := strings.ToLower(diffOutput)
+	if format != "console" && format != "json" {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.unsupported-format-choice", diffOutput, "console/json"))
+		os.Exit(1)
+	}
+
+	previous, err := types.LoadResult(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.result-load-failed-path", args[0], err))
+		os.Exit(1)
+	}
+	current, err := types.LoadResult(args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.result-load-failed-path", args[1], err))
+		os.Exit(1)
+	}
+
+	diff := types.DiffResults(previous, current)
+	if format == "json" {
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.json-failed", err))
+			os.Exit(1)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Println(i18n.T("diff.header", args[0], args[1]))
+		printDiff(diff, diffPersisting)
+	}
+
+	threshold := config.ParseSeverity(diffMinSeverity)
+	for _, issue := range diff.New {
+		if issue.Severity >= threshold {
+			os.Exit(1)
+		}
+	}
+}
+
+// printDiff 비교 결과를 콘솔에 출력 (cqc compare-config도 사용)
+func printDiff(diff *types.ResultDiff, showPersisting bool) {
+	fmt.Println(i18n.T("diff.summary", len(diff.New), len(diff.Fixed), len(diff.Persisting)))
+
+	if len(diff.Rules) > 0 {
+		fmt.Println("\n" + i18n.T("diff.by-rule"))
+		for _, rule := range diff.Rules {
+			fmt.Printf("  %-40s +%d -%d =%d\n", rule.RuleID, rule.New, rule.Fixed, rule.Unchanged)
+		}
+	}
+
+	printDiffIssues(i18n.T("diff.new"), diff.New)
+	printDiffIssues(i18n.T("diff.fixed"), diff.Fixed)
+	if showPersisting {
+		printDiffIssues(i18n.T("diff.persisting"), diff.Persisting)
+	}
+}
+
+func printDiffIssues(title string, issues []types.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+	fmt.Println("\n" + i18n.T("diff.section", title, len(issues)))
+	for _, issue := range issues {
+		fmt.Printf("  [%s] %s:%d %s (%s)\n", strings.ToUpper(issue.Severity.String()), issue.File, issue.Line, issue.Message, issue.RuleID)
+	}
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/endpoints"
+	"code-quality-checker/internal/i18n"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	endpointsFormat string
+	endpointsOut    string
+)
+
+// newEndpointsCmd Spring 엔드포인트 목록 명령
+func newEndpointsCmd() *cobra.Command {
+	endpointsCmd := &cobra.Command{
+		Use:   "endpoints [path]",
+		Short: "Spring 컨트롤러 엔드포인트 목록 생성",
+		Long: `@RequestMapping/@GetMapping 등으로 매핑된 엔드포인트의 경로, HTTP 메소드, 핸들러, 보안 어노테이션을 수집합니다.
+보안 어노테이션 없이 POST/PUT/PATCH/DELETE를 받는 엔드포인트는 인증 없는 변경 엔드포인트로 표시됩니다.
+
+사용 예시:
+  cqc endpoints ./src
+  cqc endpoints ./src -o json --output-file endpoints.json`,
+		Args: cobra.ExactArgs(1),
+		Run:  runEndpoints,
+	}
+	endpointsCmd.Flags().StringVarP(&endpointsFormat, "output", "o", "console", "출력 형식 (console/json)")
+	endpointsCmd.Flags().StringVar(&endpointsOut, "output-file", "", "출력 파일 경로 (기본값: stdout)")
+
+	return endpointsCmd
+}
+
+func runEndpoints(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	// 파싱 결과만 필요하므로 규칙 검사와 캐시는 생략
+	cfg.Languages = nil
+	cfg.Analysis.Cache = false
+
+	collector := &endpoints.Collector{}
+	a := analyzer.New(cfg)
+	a.AddHook(analyzer.HookFuncs{FileParsed: collector.Add})
+	if _, err := a.Analyze(args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
+		os.Exit(1)
+	}
+	inventory := collector.Inventory()
+
+	var out io.Writer = os.Stdout
+	if endpointsOut != "" {
+		file, err := os.Create(endpointsOut)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.output-create-failed", err))
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch endpointsFormat {
+	case "json":
+		err = endpoints.WriteJSON(out, inventory)
+	case "console", "text":
+		err = endpoints.WriteConsole(out, inventory)
+	default:
+		err = errors.New(i18n.T("cli.unsupported-format", endpointsFormat))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("endpoints.failed", err))
+		os.Exit(1)
+	}
+}
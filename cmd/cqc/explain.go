@@ -0,0 +1,81 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/rules"
+
+	"github.com/spf13/cobra"
+)
+
+// newExplainCmd 규칙 설명 명령
+func newExplainCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "explain <rule-id>",
+		Short: "규칙이 왜 중요한지와 위반/수정 예시, 참고 문서 출력",
+		Long: `규칙의 확장 문서를 출력합니다: 규칙이 왜 중요한지, 위반 코드와 수정 코드, 조정 가능한 옵션, 참고 문서.
+설정 파일의 examples와 links(팀 위키 등), cwe/owasp가 있으면 함께 표시합니다.
+리포트에서 처음 보는 규칙 ID를 만났을 때 어떻게 고쳐야 하는지 확인하는 용도입니다.
+
+사용 예시:
+  cqc explain spring-transactional-private
+  cqc explain js-innerHTML-xss`,
+		Args: cobra.ExactArgs(1),
+		Run:  runExplain,
+	}
+}
+
+func runExplain(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	language, ruleConfig, configured := findRuleConfig(cfg, args[0])
+	rule, ruleLanguage, registered := rules.NewEngine(cfg).Rule(args[0])
+	var doc rules.RuleDoc
+	switch {
+	case registered:
+		doc = rule.Doc()
+		language = ruleLanguage
+		fmt.Printf("%s: %s\n", rule.ID(), rule.Name())
+		fmt.Println(i18n.T("explain.meta", language, rule.Severity(), rule.Category()))
+	case configured:
+		// 설정에는 있지만 검사 구현이 없는 규칙도 문서는 보여줌
+		doc = rules.DocFor(ruleConfig)
+		fmt.Printf("%s: %s\n", ruleConfig.ID, ruleConfig.Name)
+		fmt.Println(i18n.T("explain.meta", language, ruleConfig.Severity, ruleConfig.Category))
+		fmt.Println(i18n.T("explain.unimplemented"))
+	default:
+		fmt.Fprintln(os.Stderr, i18n.T("explain.not-found", args[0]))
+		os.Exit(1)
+	}
+
+	if doc.Rationale != "" {
+		fmt.Printf("\n%s\n  %s\n", i18n.T("explain.rationale"), doc.Rationale)
+	}
+	for i, example := range doc.Examples {
+		title := i18n.T("explain.example")
+		if len(doc.Examples) > 1 {
+			title = i18n.T("explain.example-n", i+1)
+		}
+		if example.Bad != "" {
+			fmt.Printf("\n%s\n%s", i18n.T("explain.bad", title), indentBlock(example.Bad))
+		}
+		if example.Good != "" {
+			fmt.Printf("\n%s\n%s", i18n.T("explain.good", title), indentBlock(example.Good))
+		}
+	}
+	if configured {
+		printRuleOptions(ruleConfig, doc)
+	}
+	if len(doc.Links) > 0 {
+		fmt.Println("\n" + i18n.T("explain.links"))
+		for _, link := range doc.Links {
+			fmt.Printf("  %s\n", link)
+		}
+	}
+}
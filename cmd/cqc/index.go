@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/symbols"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	indexFind   string
+	indexKind   string
+	indexFormat string
+)
+
+// newIndexCmd 프로젝트 심볼 색인 갱신 및 조회 명령
+func newIndexCmd() *cobra.Command {
+	indexCmd := &cobra.Command{
+		Use:   "index [path]",
+		Short: "프로젝트 심볼 색인(.cqc/index) 갱신 및 조회",
+		Long: `클래스, 메소드, JS 함수, CSS 셀렉터, HTML id, Spring 엔드포인트를 색인하여 .cqc/index에 저장합니다.
+이전 색인과 내용 해시가 같은 파일은 다시 파싱하지 않고, 삭제된 파일의 심볼은 제거합니다.
+분석할 때 --index(또는 analysis.index: true)를 주면 같은 색인을 갱신하고 파일 간 규칙이 색인을 조회합니다.
+
+사용 예시:
+  cqc index ./src                              # 색인 갱신 후 종류별 심볼 수 출력
+  cqc index ./src --find UserController        # 이름으로 심볼 조회
+  cqc index ./src --kind endpoint -o json      # 종류별 심볼 목록을 JSON으로 출력`,
+		Args: cobra.ExactArgs(1),
+		Run:  runIndex,
+	}
+	indexCmd.Flags().StringVar(&indexFind, "find", "", "이 이름의 심볼만 출력")
+	indexCmd.Flags().StringVar(&indexKind, "kind", "", "심볼 종류 (class/method/function/selector/id/endpoint)")
+	indexCmd.Flags().StringVarP(&indexFormat, "output", "o", "console", "출력 형식 (console/json)")
+
+	return indexCmd
+}
+
+func runIndex(cmd *cobra.Command, args []string) {
+	if indexFormat != "console" && indexFormat != "json" {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.unsupported-format-choice", indexFormat, "console/json"))
+		os.Exit(1)
+	}
+
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	// 색인만 필요하므로 규칙 검사와 캐시는 생략
+	cfg.Languages = nil
+	cfg.Analysis.Cache = false
+	cfg.Analysis.Index = true
+
+	a := analyzer.New(cfg)
+	result, err := a.Analyze(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
+		os.Exit(1)
+	}
+	for _, warning := range result.Warnings {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.warning", warning))
+	}
+	index := a.SymbolIndex()
+
+	// 조회 조건이 없으면 요약만 출력
+	if indexFind == "" && indexKind == "" {
+		counts := index.Counts()
+		if indexFormat == "json" {
+			printIndexJSON(map[string]interface{}{"files": index.Files(), "symbols": counts})
+			return
+		}
+		fmt.Println(i18n.T("index.header", cfg.IndexDir()))
+		fmt.Println(i18n.T("index.files", index.Files(), index.Stats.Indexed, index.Stats.Reused, index.Stats.Removed))
+		kinds := make([]string, 0, len(counts))
+		for kind := range counts {
+			kinds = append(kinds, kind)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			fmt.Printf("  %-10s %s\n", kind, i18n.T("cli.count", counts[kind]))
+		}
+		return
+	}
+
+	var found []symbols.Symbol
+	if indexFind != "" {
+		found = index.Find(indexKind, indexFind)
+	} else {
+		found = index.Symbols(indexKind)
+	}
+	if indexFormat == "json" {
+		if found == nil {
+			found = []symbols.Symbol{}
+		}
+		printIndexJSON(found)
+		return
+	}
+	for _, symbol := range found {
+		line := fmt.Sprintf("%-10s %s  %s:%d", symbol.Kind, symbol.Name, symbol.File, symbol.Line)
+		if symbol.Detail != "" {
+			line += fmt.Sprintf("  (%s)", symbol.Detail)
+		}
+		fmt.Println(line)
+	}
+	fmt.Println("\n" + i18n.T("index.symbols", len(found)))
+}
+
+func printIndexJSON(value interface{}) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.json-failed", err))
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
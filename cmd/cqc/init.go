@@ -0,0 +1,206 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code-quality-checker/configs"
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// initConfigName cqc init이 만드는 설정 파일 (--config를 지정하지 않으면 현재 디렉터리의 이 파일을 먼저 사용)
+const initConfigName = ".cqc.yaml"
+
+var (
+	initForce        bool
+	initAllLanguages bool
+)
+
+// discoverConfig --config를 지정하지 않았고 현재 디렉터리에 .cqc.yaml이 있으면 그 파일을 설정으로 사용
+func discoverConfig(cmd *cobra.Command, args []string) {
+	if cmd.Flags().Changed("config") {
+		return
+	}
+	if _, err := os.Stat(initConfigName); err == nil {
+		configFile = initConfigName
+	}
+}
+
+// newInitCmd 시작 설정 파일 생성 명령
+func newInitCmd() *cobra.Command {
+	initCmd := &cobra.Command{
+		Use:   "init [path]",
+		Short: "기본 규칙을 담은 시작 설정 파일(.cqc.yaml) 생성",
+		Long: `기본 제공 규칙과 기본값을 모두 담은 시작 설정 파일 .cqc.yaml을 경로(기본값: 현재 디렉터리)에 만듭니다.
+경로의 소스 파일을 훑어 감지한 언어의 규칙만 남기며, --all-languages면 모든 언어의 규칙을 남깁니다.
+--config를 지정하지 않으면 현재 디렉터리의 .cqc.yaml을 configs/rules.yaml보다 먼저 사용하므로 만든 뒤 바로 분석할 수 있습니다.
+
+사용 예시:
+  cqc init
+  cqc init ./services/order --all-languages
+  cqc init --force`,
+		Args: cobra.MaximumNArgs(1),
+		Run:  runInit,
+	}
+	initCmd.Flags().BoolVar(&initForce, "force", false, "이미 있는 설정 파일 덮어쓰기")
+	initCmd.Flags().BoolVar(&initAllLanguages, "all-languages", false, "감지 여부와 관계없이 모든 언어의 규칙 포함")
+
+	return initCmd
+}
+
+func runInit(cmd *cobra.Command, args []string) {
+	root := "."
+	if len(args) == 1 {
+		root = args[0]
+	}
+	target := filepath.Join(root, initConfigName)
+	if _, err := os.Stat(target); err == nil && !initForce {
+		fmt.Fprintln(os.Stderr, i18n.T("init.exists", target))
+		os.Exit(1)
+	}
+
+	defaults, err := config.ParseRawConfig(configs.DefaultRules)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("init.defaults-failed", err))
+		os.Exit(1)
+	}
+
+	var languages []string
+	if !initAllLanguages {
+		languages, err = detectConfigLanguages(defaults, root)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("init.detect-failed", err))
+			os.Exit(1)
+		}
+	}
+
+	data, err := starterConfig(configs.DefaultRules, languages)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("init.create-failed", err))
+		os.Exit(1)
+	}
+	if err := os.WriteFile(target, data, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("init.save-failed", err))
+		os.Exit(1)
+	}
+
+	ruleCount := 0
+	var included []string
+	for _, langRules := range defaults.Languages {
+		if len(languages) == 0 || containsString(languages, langRules.Language) {
+			ruleCount += len(langRules.Rules)
+			included = append(included, langRules.Language)
+		}
+	}
+	fmt.Println(i18n.T("init.created", target, strings.Join(included, ", "), ruleCount))
+	if len(languages) == 0 && !initAllLanguages {
+		fmt.Println(i18n.T("init.no-sources"))
+	}
+}
+
+// detectConfigLanguages 경로에서 분석 대상 파일을 수집해 규칙 설정이 있는 언어 목록 반환 (설정 순서)
+func detectConfigLanguages(defaults *config.Config, root string) ([]string, error) {
+	files, _, err := analyzer.New(defaults).ListFiles(root)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[string]bool)
+	for _, file := range files {
+		language := file.Language
+		if language == "typescript" { // TypeScript는 JavaScript 규칙을 사용
+			language = "javascript"
+		}
+		found[language] = true
+	}
+
+	var languages []string
+	for _, langRules := range defaults.Languages {
+		if found[langRules.Language] {
+			languages = append(languages, langRules.Language)
+		}
+	}
+	return languages, nil
+}
+
+// starterConfig 기본 설정에서 languages 중 지정한 언어만 남긴 YAML (languages가 비어있으면 전체, 주석은 유지)
+func starterConfig(defaults []byte, languages []string) ([]byte, error) {
+	// 기본 설정 파일의 줄바꿈(CRLF/LF)을 그대로 따름
+	newline := "\n"
+	if bytes.Contains(defaults, []byte("\r\n")) {
+		newline = "\r\n"
+	}
+	header := "# " + i18n.T("init.header") + newline
+	if len(languages) == 0 {
+		return append([]byte(header), defaults...), nil
+	}
+	header += "# " + i18n.T("init.header-languages", strings.Join(languages, ", ")) + newline
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(defaults, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New(i18n.T("init.invalid-defaults"))
+	}
+
+	root := doc.Content[0]
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value != "languages" {
+			continue
+		}
+		sequence := root.Content[i+1]
+		var kept []*yaml.Node
+		for _, item := range sequence.Content {
+			if containsString(languages, mappingValue(item, "language")) {
+				kept = append(kept, item)
+			}
+		}
+		sequence.Content = kept
+	}
+
+	var body bytes.Buffer
+	encoder := yaml.NewEncoder(&body)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	// 인코더는 LF로 출력하므로 기본 설정의 줄바꿈으로 맞춤
+	encoded := strings.ReplaceAll(strings.ReplaceAll(body.String(), "\r\n", "\n"), "\n", newline)
+	return []byte(header + encoded), nil
+}
+
+// mappingValue YAML 매핑 노드에서 key의 스칼라 값 (없으면 빈 문자열)
+func mappingValue(node *yaml.Node, key string) string {
+	if node.Kind != yaml.MappingNode {
+		return ""
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1].Value
+		}
+	}
+	return ""
+}
+
+// containsString values에 value가 있는지
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/i18n"
+)
+
+// runListFiles 분석하지 않고 분석 대상 파일과 감지된 언어 출력 (--list-files)
+// 파일이 왜 검사되지 않는지(제외 디렉터리, 지원하지 않는 확장자, 표본 분석) 확인하는 용도입니다
+func runListFiles(a *analyzer.Analyzer, targetPaths []string) {
+	files, sampling, err := a.ListFiles(targetPaths...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.collect-failed", err))
+		os.Exit(1)
+	}
+
+	languageCount := make(map[string]int)
+	for _, file := range files {
+		languageCount[file.Language]++
+		line := fmt.Sprintf("%-10s %s", file.Language, file.Path)
+		if file.LargeFile {
+			line += "  " + i18n.T("list-files.large", float64(file.Size)/(1<<20))
+		}
+		fmt.Println(line)
+	}
+
+	languages := make([]string, 0, len(languageCount))
+	for language := range languageCount {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	counts := make([]string, 0, len(languages))
+	for _, language := range languages {
+		counts = append(counts, language+" "+i18n.T("cli.count", languageCount[language]))
+	}
+
+	fmt.Printf("\n%s", i18n.T("list-files.total", len(files)))
+	if len(counts) > 0 {
+		fmt.Printf(" (%s)", strings.Join(counts, ", "))
+	}
+	fmt.Println()
+	if sampling != nil {
+		fmt.Println(i18n.T("list-files.sampling", sampling.TotalFiles, sampling.SampledFiles, sampling.Seed))
+	}
+}
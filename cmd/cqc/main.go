@@ -1,28 +1,64 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"code-quality-checker/internal/analyzer"
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/fixer"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/notify"
 	"code-quality-checker/internal/reporter"
+	"code-quality-checker/internal/telemetry"
+	"code-quality-checker/internal/types"
 
 	"github.com/spf13/cobra"
 )
 
+// version 빌드 시 -ldflags "-X main.version=..."으로 지정되는 도구 버전
+var version = "dev"
+
 var (
-	configFile   string
-	outputFormat string
-	outputFile   string
-	minSeverity  string
-	rulesFilter  string
-	verbose      bool
+	configFile    string
+	outputFormat  string
+	outputFile    string
+	minSeverity   string
+	minConfidence string
+	rulesFilter   string
+	verbose       bool
+	applyFixes    bool
+	useCache      bool
+	useIndex      bool
+	historyDB     string
+	previousFile  string
+	sample        string
+	maxFiles      int
+	sampleSeed    int64
+	maxMemoryMB   int
+	locale        string
+	listFiles     bool
+	commit        string
+	redact        string
+	htmlTemplate  string
+	groupBy       string
+	sortBy        string
+	groupLimit    int
+	noColor       bool
+	preview       int
+	experimental  bool
+	reports       []string
+	excludes      []string
+	readStdin     bool
+	stdinFilename string
 )
 
 func main() {
 	rootCmd := &cobra.Command{
-		Use:   "cqc [path]",
+		Use:   "cqc [path|glob]...",
 		Short: "Code Quality Checker - 소스코드 품질 검사 도구",
 		Long: `Code Quality Checker (CQC)
 		
@@ -30,41 +66,161 @@ CODE_QUALITY_STANDARDS.md에 정의된 기준에 따라 Java, JavaScript, HTML,
 
 사용 예시:
   cqc ./src                           # 기본 검사
+  cqc src/main/java "web/**/*.js"     # 여러 경로와 glob 패턴을 한 결과로 검사 (glob은 따옴표로 감싸기)
+  cqc "*.js" "src/*/*.css"            # glob은 현재 디렉터리 기준 경로 전체와 일치 (*는 한 구간, **는 0개 이상의 디렉터리)
+  cqc ./src --exclude "generated/" --exclude "*.min.js"  # 생성 코드와 번들 제외 (.cqcignore 파일도 지원)
+  cat Foo.java | cqc --stdin --stdin-filename src/Foo.java -o quickfix  # 에디터의 저장하지 않은 버퍼 검사
   cqc ./src --output=html             # HTML 리포트 생성
+  cqc ./src --output=sarif --output-file=cqc.sarif  # GitHub Code Scanning용 SARIF 생성
+  cqc ./src --output=junit --output-file=cqc-junit.xml  # Jenkins/GitLab 테스트 리포트용 JUnit XML 생성
+  cqc ./src --output=markdown > cqc.md  # PR 설명/위키에 붙여넣을 Markdown 생성
+  cqc ./src --output=gitlab --output-file=gl-code-quality-report.json  # GitLab MR diff용 Code Quality 리포트 생성
+  cqc ./src --output=sonar --output-file=cqc-sonar.json  # SonarQube 외부 이슈로 가져올 JSON 생성
+  cqc ./src --output=github           # GitHub Actions에서 PR 인라인 어노테이션으로 표시
+  cqc ./src --output=quickfix         # Vim :make / Emacs compilation-mode용 file:line:col 목록
+  cqc ./src --output=ndjson | jq -c 'select(.type == "issue")'  # 파일 분석이 끝날 때마다 이슈를 JSON 한 줄씩 출력
+  cqc ./src --output=console,json,html --output-file=report  # 한 번 분석해 콘솔 요약과 report.json, report.html 생성
+  cqc ./src --report json:cqc.json --report sarif:cqc.sarif  # 콘솔 출력에 더해 형식별 파일 생성
   cqc ./src --min-severity=high       # 높은 심각도만 표시
-  cqc ./src --rules=security,performance  # 특정 카테고리만 검사`,
-		Args: cobra.ExactArgs(1),
-		Run:  runAnalysis,
+  cqc ./src --min-confidence=medium   # 추정에 가까운 이슈 숨기기
+  cqc ./src --rules=security,performance  # 특정 카테고리만 검사
+  cqc ./src --fix                     # 자동 수정 가능한 이슈 수정 (import 정렬 등)
+  cqc ./src --cache                   # 변경되지 않은 파일은 이전 결과 재사용
+  cqc ./src --previous last.json      # 지난 실행 대비 신규/해결 이슈 표시
+  cqc ./src --sample 10%              # 파일 10%만 분석하고 전체 이슈 수 추정
+  cqc ./src --list-files              # 분석하지 않고 분석 대상 파일과 언어만 출력`,
+		Args:             analysisArgs,
+		PersistentPreRun: prepareCommand,
+		Run:              runAnalysis,
+		Version:          version,
 	}
 
 	// 플래그 설정
-	rootCmd.Flags().StringVarP(&configFile, "config", "c", "configs/rules.yaml", "설정 파일 경로")
-	rootCmd.Flags().StringVarP(&outputFormat, "output", "o", "console", "출력 형식 (console/json/html)")
-	rootCmd.Flags().StringVar(&outputFile, "output-file", "", "출력 파일 경로 (기본값: stdout)")
+	rootCmd.PersistentFlags().StringVarP(&configFile, "config", "c", "configs/rules.yaml", "설정 파일 경로 (지정하지 않으면 현재 디렉터리의 .cqc.yaml을 먼저 사용)")
+	rootCmd.Flags().StringVarP(&outputFormat, "output", "o", "console", "출력 형식 (console/json/html/sarif/junit/junit-file/markdown/gitlab/codeclimate/sonar/tap/github/quickfix/ndjson, 쉼표로 여러 개 지정)")
+	rootCmd.Flags().StringVar(&outputFile, "output-file", "", "출력 파일 경로 (기본값: stdout, 여러 형식이면 확장자를 뺀 파일 이름)")
+	rootCmd.Flags().StringArrayVar(&reports, "report", nil, "추가로 생성할 리포트 (형식:경로, 여러 번 지정 가능)")
+	rootCmd.Flags().StringVar(&htmlTemplate, "html-template", "", "HTML 리포트 템플릿 파일 (기본값: 내장 템플릿)")
+	rootCmd.Flags().StringVar(&groupBy, "group-by", reporter.GroupBySeverity, "콘솔 이슈 목록 그룹 기준 (severity/file/rule/category)")
+	rootCmd.Flags().StringVar(&sortBy, "sort", "", "콘솔 그룹 안 이슈 정렬 기준 (line/severity/rule/priority, 기본값: 분석 순서)")
+	rootCmd.Flags().IntVar(&groupLimit, "group-limit", reporter.DefaultGroupLimit, "콘솔 그룹별 최대 표시 이슈 수 (0이면 제한 없음)")
+	rootCmd.Flags().IntVar(&preview, "preview", 0, "미리보기: 규칙별 대표 이슈를 최대 N개만 보고하고 전체 수를 요약 (값 없이 쓰면 3, 종료 코드는 항상 0)")
+	rootCmd.Flags().Lookup("preview").NoOptDefVal = "3"
+	rootCmd.Flags().BoolVar(&experimental, "include-experimental", false, "실험 규칙의 이슈도 종료 코드와 품질 게이트에 반영")
+	rootCmd.Flags().BoolVar(&noColor, "no-color", false, "콘솔 출력 색상 끄기 (NO_COLOR 환경 변수나 터미널이 아닌 출력에서도 자동으로 꺼짐)")
 	rootCmd.Flags().StringVarP(&minSeverity, "min-severity", "s", "low", "최소 심각도 (low/medium/high/critical)")
+	rootCmd.Flags().StringVar(&minConfidence, "min-confidence", "", "최소 신뢰도 (low/medium/high)")
 	rootCmd.Flags().StringVar(&rulesFilter, "rules", "", "검사할 규칙 카테고리 (쉼표로 구분)")
 	rootCmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "상세 출력")
+	rootCmd.Flags().BoolVar(&applyFixes, "fix", false, "자동 수정 가능한 이슈를 파일에 적용")
+	rootCmd.Flags().BoolVar(&useCache, "cache", false, "파일 내용 해시 기반 결과 캐시 사용 (.cqc/cache)")
+	rootCmd.Flags().BoolVar(&useIndex, "index", false, "프로젝트 심볼 색인 갱신 및 사용 (.cqc/index)")
+	rootCmd.Flags().StringVar(&historyDB, "history", "", "실행 요약과 이슈를 누적 기록할 SQLite 파일 (cqc trends로 추이 조회)")
+	rootCmd.Flags().StringVar(&previousFile, "previous", "", "비교할 이전 JSON 분석 결과 (없으면 비교 생략)")
+	rootCmd.Flags().StringVar(&sample, "sample", "", "분석할 파일 비율 (예: 10%, 전체 이슈 수는 추정)")
+	rootCmd.Flags().IntVar(&maxFiles, "max-files", 0, "분석할 최대 파일 수 (0이면 제한 없음)")
+	rootCmd.Flags().Int64Var(&sampleSeed, "seed", 0, "표본 선택 시드")
+	rootCmd.Flags().IntVar(&maxMemoryMB, "max-memory-mb", 0, "메모리 소프트 상한 (MB, 넘으면 이후 파일은 라인 단위 규칙만 검사, 0이면 제한 없음)")
+	rootCmd.Flags().StringVar(&commit, "commit", "", "이슈 링크에 사용할 커밋 SHA (repository.url_template 설정 시, 기본값: CI 환경 변수 또는 HEAD)")
+	rootCmd.Flags().StringVar(&redact, "redact-snippets", "", "보안 이슈의 코드 스니펫 가리기 (mask/omit, 값 없이 쓰면 mask)")
+	rootCmd.Flags().Lookup("redact-snippets").NoOptDefVal = config.RedactMask
+	rootCmd.Flags().StringArrayVar(&excludes, "exclude", nil, "분석에서 제외할 경로 패턴 (gitignore 문법, 분석 경로(여러 개면 공통 상위 디렉터리) 기준, 여러 번 지정 가능)")
+	rootCmd.Flags().BoolVar(&readStdin, "stdin", false, "분석 경로 대신 표준 입력의 내용을 --stdin-filename 파일로 보고 분석")
+	rootCmd.Flags().StringVar(&stdinFilename, "stdin-filename", "", "--stdin 내용의 파일 경로 (언어 감지, 규칙 파일 패턴, 이슈 위치에 사용)")
+	rootCmd.Flags().BoolVar(&listFiles, "list-files", false, "분석하지 않고 분석 대상 파일과 감지된 언어만 출력")
+	rootCmd.PersistentFlags().StringVar(&locale, "locale", "", "규칙 설명/메시지 로케일 (예: ko, en, 기본값: 설정의 locale 또는 CQC_LOCALE)")
+	rootCmd.PersistentFlags().StringVar(&locale, "lang", "", "이슈 메시지, 리포트, 하위 명령 출력 언어 (en/ko, --locale과 같음)")
+
+	// 하위 명령
+	rootCmd.AddCommand(newRulesCmd())
+	rootCmd.AddCommand(newBadgeCmd())
+	rootCmd.AddCommand(newBundleCmd())
+	rootCmd.AddCommand(newEndpointsCmd())
+	rootCmd.AddCommand(newSQLCmd())
+	rootCmd.AddCommand(newVerifyCmd())
+	rootCmd.AddCommand(newDiffCmd())
+	rootCmd.AddCommand(newCompareConfigCmd())
+	rootCmd.AddCommand(newIndexCmd())
+	rootCmd.AddCommand(newTrendsCmd())
+	rootCmd.AddCommand(newAnnotateCmd())
+	rootCmd.AddCommand(newBenchCmd())
+	rootCmd.AddCommand(newInitCmd())
+	rootCmd.AddCommand(newExplainCmd())
 
 	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "오류 발생: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.error", err))
+		os.Exit(1)
+	}
+}
+
+// prepareCommand 모든 명령의 공통 준비 (출력 언어 적용, 설정 파일 탐색)
+func prepareCommand(cmd *cobra.Command, args []string) {
+	applyLanguage(cmd)
+	discoverConfig(cmd, args)
+}
+
+// applyLanguage --lang/--locale 또는 CQC_LOCALE의 출력 언어 적용 (설정 파일을 읽기 전에 나오는 메시지에도 적용)
+func applyLanguage(cmd *cobra.Command) {
+	if cmd.Flags().Changed("lang") && !i18n.Supported(locale) {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.unsupported-language", locale, strings.Join(i18n.Languages(), ", ")))
 		os.Exit(1)
 	}
+	switch {
+	case locale != "":
+		i18n.SetLanguage(locale)
+	case os.Getenv("CQC_LOCALE") != "":
+		i18n.SetLanguage(os.Getenv("CQC_LOCALE"))
+	}
+}
+
+// loadConfig 설정 파일 로드 (--lang/--locale을 지정했으면 설정의 locale보다 우선)
+func loadConfig(path string) (*config.Config, error) {
+	cfg, err := config.LoadConfig(path)
+	if err != nil {
+		return nil, err
+	}
+	if locale != "" {
+		cfg.ApplyLocale(locale)
+	}
+	return cfg, nil
+}
+
+// analysisArgs 분석 경로 인자 확인 (--stdin이면 경로 대신 --stdin-filename 필요)
+func analysisArgs(cmd *cobra.Command, args []string) error {
+	if !readStdin {
+		return cobra.MinimumNArgs(1)(cmd, args)
+	}
+	switch {
+	case len(args) > 0:
+		return errors.New(i18n.T("cli.stdin-with-paths"))
+	case stdinFilename == "":
+		return errors.New(i18n.T("cli.stdin-filename-required"))
+	case applyFixes:
+		return errors.New(i18n.T("cli.stdin-fix"))
+	case listFiles:
+		return errors.New(i18n.T("cli.stdin-list-files"))
+	}
+	return nil
 }
 
 func runAnalysis(cmd *cobra.Command, args []string) {
-	targetPath := args[0]
+	targetPaths := args
 
 	if verbose {
-		fmt.Printf("Code Quality Checker 시작\n")
-		fmt.Printf("대상 경로: %s\n", targetPath)
-		fmt.Printf("설정 파일: %s\n", configFile)
-		fmt.Printf("출력 형식: %s\n", outputFormat)
+		fmt.Println(i18n.T("cli.verbose.start"))
+		if readStdin {
+			fmt.Println(i18n.T("cli.verbose.stdin-target", stdinFilename))
+		} else {
+			fmt.Println(i18n.T("cli.verbose.target", strings.Join(targetPaths, ", ")))
+		}
+		fmt.Println(i18n.T("cli.verbose.config", configFile))
+		fmt.Println(i18n.T("cli.verbose.output", outputFormat))
 	}
 
 	// 1. 설정 로드
-	cfg, err := config.LoadConfig(configFile)
+	cfg, err := loadConfig(configFile)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "설정 파일 로드 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
 		os.Exit(1)
 	}
 
@@ -73,34 +229,202 @@ func runAnalysis(cmd *cobra.Command, args []string) {
 		cfg.FilterByCategories(rulesFilter)
 	}
 	cfg.FilterBySeverity(config.ParseSeverity(minSeverity))
+	if minConfidence != "" {
+		cfg.Analysis.MinConfidence = minConfidence
+	}
+	if cmd.Flags().Changed("cache") {
+		cfg.Analysis.Cache = useCache
+	}
+	if cmd.Flags().Changed("index") {
+		cfg.Analysis.Index = useIndex
+	}
+	if historyDB != "" {
+		cfg.Analysis.History = historyDB
+	}
+	cfg.Analysis.Exclude = append(cfg.Analysis.Exclude, excludes...)
+	if cmd.Flags().Changed("sample") {
+		cfg.Analysis.Sample = sample
+	}
+	if cmd.Flags().Changed("max-files") {
+		cfg.Analysis.MaxFiles = maxFiles
+	}
+	if cmd.Flags().Changed("max-memory-mb") {
+		cfg.Analysis.MaxMemoryMB = maxMemoryMB
+	}
+	if experimental {
+		cfg.Analysis.GateExperimental = true
+	}
+	if cmd.Flags().Changed("preview") {
+		cfg.Analysis.Preview = preview
+	}
+	if cfg.Analysis.Preview > 0 {
+		// 콘솔에서는 규칙별로 대표 이슈를 모두 보여줌
+		if !cmd.Flags().Changed("group-by") {
+			groupBy = reporter.GroupByRule
+		}
+		if !cmd.Flags().Changed("group-limit") {
+			groupLimit = 0
+		}
+	}
+	if cmd.Flags().Changed("seed") {
+		cfg.Analysis.SampleSeed = sampleSeed
+	}
+	if commit != "" {
+		cfg.Repository.Commit = commit
+	}
+	if redact != "" {
+		if redact != config.RedactMask && redact != config.RedactOmit {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.invalid-redact", redact))
+			os.Exit(1)
+		}
+		cfg.Analysis.RedactSnippets = redact
+	}
 
-	// 3. 분석 실행
-	analyzer := analyzer.New(cfg)
-	result, err := analyzer.Analyze(targetPath)
+	// 분석 전에 출력 설정 확인 (잘못된 형식으로 분석 시간을 버리지 않도록)
+	outputs, err := parseOutputs(outputFormat, outputFile, reports)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "분석 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.reporter-failed", err))
 		os.Exit(1)
 	}
 
-	// 4. 결과 리포팅
-	rep, err := reporter.New(outputFormat)
+	// 3. 분석 실행
+	analyzer := analyzer.New(cfg)
+	analyzer.SetVersion(version)
+	if listFiles {
+		runListFiles(analyzer, targetPaths)
+		return
+	}
+
+	// 지난 실행 결과 (리포트가 같은 파일을 덮어쓰기 전에 읽음)
+	if previousFile != "" {
+		previous, err := types.LoadResult(previousFile)
+		switch {
+		case err == nil:
+			analyzer.SetPrevious(previous)
+		case !os.IsNotExist(err):
+			fmt.Fprintln(os.Stderr, i18n.T("cli.previous-load-failed", err))
+		}
+	}
+
+	// 실행 기록이 있으면 이슈의 연속 발견 횟수와 처음 발견 시각은 실행 기록 기준 (이번 실행을 기록하기 전에 읽음)
+	if cfg.Analysis.History != "" {
+		persistence, err := loadPersistence(cfg.Analysis.History)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.history-query-failed", err))
+		} else {
+			analyzer.SetPersistence(persistence)
+		}
+	}
+
+	// NDJSON 하나만 출력하면 이슈를 결과에 모으지 않고 파일 분석이 끝날 때마다 바로 출력
+	stream := streamReporter(cfg, outputs)
+	if stream != nil {
+		if err := stream.Begin(outputs[0].Path); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.report-failed", err))
+			os.Exit(1)
+		}
+		analyzer.SetStream(stream.WriteIssue)
+	}
+
+	var result *types.AnalysisResult
+	if readStdin {
+		content, readErr := io.ReadAll(os.Stdin)
+		if readErr != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.stdin-read-failed", readErr))
+			os.Exit(1)
+		}
+		result, err = analyzer.AnalyzeSource(stdinFilename, string(content))
+	} else {
+		result, err = analyzer.Analyze(targetPaths...)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "리포터 생성 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
 		os.Exit(1)
 	}
 
-	err = rep.Generate(result, outputFile)
+	// 4. 결과 리포팅 (한 번의 분석 결과로 모든 형식 생성, 스트리밍이면 요약만 출력)
+	if stream != nil {
+		err = stream.End(result)
+	} else {
+		err = generateReports(outputs, result)
+	}
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "리포트 생성 실패: %v\n", err)
+		fmt.Fprintln(os.Stderr, i18n.T("cli.report-failed", err))
 		os.Exit(1)
 	}
 
 	if verbose {
-		fmt.Printf("\n분석 완료! 총 %d개 이슈 발견\n", len(result.Issues))
+		fmt.Println("\n" + i18n.T("cli.verbose.done", result.Summary.TotalIssues))
+		fmt.Println(i18n.T("cli.verbose.memory", float64(result.Summary.Performance.PeakMemoryBytes)/(1<<20)))
+		if stats := analyzer.CacheStats(); stats != nil {
+			fmt.Println(i18n.T("cli.verbose.cache", stats.Hits, stats.Misses, stats.Errors))
+		}
+		if index := analyzer.SymbolIndex(); index != nil {
+			fmt.Println(i18n.T("cli.verbose.index", index.Stats.Indexed, index.Stats.Reused, index.Stats.Removed))
+		}
+	}
+
+	// 자동 수정 적용
+	if applyFixes {
+		fixed, err := fixer.Apply(result.Issues)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.fix-failed", err))
+			os.Exit(1)
+		}
+		fmt.Fprintln(os.Stderr, i18n.T("cli.fixed", fixed))
+	}
+
+	// 리포트 업로드 (실패해도 분석 결과에는 영향 없음)
+	if cfg.Publish.Provider != "" {
+		if err := publishReports(cfg.Publish, outputs, result); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.upload-failed", err))
+		}
+	}
+
+	// Confluence 페이지 게시 (실패해도 분석 결과에는 영향 없음)
+	if cfg.Confluence.URL != "" {
+		if err := publishConfluence(cfg.Confluence, result); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.confluence-failed", err))
+		}
+	}
+
+	// 메일 알림 (실패해도 분석 결과에는 영향 없음)
+	if cfg.Notify.Email.Host != "" {
+		sent, err := notify.SendEmail(cfg.Notify.Email, result)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.warning", err))
+		} else if sent {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.mailed", len(cfg.Notify.Email.To)))
+		}
+	}
+
+	// 집계 지표 전송 (사용자가 켠 경우에만, 실패해도 분석 결과에는 영향 없음)
+	if cfg.Telemetry.Enabled && cfg.Telemetry.Endpoint != "" {
+		if err := telemetry.Send(cfg.Telemetry, telemetry.Build(result, cfg.Telemetry.Project)); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.telemetry-failed", err))
+		} else if verbose {
+			fmt.Println(i18n.T("cli.telemetry-sent", cfg.Telemetry.Endpoint))
+		}
+	}
+
+	// 실행 기록 저장 (미리보기는 대표 이슈만 남으므로 기록하지 않음, 실패해도 분석 결과에는 영향 없음)
+	if cfg.Analysis.History != "" && result.Preview == nil {
+		if err := appendHistory(cfg.Analysis.History, result); err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.history-save-failed", err))
+		} else if verbose {
+			fmt.Println(i18n.T("cli.history-saved", cfg.Analysis.History))
+		}
+	}
+
+	// 5. 품질 게이트 결과 출력
+	failedGates := result.FailedGates()
+	for _, gate := range failedGates {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.gate-failed",
+			gate.Category, gate.Count, gate.Max, gate.MinSeverity))
 	}
 
-	// 5. 심각한 이슈가 있으면 종료 코드 1 반환
-	if result.HasCriticalIssues() {
+	// 6. 심각한 이슈가 있거나 품질 게이트가 실패하면 종료 코드 1 반환 (미리보기는 검토용이라 실패 처리하지 않음)
+	if result.Preview == nil && (result.HasCriticalIssues() || len(failedGates) > 0) {
 		os.Exit(1)
 	}
 }
\ No newline at end of file
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/reporter"
+	"code-quality-checker/internal/types"
+)
+
+// reportOutput 한 번의 분석으로 생성할 리포트 (Path가 비어있으면 stdout)
+type reportOutput struct {
+	Format string
+	Path   string
+}
+
+// reportExtensions --output에 여러 형식을 지정했을 때 --output-file 뒤에 붙이는 확장자
+// JSON 기반 연동 형식은 json 리포트와 겹치지 않도록 형식 이름을 함께 붙임
+var reportExtensions = map[string]string{
+	"json":        ".json",
+	"html":        ".html",
+	"sarif":       ".sarif",
+	"junit":       ".xml",
+	"junit-file":  ".xml",
+	"markdown":    ".md",
+	"md":          ".md",
+	"gitlab":      ".gitlab.json",
+	"codeclimate": ".codeclimate.json",
+	"sonar":       ".sonar.json",
+	"sonarqube":   ".sonar.json",
+	"tap":         ".tap",
+	"github":      ".github.txt",
+	"quickfix":    ".qf",
+	"ndjson":      ".ndjson",
+}
+
+// parseOutputs --output, --output-file, --report 조합으로 생성할 리포트 목록 구성
+// --output에 형식이 하나면 기존처럼 --output-file(없으면 stdout)에 쓰고,
+// 여러 개면 console은 stdout에, 나머지는 --output-file을 파일 이름(확장자 제외)으로 삼아 형식별 파일에 씁니다.
+// --report 형식:경로 항목은 그 뒤에 추가됩니다.
+func parseOutputs(formats, file string, reports []string) ([]reportOutput, error) {
+	var outputs []reportOutput
+
+	var names []string
+	for _, format := range strings.Split(formats, ",") {
+		if format = strings.ToLower(strings.TrimSpace(format)); format != "" {
+			names = append(names, format)
+		}
+	}
+	switch {
+	case len(names) == 1:
+		outputs = append(outputs, reportOutput{Format: names[0], Path: file})
+	case len(names) > 1:
+		for _, format := range names {
+			if format == "console" || format == "text" {
+				outputs = append(outputs, reportOutput{Format: format})
+				continue
+			}
+			ext, ok := reportExtensions[format]
+			if !ok {
+				return nil, errors.New(i18n.T("cli.unsupported-format", format))
+			}
+			if file == "" {
+				return nil, errors.New(i18n.T("outputs.need-file"))
+			}
+			outputs = append(outputs, reportOutput{Format: format, Path: file + ext})
+		}
+	}
+
+	for _, report := range reports {
+		format, path, ok := strings.Cut(report, ":")
+		if !ok || strings.TrimSpace(format) == "" || strings.TrimSpace(path) == "" {
+			return nil, errors.New(i18n.T("outputs.invalid-report", report))
+		}
+		outputs = append(outputs, reportOutput{Format: strings.ToLower(strings.TrimSpace(format)), Path: strings.TrimSpace(path)})
+	}
+
+	// 같은 파일을 두 번 쓰거나 stdout에 여러 리포트가 섞이지 않도록 확인
+	seen := make(map[string]bool)
+	for _, output := range outputs {
+		rep, err := reporter.New(output.Format)
+		if err != nil {
+			return nil, err
+		}
+		if console, ok := configureReporter(rep, output).(*reporter.ConsoleReporter); ok {
+			if err := console.Validate(); err != nil {
+				return nil, err
+			}
+		}
+		if seen[output.Path] {
+			target := output.Path
+			if target == "" {
+				target = "stdout"
+			}
+			return nil, errors.New(i18n.T("outputs.duplicate-target", target))
+		}
+		seen[output.Path] = true
+	}
+	return outputs, nil
+}
+
+// generateReports 분석 결과 하나로 모든 리포트 생성
+func generateReports(outputs []reportOutput, result *types.AnalysisResult) error {
+	for _, output := range outputs {
+		rep, err := reporter.New(output.Format)
+		if err != nil {
+			return err
+		}
+		if err := configureReporter(rep, output).Generate(result, output.Path); err != nil {
+			return fmt.Errorf("%s: %w", output.Format, err)
+		}
+	}
+	return nil
+}
+
+// streamReporter 이슈를 분석 중에 바로 내보낼 수 있으면 스트리밍 리포터 반환 (아니면 nil)
+// 스트리밍 형식(ndjson) 하나만 출력하고, 전체 이슈 목록이 필요한 기능(자동 수정, 이전 결과 비교,
+// 미리보기, 실행 기록, 리포트 업로드, Confluence 게시, 메일 알림)을 쓰지 않을 때만 스트리밍합니다
+func streamReporter(cfg *config.Config, outputs []reportOutput) reporter.StreamReporter {
+	if len(outputs) != 1 || applyFixes || previousFile != "" || cfg.Analysis.Preview > 0 || cfg.Analysis.History != "" ||
+		cfg.Publish.Provider != "" || cfg.Confluence.URL != "" || cfg.Notify.Email.Host != "" {
+		return nil
+	}
+	return reporter.NewStream(outputs[0].Format)
+}
+
+// configureReporter 형식별 명령줄 옵션 적용 (HTML 템플릿, 콘솔 그룹/정렬/색상)
+func configureReporter(rep reporter.Reporter, output reportOutput) reporter.Reporter {
+	switch rep := rep.(type) {
+	case *reporter.HTMLReporter:
+		rep.TemplateFile = htmlTemplate
+	case *reporter.ConsoleReporter:
+		rep.GroupBy = strings.ToLower(groupBy)
+		rep.SortBy = strings.ToLower(sortBy)
+		rep.GroupLimit = groupLimit
+		// 색상은 터미널인 stdout에만 (파일로 저장하거나 파이프로 넘기면 끔)
+		rep.Color = !noColor && output.Path == "" && reporter.ColorSupported(os.Stdout)
+	}
+	return rep
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/confluence"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/publish"
+	"code-quality-checker/internal/types"
+)
+
+// reportContentTypes 출력 형식별 Content-Type
+var reportContentTypes = map[string]string{
+	"json":    "application/json",
+	"html":    "text/html; charset=utf-8",
+	"console": "text/plain; charset=utf-8",
+}
+
+// publishReports 생성된 리포트와 JSON 결과를 버킷에 업로드
+func publishReports(cfg config.PublishConfig, outputs []reportOutput, result *types.AnalysisResult) error {
+	publisher, err := publish.New(cfg)
+	if err != nil {
+		return err
+	}
+
+	var artifacts []publish.Artifact
+
+	// 파일로 생성한 리포트 (--output-file, --report)
+	hasJSON := false
+	for _, output := range outputs {
+		if output.Path == "" {
+			continue
+		}
+		data, err := os.ReadFile(output.Path)
+		if err != nil {
+			return err
+		}
+		contentType := reportContentTypes[output.Format]
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		artifacts = append(artifacts, publish.Artifact{Name: filepath.Base(output.Path), ContentType: contentType, Data: data})
+		hasJSON = hasJSON || output.Format == "json"
+	}
+
+	// 이력 보관용 JSON 결과 (JSON 리포트를 이미 올리는 경우 제외)
+	if !hasJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("%s: %w", i18n.T("publish.marshal-failed"), err)
+		}
+		artifacts = append(artifacts, publish.Artifact{Name: "result.json", ContentType: reportContentTypes["json"], Data: data})
+	}
+
+	keys, err := publisher.Publish(artifacts)
+	if err != nil {
+		return err
+	}
+	if verbose {
+		for _, key := range keys {
+			fmt.Println(i18n.T("publish.uploading", cfg.Bucket, key))
+		}
+	}
+	fmt.Println(i18n.T("publish.uploaded", cfg.Provider, cfg.Bucket))
+	return nil
+}
+
+// publishConfluence 분석 결과를 Confluence 페이지로 게시 (같은 제목의 페이지가 있으면 갱신)
+func publishConfluence(cfg config.ConfluenceConfig, result *types.AnalysisResult) error {
+	client, err := confluence.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	pageURL, err := client.Publish(result)
+	if err != nil {
+		return err
+	}
+	fmt.Println(i18n.T("publish.confluence", pageURL))
+	return nil
+}
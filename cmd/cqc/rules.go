@@ -0,0 +1,274 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/registry"
+	"code-quality-checker/internal/rules"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	registryURL  string
+	listLanguage string
+	listCategory string
+)
+
+// newRulesCmd 규칙 관리 명령
+func newRulesCmd() *cobra.Command {
+	rulesCmd := &cobra.Command{
+		Use:   "rules",
+		Short: "규칙 관리",
+	}
+
+	syncCmd := &cobra.Command{
+		Use:   "sync",
+		Short: "조직 레지스트리에서 승인된 규칙 팩 동기화",
+		Long: `조직 규칙 레지스트리에서 승인된 규칙 팩(정규식 규칙, 플러그인 매니페스트)을 다운로드합니다.
+설정 파일의 registry.packs에 고정된 버전을 사용하며, 버전이 없으면 최신 버전을 받습니다.
+
+설정 예시:
+  registry:
+    url: https://cqc.mycorp.com
+    packs:
+      - name: security
+        version: "1.2.0"
+
+사용 예시:
+  cqc rules sync --registry https://cqc.mycorp.com`,
+		Args: cobra.NoArgs,
+		Run:  runRulesSync,
+	}
+	syncCmd.Flags().StringVar(&registryURL, "registry", "", "규칙 레지스트리 URL (기본값: 설정 파일의 registry.url)")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "설정된 규칙 목록 출력",
+		Long: `설정 파일(규칙 팩 포함)의 규칙 ID, 언어, 심각도, 카테고리, 이름을 출력합니다.
+규칙 옵션과 위반 예시는 cqc rules describe로 확인하세요.
+
+사용 예시:
+  cqc rules list
+  cqc rules list --language java --category security`,
+		Args: cobra.NoArgs,
+		Run:  runRulesList,
+	}
+	listCmd.Flags().StringVar(&listLanguage, "language", "", "언어 (java/javascript/html/css/manifest, typescript는 javascript 규칙)")
+	listCmd.Flags().StringVar(&listCategory, "category", "", "카테고리 (예: security, performance)")
+
+	describeCmd := &cobra.Command{
+		Use:   "describe <rule-id>",
+		Short: "규칙 상세 정보 출력",
+		Long: `규칙의 심각도, 카테고리, 설명, 조정 가능한 옵션(custom), 위반 예시와 수정 예시를 출력합니다.
+
+사용 예시:
+  cqc rules describe java-method-length`,
+		Args: cobra.ExactArgs(1),
+		Run:  runRulesDescribe,
+	}
+
+	rulesCmd.AddCommand(syncCmd)
+	rulesCmd.AddCommand(listCmd)
+	rulesCmd.AddCommand(describeCmd)
+	return rulesCmd
+}
+
+func runRulesList(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	language := strings.ToLower(listLanguage)
+	if language == "typescript" { // TypeScript는 JavaScript 규칙을 사용
+		language = "javascript"
+	}
+
+	count := 0
+	for _, langRules := range cfg.Languages {
+		if language != "" && langRules.Language != language {
+			continue
+		}
+		for _, rule := range langRules.Rules {
+			if listCategory != "" && !strings.EqualFold(rule.Category, listCategory) {
+				continue
+			}
+			line := fmt.Sprintf("%-40s %-10s %-8s %-14s %s", rule.ID, langRules.Language, rule.Severity, rule.Category, rule.Name)
+			if rule.Maturity != "" && rule.Maturity != config.MaturityStable {
+				line += fmt.Sprintf(" (%s)", rule.Maturity)
+			}
+			fmt.Println(line)
+			count++
+		}
+	}
+
+	if count == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("rules.none"))
+		os.Exit(1)
+	}
+	fmt.Println("\n" + i18n.T("rules.count", count))
+}
+
+func runRulesDescribe(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	language, rule, ok := findRuleConfig(cfg, args[0])
+	if !ok {
+		fmt.Fprintln(os.Stderr, i18n.T("explain.not-found", args[0]))
+		os.Exit(1)
+	}
+	printRule(language, rule)
+}
+
+// findRuleConfig 설정에서 규칙 ID로 규칙 설정과 언어 찾기
+func findRuleConfig(cfg *config.Config, id string) (string, config.RuleConfig, bool) {
+	for _, langRules := range cfg.Languages {
+		for _, rule := range langRules.Rules {
+			if rule.ID == id {
+				return langRules.Language, rule, true
+			}
+		}
+	}
+	return "", config.RuleConfig{}, false
+}
+
+// printRule 규칙 상세 정보 출력 (describe)
+func printRule(language string, rule config.RuleConfig) {
+	fmt.Printf("%s\n", rule.ID)
+	fmt.Println(i18n.T("rules.describe.name", rule.Name))
+	fmt.Println(i18n.T("rules.describe.language", language))
+	fmt.Println(i18n.T("rules.describe.severity", rule.Severity))
+	fmt.Println(i18n.T("rules.describe.category", rule.Category))
+	if rule.Confidence != "" {
+		fmt.Println(i18n.T("rules.describe.confidence", rule.Confidence))
+	}
+	if rule.Maturity != "" {
+		fmt.Println(i18n.T("rules.describe.maturity", rule.Maturity))
+	}
+	if rule.Pack != "" {
+		fmt.Println(i18n.T("rules.describe.pack", rule.Pack))
+	}
+	if len(rule.CWE) > 0 {
+		fmt.Printf("  CWE:      %s\n", strings.Join(rule.CWE, ", "))
+	}
+	if rule.OWASP != "" {
+		fmt.Printf("  OWASP:    %s\n", rule.OWASP)
+	}
+	if rule.Description != "" {
+		fmt.Printf("\n%s\n", rule.Description)
+	}
+
+	doc := rules.DocFor(rule)
+	printRuleOptions(rule, doc)
+	if len(rule.Exclude) > 0 {
+		fmt.Println("\n" + i18n.T("rules.describe.exclude", strings.Join(rule.Exclude, ", ")))
+	}
+	if len(rule.Banned) > 0 {
+		fmt.Println("\n" + i18n.T("rules.describe.banned", len(rule.Banned)))
+	}
+
+	if len(doc.Examples) == 0 {
+		fmt.Println("\n" + i18n.T("rules.describe.no-examples"))
+		return
+	}
+	for _, example := range doc.Examples {
+		if example.Bad != "" {
+			fmt.Printf("\n%s\n%s", i18n.T("rules.describe.bad-example"), indentBlock(example.Bad))
+		}
+	}
+	fmt.Println("\n" + i18n.T("rules.describe.explain", rule.ID))
+}
+
+// printRuleOptions 규칙 옵션 출력
+// 기본 제공 규칙이 읽는 옵션은 현재 값(설정하지 않았으면 기본값)과 함께, 그 외 설정된 값은 그대로 표시합니다
+func printRuleOptions(rule config.RuleConfig, doc rules.RuleDoc) {
+	var options []string
+	documented := make(map[string]bool)
+	for _, option := range doc.Options {
+		documented[option.Name] = true
+		value, set := rule.Custom[option.Name]
+		switch {
+		case set:
+			value = fmt.Sprintf("%q", value)
+		case option.Default != "":
+			value = i18n.T("rules.option.default", option.Default)
+		default:
+			value = i18n.T("rules.option.unset")
+		}
+		options = append(options, fmt.Sprintf("  %s: %s\n      %s", option.Name, value, option.Description))
+	}
+	var extra []string
+	for key := range rule.Custom {
+		if !documented[key] {
+			extra = append(extra, key)
+		}
+	}
+	sort.Strings(extra)
+	for _, key := range extra {
+		options = append(options, fmt.Sprintf("  %s: %q", key, rule.Custom[key]))
+	}
+	if len(options) > 0 {
+		fmt.Println("\n" + i18n.T("rules.options"))
+		for _, option := range options {
+			fmt.Println(option)
+		}
+	}
+}
+
+// indentBlock 여러 줄 코드를 들여써서 출력용으로 변환
+func indentBlock(code string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(strings.TrimRight(code, "\n"), "\n") {
+		b.WriteString("    " + line + "\n")
+	}
+	return b.String()
+}
+
+func runRulesSync(cmd *cobra.Command, args []string) {
+	// 설치된 팩 버전과 무관하게 동기화할 수 있도록 팩 병합 없이 로드
+	cfg, err := config.LoadRawConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	url := registryURL
+	if url == "" {
+		url = cfg.Registry.URL
+	}
+	if url == "" {
+		fmt.Fprintln(os.Stderr, i18n.T("rules.sync.no-registry"))
+		os.Exit(1)
+	}
+
+	dir := cfg.PackDir()
+	if len(cfg.Registry.Packs) == 0 {
+		fmt.Fprintln(os.Stderr, i18n.T("rules.sync.no-packs"))
+		os.Exit(1)
+	}
+
+	lock, err := registry.NewClient(url).Sync(cfg.Registry.Packs, dir)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("rules.sync.failed", err))
+		os.Exit(1)
+	}
+
+	for _, entry := range lock.Packs {
+		fmt.Printf("✅ %s@%s (sha256: %s)\n", entry.Name, entry.Version, entry.SHA256[:12])
+		for _, plugin := range entry.Plugins {
+			fmt.Printf("   🔌 %s\n", plugin)
+		}
+	}
+	fmt.Println(i18n.T("rules.sync.done", len(lock.Packs), dir))
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"code-quality-checker/internal/analyzer"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/queries"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	sqlFormat string
+	sqlOut    string
+	sqlTop    int
+)
+
+// newSQLCmd SQL 사용 목록 명령
+func newSQLCmd() *cobra.Command {
+	sqlCmd := &cobra.Command{
+		Use:   "sql [path]",
+		Short: "Java 문자열과 MyBatis 매퍼의 SQL 사용 목록 생성",
+		Long: `Java 문자열 리터럴과 MyBatis 매퍼 XML에서 SQL 문을 찾아 사용하는 테이블, 조인 수, 서브쿼리 수, 복잡도를 정리합니다.
+콘솔 출력은 복잡도가 높은 쿼리와 테이블별 사용 횟수를 보여주며, JSON 출력은 전체 목록을 담습니다.
+
+사용 예시:
+  cqc sql ./src
+  cqc sql ./src --top 20
+  cqc sql ./src -o json --output-file sql.json`,
+		Args: cobra.ExactArgs(1),
+		Run:  runSQL,
+	}
+	sqlCmd.Flags().StringVarP(&sqlFormat, "output", "o", "console", "출력 형식 (console/json)")
+	sqlCmd.Flags().StringVar(&sqlOut, "output-file", "", "출력 파일 경로 (기본값: stdout)")
+	sqlCmd.Flags().IntVar(&sqlTop, "top", 10, "콘솔에 표시할 복잡도 상위 쿼리 수")
+
+	return sqlCmd
+}
+
+func runSQL(cmd *cobra.Command, args []string) {
+	cfg, err := loadConfig(configFile)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.config-load-failed", err))
+		os.Exit(1)
+	}
+
+	// 파싱 결과만 필요하므로 규칙 검사와 캐시는 생략
+	cfg.Languages = nil
+	cfg.Analysis.Cache = false
+
+	collector := &queries.Collector{}
+	a := analyzer.New(cfg)
+	a.AddHook(analyzer.HookFuncs{FileParsed: collector.Add})
+	if _, err := a.Analyze(args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.analysis-failed", err))
+		os.Exit(1)
+	}
+	if err := collector.AddMappers(args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("sql.mapper-failed", err))
+		os.Exit(1)
+	}
+	inventory := collector.Inventory()
+
+	var out io.Writer = os.Stdout
+	if sqlOut != "" {
+		file, err := os.Create(sqlOut)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.output-create-failed", err))
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch sqlFormat {
+	case "json":
+		err = queries.WriteJSON(out, inventory)
+	case "console", "text":
+		err = queries.WriteConsole(out, inventory, sqlTop)
+	default:
+		err = errors.New(i18n.T("cli.unsupported-format", sqlFormat))
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("sql.failed", err))
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"code-quality-checker/internal/history"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	trendsLast   int
+	trendsFormat string
+	trendsOut    string
+)
+
+// newTrendsCmd 실행 기록의 이슈 추이 명령
+func newTrendsCmd() *cobra.Command {
+	trendsCmd := &cobra.Command{
+		Use:   "trends <history.sqlite>",
+		Short: "실행 기록(--history)의 심각도/규칙별 이슈 추이 출력",
+		Long: `--history로 SQLite 파일에 누적한 실행 기록에서 최근 실행들의 심각도별, 규칙별 이슈 수 추이를 출력합니다.
+HTML 형식은 심각도별 추이 차트와 규칙별 추이 표를 외부 리소스 없이 파일 하나에 담습니다.
+SQLite 파일은 내장된 순수 Go 드라이버로 읽으므로 sqlite3 명령이 필요 없습니다.
+
+사용 예시:
+  cqc ./src --history quality.sqlite
+  cqc trends quality.sqlite
+  cqc trends quality.sqlite --last 50 -o html --output-file trends.html`,
+		Args: cobra.ExactArgs(1),
+		Run:  runTrends,
+	}
+	trendsCmd.Flags().IntVar(&trendsLast, "last", 20, "조회할 최근 실행 수 (0이면 전체)")
+	trendsCmd.Flags().StringVarP(&trendsFormat, "output", "o", "console", "출력 형식 (console/html/json)")
+	trendsCmd.Flags().StringVar(&trendsOut, "output-file", "", "출력 파일 경로 (기본값: stdout)")
+
+	return trendsCmd
+}
+
+func runTrends(cmd *cobra.Command, args []string) {
+	if trendsFormat != "console" && trendsFormat != "html" && trendsFormat != "json" {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.unsupported-format-choice", trendsFormat, "console/html/json"))
+		os.Exit(1)
+	}
+	if _, err := os.Stat(args[0]); err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("trends.open-failed", err))
+		os.Exit(1)
+	}
+
+	store, err := history.Open(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		os.Exit(1)
+	}
+	defer store.Close()
+	trends, err := store.Trends(trendsLast)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("trends.query-failed", err))
+		os.Exit(1)
+	}
+
+	var out io.Writer = os.Stdout
+	if trendsOut != "" {
+		file, err := os.Create(trendsOut)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, i18n.T("cli.output-create-failed", err))
+			os.Exit(1)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch trendsFormat {
+	case "html":
+		err = history.WriteHTML(out, trends)
+	case "json":
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(trends)
+	default:
+		err = history.WriteConsole(out, trends)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("trends.failed", err))
+		os.Exit(1)
+	}
+	if trendsOut != "" {
+		fmt.Println(i18n.T("trends.created", trendsOut))
+	}
+}
+
+// loadPersistence 실행 기록에서 이슈별 연속 발견 정보 조회
+func loadPersistence(path string) (map[string]types.Persistence, error) {
+	store, err := history.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer store.Close()
+	return store.Persistence()
+}
+
+// appendHistory 분석 결과를 실행 기록에 추가
+func appendHistory(path string, result *types.AnalysisResult) error {
+	store, err := history.Open(path)
+	if err != nil {
+		return err
+	}
+	defer store.Close()
+	return store.Append(result)
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"github.com/spf13/cobra"
+)
+
+var verifyKeyEnv string
+
+// newVerifyCmd JSON 결과 서명 검증 명령
+func newVerifyCmd() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use:   "verify <result.json>",
+		Short: "JSON 분석 결과의 메타데이터 출력 및 서명 검증",
+		Long: `JSON 결과 파일(cqc -o json)에 기록된 도구 버전, 규칙 세트 해시, 커밋을 출력하고 HMAC 서명을 검증합니다.
+서명 키는 분석할 때와 같은 환경 변수(기본값 CQC_SIGNING_KEY)에서 읽습니다.
+서명이 없거나 일치하지 않으면 종료 코드 1을 반환합니다.
+
+사용 예시:
+  CQC_SIGNING_KEY=secret cqc ./src -o json --output-file result.json
+  CQC_SIGNING_KEY=secret cqc verify result.json`,
+		Args: cobra.ExactArgs(1),
+		Run:  runVerify,
+	}
+	verifyCmd.Flags().StringVar(&verifyKeyEnv, "key-env", config.DefaultSigningKeyEnv, "서명 키를 담은 환경 변수")
+
+	return verifyCmd
+}
+
+func runVerify(cmd *cobra.Command, args []string) {
+	result, err := types.LoadResult(args[0])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, i18n.T("cli.result-load-failed", err))
+		os.Exit(1)
+	}
+	if result.Metadata == nil {
+		fmt.Fprintln(os.Stderr, i18n.T("verify.no-metadata"))
+		os.Exit(1)
+	}
+
+	metadata := result.Metadata
+	fmt.Println(i18n.T("verify.tool-version", metadata.ToolVersion))
+	fmt.Println(i18n.T("verify.ruleset-hash", metadata.RulesetHash))
+	if metadata.Commit != "" {
+		fmt.Println(i18n.T("verify.commit", metadata.Commit))
+	}
+
+	key := os.Getenv(verifyKeyEnv)
+	if key == "" {
+		fmt.Fprintln(os.Stderr, i18n.T("verify.no-key", verifyKeyEnv))
+		os.Exit(1)
+	}
+	if err := result.VerifySignature([]byte(key)); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(i18n.T("verify.ok"))
+}
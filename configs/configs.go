@@ -0,0 +1,10 @@
+package configs
+
+import (
+	_ "embed"
+)
+
+// DefaultRules 기본 제공 규칙 설정 (cqc init이 시작 설정 파일을 만들 때 사용)
+//
+//go:embed rules.yaml
+var DefaultRules []byte
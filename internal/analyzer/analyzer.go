@@ -7,9 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"code-quality-checker/internal/cache"
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/ignore"
 	"code-quality-checker/internal/parser"
 	"code-quality-checker/internal/rules"
+	"code-quality-checker/internal/symbols"
 	"code-quality-checker/internal/types"
 )
 
@@ -22,19 +25,40 @@ type Summary = types.Summary
 type Analyzer struct {
 	config     *config.Config
 	ruleEngine *rules.Engine
+	cache      *cache.Cache   // nil이면 캐시 사용 안 함
+	symbols    *symbols.Index // nil이면 심볼 색인 사용 안 함
+	hooks      []Hook
+	previous   *AnalysisResult // 비교할 이전 결과 (nil이면 비교 안 함)
+	version    string          // 결과 메타데이터에 기록할 도구 버전
+	stream     IssueStream     // nil이면 이슈를 결과에 모음 (SetStream 참고)
+
+	baseline      types.IssueSet // 억제할 기존 이슈 (analysis.baseline)
+	baselineUntil *time.Time
+	persistence   map[string]types.Persistence // 실행 기록의 연속 발견 정보 (nil이면 이전 결과로 계산)
+	stageTime     map[string]time.Duration     // 실행 중인 분석의 단계별 소요 시간
 }
 
 // New 새로운 분석기 생성
 func New(cfg *config.Config) *Analyzer {
-	return &Analyzer{
+	a := &Analyzer{
 		config:     cfg,
 		ruleEngine: rules.NewEngine(cfg),
+		hooks:      registeredHooks(),
+	}
+	if cfg.Analysis.Cache {
+		a.cache = cache.New(cfg.CacheDir(), cfg)
 	}
+	return a
 }
 
-// Analyze 코드 분석 실행
-func (a *Analyzer) Analyze(targetPath string) (*AnalysisResult, error) {
+// Analyze 코드 분석 실행 (경로나 glob 패턴을 여러 개 주면 한 결과로 합침)
+func (a *Analyzer) Analyze(paths ...string) (*AnalysisResult, error) {
 	startTime := time.Now()
+	targets, err := parseTargets(paths)
+	if err != nil {
+		return nil, err
+	}
+	targetPath := targetBase(targets)
 	
 	result := &AnalysisResult{
 		StartTime: startTime,
@@ -42,50 +66,351 @@ func (a *Analyzer) Analyze(targetPath string) (*AnalysisResult, error) {
 			SeverityCount: make(map[config.Severity]int),
 			CategoryCount: make(map[string]int),
 			LanguageCount: make(map[string]int),
+			Performance: types.Performance{
+				LanguageTimeMS: make(map[string]int64),
+			},
 		},
 	}
 
 	// 대상 파일 수집
-	files, err := a.collectFiles(targetPath)
+	a.stageTime = make(map[string]time.Duration)
+	result.Summary.Performance.StageTime = a.stageTime
+	files, sampling, err := a.selectFiles(targets)
 	if err != nil {
-		return nil, fmt.Errorf("파일 수집 실패: %w", err)
+		return nil, err
 	}
-
+	a.stageTime[types.StageCollect] = time.Since(startTime)
+	result.Sampling = sampling
 	result.Summary.TotalFiles = len(files)
 
+	// 베이스라인 (기존 이슈 일괄 억제)
+	if err := a.loadBaseline(); err != nil {
+		return nil, err
+	}
+
+	// 트리아지 상태 (오탐/수정 안 함 표시)
+	triage, err := a.loadTriage(targetPath)
+	if err != nil {
+		return nil, err
+	}
+	stream := a.newIssueStream(targetPath, triage)
+
+	// 파일 간 상관 분석용 색인
+	var index *projectIndex
+	if a.config.Analysis.Correlate {
+		index = newProjectIndex()
+	}
+
+	// 프로젝트 심볼 색인 (내용이 바뀐 파일만 다시 색인)
+	indexed := make(map[string]bool)
+	if a.config.Analysis.Index {
+		symbolIndex, err := symbols.Load(a.config.IndexDir())
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("심볼 색인을 읽지 못해 새로 만듭니다: %v", err))
+		}
+		a.symbols = symbolIndex
+	}
+
+	// 기능 플래그 목록 (기능 플래그 규칙이 켜진 경우만)
+	flags := newFeatureFlagIndex(a.config)
+
 	// 각 파일 분석
+	perf := &result.Summary.Performance
+	memory := newMemoryMonitor(a.config.Analysis.MaxMemoryMB)
+	languageTime := make(map[string]time.Duration)
 	for _, file := range files {
-		issues, err := a.analyzeFile(file)
+		language := a.detectLanguage(file)
+		fileStart := time.Now()
+
+		info, err := parser.Stat(file)
 		if err != nil {
 			fmt.Printf("경고: %s 파일 분석 중 오류 발생: %v\n", file, err)
 			continue
 		}
 
-		result.Issues = append(result.Issues, issues...)
+		// 메모리 상한을 넘은 뒤에는 라인 단위 규칙만 검사
+		lowMemory := memory.exceeded
+		if lowMemory {
+			perf.LowMemoryFiles++
+		}
+		issues, warning, degraded, err := a.analyzeFile(file, info, lowMemory)
+		memory.sample()
+		elapsed := time.Since(fileStart)
+		languageTime[language] += elapsed
+		if err != nil {
+			fmt.Printf("경고: %s 파일 분석 중 오류 발생: %v\n", file, err)
+			continue
+		}
+		if warning != "" {
+			result.Warnings = append(result.Warnings, warning)
+		}
+		if degraded != nil {
+			result.Degraded = append(result.Degraded, *degraded)
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s 구조 파싱 실패로 텍스트 기반 규칙만 검사했습니다: %s", file, degraded.Reason))
+		}
+
+		reported, suppressed, suppressWarnings := a.suppressIssues(file, a.filterByConfidence(issues), startTime)
+		result.Warnings = append(result.Warnings, suppressWarnings...)
+		reported = a.applyIssueHooks(reported)
+		if stream != nil {
+			if suppressed, err = stream.emit(&result.Summary, reported, suppressed); err != nil {
+				return nil, err
+			}
+		} else {
+			result.Issues = append(result.Issues, reported...)
+		}
+		result.Suppressed = append(result.Suppressed, suppressed...)
+		perf.TotalBytes += info.Size()
+
+		if a.symbols != nil && info.Size() <= a.config.LargeFileThreshold() {
+			if err := a.symbols.Update(file, language); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s 심볼 색인 실패: %v", file, err))
+			} else {
+				symbols.Seen(indexed, file)
+			}
+		}
+		// 심볼 색인을 쓰면 분석 후 색인에서 템플릿 요소를 가져옴
+		if index != nil && a.symbols == nil && language == "html" {
+			if err := index.addTemplate(file); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s 템플릿 색인 실패: %v", file, err))
+			}
+		}
+		if flags != nil {
+			if err := flags.add(file, language); err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("%s 기능 플래그 수집 실패: %v", file, err))
+			}
+		}
 		
 		// 언어별 카운트 업데이트
-		language := a.detectLanguage(file)
 		result.Summary.LanguageCount[language]++
 	}
 
+	perf.PeakMemoryBytes = memory.peak
+	for language, elapsed := range languageTime {
+		perf.LanguageTimeMS[language] = elapsed.Milliseconds()
+	}
+	if perf.LowMemoryFiles > 0 {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("메모리 사용량이 상한(%dMB)을 넘어 파일 %d개는 라인 단위 규칙만 검사했습니다", a.config.Analysis.MaxMemoryMB, perf.LowMemoryFiles))
+	}
+
+	// 심볼 색인 정리 및 저장 (표본 분석이면 분석하지 않은 파일의 심볼은 유지)
+	if a.symbols != nil {
+		for _, root := range pruneRoots(targets, sampling) {
+			a.symbols.Prune(root, indexed)
+		}
+		if err := a.symbols.Save(); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("심볼 색인 저장 실패: %v", err))
+		}
+		if index != nil {
+			index = projectIndexFromSymbols(a.symbols)
+		}
+	}
+
+	// 템플릿과 스크립트에 걸친 XSS 결합 (스트리밍이면 이미 내보낸 이슈는 결합할 수 없으므로 생략)
+	if index != nil && stream != nil {
+		result.Warnings = append(result.Warnings, "이슈를 스트리밍으로 출력해 템플릿과 스크립트에 걸친 XSS 결합은 적용하지 않았습니다")
+	} else if index != nil {
+		result.Issues = correlateXSS(result.Issues, index)
+	}
+	if flags != nil {
+		result.Flags = flags.list()
+	}
+
+	if stream != nil {
+		stream.finish(result)
+	} else {
+		a.finishIssues(targetPath, result, triage, startTime)
+	}
+	if result.Sampling != nil {
+		estimateSampling(result.Sampling, result.Summary)
+	}
+	result.Warnings = append(result.Warnings, deprecatedRuleWarnings(a.config)...)
+
+	// 미리보기 모드 (요약과 게이트는 전체 이슈 기준)
+	if a.config.Analysis.Preview > 0 && stream == nil {
+		applyPreview(result, a.config.Analysis.Preview)
+	}
+
+	result.EndTime = time.Now()
+	result.Duration = result.EndTime.Sub(result.StartTime)
+
+	// 성능 통계 계산
+	if seconds := result.Duration.Seconds(); seconds > 0 {
+		perf.FilesPerSecond = float64(len(files)) / seconds
+	}
+
+	result.Metadata = a.buildMetadata(targetPath)
+	for _, hook := range a.hooks {
+		hook.OnComplete(result)
+	}
+
+	// 훅까지 반영된 결과에 서명 (서명 이후에는 결과를 바꾸지 않음)
+	if key := a.config.SigningKey(); key != nil {
+		if err := result.Sign(key); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// finishIssues 모은 이슈 전체에 ID, 트리아지, 링크, 이전 결과 비교, 우선순위를 적용하고 요약과 품질 게이트 계산
+func (a *Analyzer) finishIssues(targetPath string, result *AnalysisResult, triage map[string]types.Triage, startTime time.Time) {
+	// 스니펫 가리기 (식별자는 결과 파일에 남는 가린 스니펫으로 계산)
+	a.redactSnippets(result.Issues, result.Suppressed)
+
+	// 이슈 식별자/ID 기록 및 트리아지 적용
+	assignIssueIDs(result.Issues, result.Suppressed)
+	var triaged []types.SuppressedIssue
+	result.Issues, triaged = applyTriage(result.Issues, triage)
+	result.Suppressed = append(result.Suppressed, triaged...)
+
+	// 걸러지고 남은 이슈에 규칙별/파일별 상한 적용
+	result.Issues = a.capIssues(result.Issues)
+
+	// 코드 호스팅 링크
+	linkIssues(result.Issues, a.config.Repository)
+
+	// 지속 기간 기록과 방치된 이슈 심각도 상향 (실행 기록이 있으면 실행 기록 기준), 이전 결과와 비교
+	switch {
+	case a.persistence != nil:
+		types.ApplyPersistence(a.persistence, result)
+	case a.previous != nil:
+		types.TrackPersistence(a.previous, result)
+	}
+	if a.persistence != nil || a.previous != nil {
+		escalateIssues(a.config.Escalation, result.Issues, startTime)
+	}
+	if a.previous != nil {
+		result.Delta = types.CompareResults(a.previous, result)
+	}
+
+	// 우선순위 점수 (심각도 상향까지 반영한 심각도 기준)
+	a.prioritize(targetPath, result.Issues)
+
 	// 요약 정보 계산
 	result.Summary.TotalIssues = len(result.Issues)
 	for _, issue := range result.Issues {
 		result.Summary.SeverityCount[issue.Severity]++
 		result.Summary.CategoryCount[issue.Category]++
 	}
+	result.Summary.Hotspots = types.RankHotspots(result.Issues, types.HotspotCount)
 
-	result.EndTime = time.Now()
-	result.Duration = result.EndTime.Sub(result.StartTime)
+	// 품질 게이트 평가 (실험 규칙의 이슈는 제외)
+	markAdvisory(result.Issues, a.config.Analysis.GateExperimental)
+	result.Gates = evaluateGates(a.config.Gates, result.Issues)
+}
 
-	return result, nil
+// selectFiles 분석 대상마다 파일을 수집해 합친 뒤 표본 분석 설정 적용 (표본을 고르지 않았으면 Sampling은 nil)
+// 여러 대상에 겹치는 파일은 한 번만 분석합니다
+func (a *Analyzer) selectFiles(targets []target) ([]string, *types.Sampling, error) {
+	var files []string
+	seen := make(map[string]bool)
+	// 제외 패턴은 대상마다가 아니라 대상들의 공통 상위 디렉터리 기준 (대상이 하나면 그 경로)
+	excludeBase := targetBase(targets)
+	for _, t := range targets {
+		// glob의 시작 디렉터리가 없으면 일치하는 파일이 없는 것
+		if _, err := os.Stat(t.root); t.pattern != "" && os.IsNotExist(err) {
+			continue
+		}
+		collected, err := a.collectFiles(t.root, excludeBase)
+		if err != nil {
+			return nil, nil, fmt.Errorf("파일 수집 실패: %w", err)
+		}
+		for _, file := range collected {
+			key, err := filepath.Abs(file)
+			if err != nil {
+				key = file
+			}
+			if t.matches(file) && !seen[key] {
+				seen[key] = true
+				files = append(files, file)
+			}
+		}
+	}
+	// 일치하는 파일이 없는 glob 하나는 건너뛰지만, 모든 대상에서 파일이 하나도 나오지 않으면 오타일 가능성이 높으므로 오류
+	if len(files) == 0 {
+		var globs []string
+		for _, t := range targets {
+			if t.pattern != "" {
+				globs = append(globs, t.arg)
+			}
+		}
+		if len(globs) > 0 {
+			return nil, nil, fmt.Errorf("분석 대상과 일치하는 파일이 없습니다: %s", strings.Join(globs, ", "))
+		}
+	}
+
+	// 표본 분석 (대형 저장소의 빠른 상태 점검용)
+	ratio, err := a.config.SampleRatio()
+	if err != nil {
+		return nil, nil, err
+	}
+	if ratio < 1 || a.config.Analysis.MaxFiles > 0 {
+		sampled := sampleFiles(files, ratio, a.config.Analysis.MaxFiles, a.config.Analysis.SampleSeed)
+		if len(sampled) < len(files) {
+			sampling := &types.Sampling{
+				TotalFiles:   len(files),
+				SampledFiles: len(sampled),
+				Ratio:        float64(len(sampled)) / float64(len(files)),
+				Seed:         a.config.Analysis.SampleSeed,
+			}
+			return sampled, sampling, nil
+		}
+	}
+
+	return files, nil, nil
+}
+
+// ListedFile 분석 대상 파일 (--list-files 출력용)
+type ListedFile struct {
+	Path      string
+	Language  string
+	Size      int64
+	LargeFile bool // 대용량 파일이라 라인 단위 규칙만 검사
 }
 
-// collectFiles 분석할 파일 수집
-func (a *Analyzer) collectFiles(targetPath string) ([]string, error) {
+// ListFiles 분석을 실행하지 않고 분석할 파일과 감지된 언어 반환 (제외 디렉터리, 확장자, 표본 분석 적용 후)
+func (a *Analyzer) ListFiles(paths ...string) ([]ListedFile, *types.Sampling, error) {
+	targets, err := parseTargets(paths)
+	if err != nil {
+		return nil, nil, err
+	}
+	files, sampling, err := a.selectFiles(targets)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	listed := make([]ListedFile, 0, len(files))
+	for _, file := range files {
+		entry := ListedFile{Path: file, Language: a.detectLanguage(file)}
+		if info, err := os.Stat(file); err == nil {
+			entry.Size = info.Size()
+			entry.LargeFile = info.Size() > a.config.LargeFileThreshold()
+		}
+		listed = append(listed, entry)
+	}
+	return listed, sampling, nil
+}
+
+// collectFiles 분석할 파일 수집 (설정의 제외 패턴은 excludeBase 기준)
+func (a *Analyzer) collectFiles(targetPath, excludeBase string) ([]string, error) {
 	var files []string
 
-	err := filepath.Walk(targetPath, func(path string, info os.FileInfo, err error) error {
+	// 표준 입력으로 받은 내용은 디스크에 파일이 없어도 분석 대상
+	if _, ok := parser.Overlay(targetPath); ok {
+		if a.isSupportedFile(targetPath) {
+			files = append(files, targetPath)
+		}
+		return files, nil
+	}
+
+	excludes, err := a.excludeList(targetPath, excludeBase)
+	if err != nil {
+		return nil, err
+	}
+
+	err = filepath.Walk(targetPath, func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
@@ -93,14 +418,15 @@ func (a *Analyzer) collectFiles(targetPath string) ([]string, error) {
 		if info.IsDir() {
 			// 제외할 디렉토리 스킵
 			dirName := filepath.Base(path)
-			if a.shouldSkipDirectory(dirName) {
+			if a.shouldSkipDirectory(dirName) || excludes.Ignored(path, true) {
 				return filepath.SkipDir
 			}
-			return nil
+			// 하위 디렉터리의 .cqcignore는 그 디렉터리 아래에만 적용
+			return excludes.AddFile(filepath.Join(path, ignore.FileName))
 		}
 
 		// 지원하는 파일 확장자인지 확인
-		if a.isSupportedFile(path) {
+		if a.isSupportedFile(path) && !excludes.Ignored(path, false) {
 			files = append(files, path)
 		}
 
@@ -110,6 +436,29 @@ func (a *Analyzer) collectFiles(targetPath string) ([]string, error) {
 	return files, err
 }
 
+// excludeList 설정(analysis.exclude, --exclude)의 제외 패턴을 기준 경로(파일이면 그 디렉터리)로 구성
+// 기준 경로는 수집하는 경로와 같은 형태(상대/절대)로 맞추며, .cqcignore는 파일을 수집하면서 디렉터리마다 추가합니다
+func (a *Analyzer) excludeList(targetPath, base string) (*ignore.List, error) {
+	if info, err := os.Stat(base); err == nil && !info.IsDir() {
+		base = filepath.Dir(base)
+	}
+	if filepath.IsAbs(base) && !filepath.IsAbs(targetPath) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, base); err == nil {
+				base = rel
+			}
+		}
+	}
+
+	excludes := &ignore.List{}
+	for _, exclude := range a.config.Analysis.Exclude {
+		if err := excludes.Add(base, exclude); err != nil {
+			return nil, err
+		}
+	}
+	return excludes, nil
+}
+
 // shouldSkipDirectory 스킵할 디렉토리인지 확인
 func (a *Analyzer) shouldSkipDirectory(dirName string) bool {
 	skipDirs := []string{
@@ -130,8 +479,12 @@ func (a *Analyzer) shouldSkipDirectory(dirName string) bool {
 
 // isSupportedFile 지원하는 파일인지 확인
 func (a *Analyzer) isSupportedFile(path string) bool {
+	if parser.IsManifest(path) {
+		return true
+	}
+
 	ext := strings.ToLower(filepath.Ext(path))
-	supportedExts := []string{".java", ".js", ".jsx", ".ts", ".tsx", ".html", ".htm", ".css", ".scss", ".less"}
+	supportedExts := []string{".java", ".js", ".jsx", ".ts", ".tsx", ".html", ".htm", ".jsp", ".jspf", ".css", ".scss", ".less"}
 	
 	for _, supportedExt := range supportedExts {
 		if ext == supportedExt {
@@ -143,6 +496,10 @@ func (a *Analyzer) isSupportedFile(path string) bool {
 
 // detectLanguage 파일 확장자로 언어 감지
 func (a *Analyzer) detectLanguage(path string) string {
+	if parser.IsManifest(path) {
+		return "manifest"
+	}
+
 	ext := strings.ToLower(filepath.Ext(path))
 	
 	switch ext {
@@ -152,7 +509,7 @@ func (a *Analyzer) detectLanguage(path string) string {
 		return "javascript"
 	case ".ts", ".tsx":
 		return "typescript"
-	case ".html", ".htm":
+	case ".html", ".htm", ".jsp", ".jspf":
 		return "html"
 	case ".css", ".scss", ".less":
 		return "css"
@@ -161,23 +518,127 @@ func (a *Analyzer) detectLanguage(path string) string {
 	}
 }
 
-// analyzeFile 개별 파일 분석
-func (a *Analyzer) analyzeFile(filePath string) ([]Issue, error) {
+// filterByConfidence 최소 신뢰도보다 낮은 이슈 제외
+func (a *Analyzer) filterByConfidence(issues []Issue) []Issue {
+	minConfidence := config.ParseConfidence(a.config.Analysis.MinConfidence)
+	if minConfidence == 0 {
+		return issues
+	}
+
+	filtered := issues[:0]
+	for _, issue := range issues {
+		if issue.Confidence >= minConfidence {
+			filtered = append(filtered, issue)
+		}
+	}
+	return filtered
+}
+
+// analyzeFile 개별 파일 분석 (대용량 파일은 경고 메시지를, 텍스트 분석으로 대체한 파일은 그 정보를 함께 반환)
+// lowMemory면 메모리 상한을 넘은 상태라 크기와 관계없이 라인 단위로 분석합니다
+func (a *Analyzer) analyzeFile(filePath string, info os.FileInfo, lowMemory bool) ([]Issue, string, *types.DegradedFile, error) {
 	language := a.detectLanguage(filePath)
-	
-	// 파일 파싱
-	parseResult, err := parser.ParseFile(filePath, language)
+
+	// 대용량 파일은 전체를 읽지 않고 라인 단위로 분석
+	largeFile := info.Size() > a.config.LargeFileThreshold()
+
+	issues, skipped, degraded, err := a.checkFile(filePath, language, largeFile || lowMemory, !lowMemory)
 	if err != nil {
-		return nil, fmt.Errorf("파일 파싱 실패: %w", err)
+		return nil, "", nil, err
 	}
 
-	// 규칙 엔진으로 검사
-	issues := a.ruleEngine.CheckFile(parseResult, language)
-
 	// 파일 경로를 상대 경로로 변환
 	for i := range issues {
 		issues[i].File = filePath
 	}
 
-	return issues, nil
+	var warning string
+	if largeFile {
+		warning = fmt.Sprintf("%s 파일이 너무 커서(%dMB) 라인 단위 규칙만 검사했습니다", filePath, info.Size()>>20)
+		if len(skipped) > 0 {
+			warning += fmt.Sprintf(" (건너뛴 규칙: %s)", strings.Join(skipped, ", "))
+		}
+	}
+
+	return issues, warning, degraded, nil
+}
+
+// checkFile 규칙 검사 실행 (캐시된 결과가 있으면 재사용, cacheable이 false면 결과를 캐시에 저장하지 않음)
+// 구조 파싱이 실패하거나 제한 시간을 넘으면 텍스트로 읽어 AST가 필요 없는 규칙만 검사하고 대체 정보를 반환합니다
+func (a *Analyzer) checkFile(filePath, language string, largeFile, cacheable bool) ([]Issue, []string, *types.DegradedFile, error) {
+	var cacheKey string
+	if a.cache != nil {
+		if key, err := a.cache.Key(filePath, language); err == nil {
+			cacheKey = key
+			if entry, ok := a.cache.Get(key); ok {
+				return entry.Issues, entry.Skipped, nil, nil
+			}
+		}
+	}
+
+	var issues []Issue
+	var skipped []string
+	var degraded *types.DegradedFile
+	if largeFile {
+		var err error
+		rulesStart := time.Now()
+		issues, skipped, err = a.ruleEngine.CheckLargeFile(filePath, language)
+		a.stageTime[types.StageRules] += time.Since(rulesStart)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("대용량 파일 분석 실패: %w", err)
+		}
+	} else {
+		// 파일 파싱
+		parseStart := time.Now()
+		parseResult, err := parser.ParseFileTimeout(filePath, language, a.config.ParseTimeout(language))
+		if err != nil {
+			fallback, textErr := parser.ParseText(filePath, language)
+			if textErr != nil {
+				return nil, nil, nil, fmt.Errorf("파일 파싱 실패: %w", err)
+			}
+			parseResult = fallback
+			degraded = &types.DegradedFile{File: filePath, Language: language, Reason: err.Error()}
+		}
+
+		a.stageTime[types.StageParse] += time.Since(parseStart)
+
+		for _, hook := range a.hooks {
+			hook.OnFileParsed(parseResult)
+		}
+
+		// 규칙 엔진으로 검사
+		rulesStart := time.Now()
+		issues = a.ruleEngine.CheckFile(parseResult, language)
+		a.stageTime[types.StageRules] += time.Since(rulesStart)
+	}
+
+	// 텍스트 분석으로 대체했거나 메모리 상한 때문에 라인 단위로 검사한 결과는 다음 실행에서 다시 분석하도록 캐시하지 않음
+	if cacheKey != "" && cacheable && degraded == nil {
+		a.cache.Put(cacheKey, &cache.Entry{Issues: issues, Skipped: skipped})
+	}
+
+	return issues, skipped, degraded, nil
+}
+
+// SetPrevious 비교할 이전 분석 결과 지정 (지난 실행 대비 변화, 방치된 이슈 심각도 상향에 사용)
+func (a *Analyzer) SetPrevious(previous *AnalysisResult) {
+	a.previous = previous
+}
+
+// SetPersistence 실행 기록(--history)에서 조회한 이슈의 연속 발견 정보 지정 (지정하면 이전 결과 대신 처음 발견 시각과 연속 발견 횟수에 사용)
+func (a *Analyzer) SetPersistence(persistence map[string]types.Persistence) {
+	a.persistence = persistence
+}
+
+// SymbolIndex 분석 중 갱신한 심볼 색인 (색인을 사용하지 않으면 nil)
+func (a *Analyzer) SymbolIndex() *symbols.Index {
+	return a.symbols
+}
+
+// CacheStats 캐시 사용 통계 (캐시를 사용하지 않으면 nil)
+func (a *Analyzer) CacheStats() *cache.Stats {
+	if a.cache == nil {
+		return nil
+	}
+	return &a.cache.Stats
 }
\ No newline at end of file
@@ -0,0 +1,104 @@
+package analyzer
+
+import (
+	"fmt"
+	"regexp"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/symbols"
+)
+
+// xssCorrelationRuleID 템플릿과 JS를 함께 본 XSS 결합 이슈 규칙 ID
+const xssCorrelationRuleID = "xss-template-correlation"
+
+// innerHTMLRuleID 결합 대상이 되는 JS innerHTML 규칙
+const innerHTMLRuleID = "js-innerHTML-xss"
+
+// JS에서 innerHTML을 쓰는 대상 요소 id
+var jsTargetIDRegexes = []*regexp.Regexp{
+	regexp.MustCompile(`getElementById\(\s*["']([\w-]+)["']\s*\)`),
+	regexp.MustCompile(`querySelector\(\s*["']#([\w-]+)["']\s*\)`),
+	regexp.MustCompile(`\$\(\s*["']#([\w-]+)["']\s*\)`),
+}
+
+// templateElement 템플릿에서 사용자 데이터로 렌더링되는 요소 위치
+type templateElement struct {
+	file string
+	line int
+}
+
+// projectIndex 파일 간 상관 분석을 위한 프로젝트 색인
+type projectIndex struct {
+	userDataElements map[string]templateElement // 요소 id -> 처음 발견된 위치
+}
+
+func newProjectIndex() *projectIndex {
+	return &projectIndex{userDataElements: make(map[string]templateElement)}
+}
+
+// addTemplate HTML 템플릿에서 같은 라인에 데이터 표현식이 있는 요소 id 수집
+func (idx *projectIndex) addTemplate(filePath string) error {
+	return parser.ScanLines(filePath, func(lineNum int, line string) {
+		for _, id := range symbols.UserDataIDs(line) {
+			if _, exists := idx.userDataElements[id]; !exists {
+				idx.userDataElements[id] = templateElement{file: filePath, line: lineNum}
+			}
+		}
+	})
+}
+
+// projectIndexFromSymbols 심볼 색인에 저장된 사용자 데이터 요소로 색인 구성 (템플릿을 다시 읽지 않음)
+func projectIndexFromSymbols(symbolIndex *symbols.Index) *projectIndex {
+	idx := newProjectIndex()
+	for _, symbol := range symbolIndex.Symbols(symbols.KindID) {
+		if symbol.Detail != symbols.DetailUserData {
+			continue
+		}
+		if _, exists := idx.userDataElements[symbol.Name]; !exists {
+			idx.userDataElements[symbol.Name] = templateElement{file: symbol.File, line: symbol.Line}
+		}
+	}
+	return idx
+}
+
+// correlateXSS innerHTML 쓰기 대상이 템플릿에서 사용자 데이터로 렌더링되는 요소이면 결합 이슈로 대체
+func correlateXSS(issues []Issue, index *projectIndex) []Issue {
+	if len(index.userDataElements) == 0 {
+		return issues
+	}
+
+	for i, issue := range issues {
+		if issue.RuleID != innerHTMLRuleID {
+			continue
+		}
+		id, element, ok := index.lookupTarget(issue.CodeSnippet)
+		if !ok {
+			continue
+		}
+
+		combined := issue
+		combined.RuleID = xssCorrelationRuleID
+		combined.Severity = config.SeverityCritical
+		combined.Confidence = config.ConfidenceHigh
+		combined.Message = i18n.T("xss-correlation.message", id, element.file, element.line)
+		combined.Description = i18n.T("xss-correlation.description")
+		combined.Suggestion = i18n.T("xss-correlation.suggestion")
+		combined.Params = map[string]string{"element": id, "template": fmt.Sprintf("%s:%d", element.file, element.line)}
+		issues[i] = combined
+	}
+	return issues
+}
+
+// lookupTarget 코드에서 innerHTML 대상 요소 id를 찾아 템플릿 위치 반환
+func (idx *projectIndex) lookupTarget(code string) (string, templateElement, bool) {
+	for _, regex := range jsTargetIDRegexes {
+		if match := regex.FindStringSubmatch(code); match != nil {
+			if element, ok := idx.userDataElements[match[1]]; ok {
+				return match[1], element, true
+			}
+		}
+	}
+	return "", templateElement{}, false
+}
@@ -0,0 +1,48 @@
+package analyzer
+
+import (
+	"time"
+
+	"code-quality-checker/internal/config"
+)
+
+// escalateIssues 카테고리별 기준보다 오래 방치된 이슈의 심각도 상향 (TrackPersistence 이후 호출)
+func escalateIssues(escalation map[string]config.EscalationConfig, issues []Issue, now time.Time) {
+	if len(escalation) == 0 {
+		return
+	}
+
+	for i := range issues {
+		issue := &issues[i]
+		rule, ok := escalation[issue.Category]
+		if !ok || !escalationDue(rule, issue, now) {
+			continue
+		}
+
+		target := issue.Severity + 1
+		if rule.To != "" {
+			target = config.ParseSeverity(rule.To)
+		}
+		if target > config.SeverityCritical {
+			target = config.SeverityCritical
+		}
+		if target <= issue.Severity {
+			continue
+		}
+
+		original := issue.Severity
+		issue.EscalatedFrom = &original
+		issue.Severity = target
+	}
+}
+
+// escalationDue 연속 발견 횟수나 방치 기간이 상향 기준에 도달했는지 확인
+func escalationDue(rule config.EscalationConfig, issue *Issue, now time.Time) bool {
+	if rule.AfterRuns > 0 && issue.Runs >= rule.AfterRuns {
+		return true
+	}
+	if rule.AfterDays > 0 && issue.FirstSeen != nil {
+		return now.Sub(*issue.FirstSeen) >= time.Duration(rule.AfterDays)*24*time.Hour
+	}
+	return false
+}
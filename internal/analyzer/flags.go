@@ -0,0 +1,73 @@
+package analyzer
+
+import (
+	"sort"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/rules"
+	"code-quality-checker/internal/types"
+)
+
+// featureFlagIndex 프로젝트 전체의 기능 플래그 사용 현황 (오래된 플래그 정리용 목록)
+type featureFlagIndex struct {
+	scanners map[string]*rules.FeatureFlagScanner // 언어 설정 섹션 -> 기능 플래그 규칙 설정으로 만든 스캐너
+	flags    map[string]*types.FeatureFlag
+}
+
+// newFeatureFlagIndex 기능 플래그 규칙이 켜진 언어가 없으면 nil 반환
+func newFeatureFlagIndex(cfg *config.Config) *featureFlagIndex {
+	idx := &featureFlagIndex{
+		scanners: make(map[string]*rules.FeatureFlagScanner),
+		flags:    make(map[string]*types.FeatureFlag),
+	}
+	for language, ruleID := range rules.FeatureFlagRuleIDs {
+		for _, rule := range cfg.GetRulesForLanguage(language) {
+			if rule.ID == ruleID {
+				idx.scanners[language] = rules.NewFeatureFlagScanner(rule)
+			}
+		}
+	}
+	if len(idx.scanners) == 0 {
+		return nil
+	}
+	return idx
+}
+
+// add 파일의 기능 플래그 사용 위치 수집
+func (idx *featureFlagIndex) add(filePath, language string) error {
+	if language == "typescript" {
+		language = "javascript"
+	}
+	scanner, ok := idx.scanners[language]
+	if !ok {
+		return nil
+	}
+
+	return parser.ScanLines(filePath, func(lineNum int, line string) {
+		for _, usage := range scanner.ScanLine(line) {
+			flag, exists := idx.flags[usage.Name]
+			if !exists {
+				flag = &types.FeatureFlag{Name: usage.Name}
+				idx.flags[usage.Name] = flag
+			}
+			flag.Usages++
+			if usage.Literal {
+				flag.LiteralUsages++
+			}
+			if len(flag.Files) == 0 || flag.Files[len(flag.Files)-1] != filePath {
+				flag.Files = append(flag.Files, filePath)
+			}
+		}
+	})
+}
+
+// list 플래그 이름 순으로 정렬한 목록
+func (idx *featureFlagIndex) list() []types.FeatureFlag {
+	flags := make([]types.FeatureFlag, 0, len(idx.flags))
+	for _, flag := range idx.flags {
+		flags = append(flags, *flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Name < flags[j].Name })
+	return flags
+}
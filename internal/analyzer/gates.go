@@ -0,0 +1,80 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// evaluateGates 카테고리별 품질 게이트 평가 (카테고리 이름순, Advisory 이슈 제외)
+func evaluateGates(gates map[string]config.GateConfig, issues []Issue) []types.GateResult {
+	return gateResults(gates, countGateIssues(gates, issues, nil))
+}
+
+// countGateIssues 게이트별로 집계 대상 이슈 수를 counts에 더함 (counts가 nil이면 새로 만듦)
+func countGateIssues(gates map[string]config.GateConfig, issues []Issue, counts map[string]int) map[string]int {
+	if counts == nil {
+		counts = make(map[string]int)
+	}
+	for category, gate := range gates {
+		minSeverity := config.ParseSeverity(gate.MinSeverity)
+		for _, issue := range issues {
+			if issue.Category == category && issue.Severity >= minSeverity && !issue.Advisory {
+				counts[category]++
+			}
+		}
+	}
+	return counts
+}
+
+// gateResults 게이트별 이슈 수로 평가 결과 구성 (카테고리 이름순)
+func gateResults(gates map[string]config.GateConfig, counts map[string]int) []types.GateResult {
+	categories := make([]string, 0, len(gates))
+	for category := range gates {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+
+	var results []types.GateResult
+	for _, category := range categories {
+		gate := gates[category]
+		minSeverity := config.ParseSeverity(gate.MinSeverity)
+		count := counts[category]
+
+		results = append(results, types.GateResult{
+			Category:    category,
+			Count:       count,
+			Max:         gate.Max,
+			MinSeverity: minSeverity,
+			Passed:      count <= gate.Max,
+		})
+	}
+	return results
+}
+
+// markAdvisory 실험 규칙의 이슈를 종료 코드와 품질 게이트에서 제외 (analysis.gate_experimental이면 반영)
+func markAdvisory(issues []Issue, gateExperimental bool) {
+	if gateExperimental {
+		return
+	}
+	for i := range issues {
+		if issues[i].Maturity == config.MaturityExperimental {
+			issues[i].Advisory = true
+		}
+	}
+}
+
+// deprecatedRuleWarnings 켜져 있는 폐기 예정 규칙 경고
+func deprecatedRuleWarnings(cfg *config.Config) []string {
+	var warnings []string
+	for _, langRules := range cfg.Languages {
+		for _, rule := range langRules.Rules {
+			if rule.Enabled && rule.Maturity == config.MaturityDeprecated {
+				warnings = append(warnings, fmt.Sprintf("규칙 %s은(는) 폐기 예정입니다. 설정에서 끄거나 대체 규칙으로 옮기세요", rule.ID))
+			}
+		}
+	}
+	return warnings
+}
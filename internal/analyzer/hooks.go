@@ -0,0 +1,90 @@
+package analyzer
+
+import (
+	"sync"
+
+	"code-quality-checker/internal/parser"
+)
+
+// Hook 분석 수명 주기 훅
+// 외부 연동이나 플러그인이 분석기 코어를 수정하지 않고 이슈 필터링, 보강(티켓 조회 등), 라우팅을 할 수 있습니다
+type Hook interface {
+	// OnFileParsed 파일 파싱 직후 호출 (캐시 적중이나 대용량 파일처럼 파싱하지 않은 파일은 호출되지 않음)
+	OnFileParsed(file *parser.ParsedFile)
+	// OnIssue 이슈마다 호출, 이슈를 수정할 수 있으며 false를 반환하면 결과에서 제외
+	OnIssue(issue *Issue) bool
+	// OnComplete 요약과 품질 게이트 계산이 끝난 뒤 결과를 반환하기 직전에 호출
+	OnComplete(result *AnalysisResult)
+}
+
+// HookFuncs 필요한 단계만 함수로 지정하는 Hook 구현 (nil인 단계는 건너뜀)
+type HookFuncs struct {
+	FileParsed func(file *parser.ParsedFile)
+	Issue      func(issue *Issue) bool
+	Complete   func(result *AnalysisResult)
+}
+
+func (h HookFuncs) OnFileParsed(file *parser.ParsedFile) {
+	if h.FileParsed != nil {
+		h.FileParsed(file)
+	}
+}
+
+func (h HookFuncs) OnIssue(issue *Issue) bool {
+	if h.Issue != nil {
+		return h.Issue(issue)
+	}
+	return true
+}
+
+func (h HookFuncs) OnComplete(result *AnalysisResult) {
+	if h.Complete != nil {
+		h.Complete(result)
+	}
+}
+
+var (
+	globalHooksMu sync.Mutex
+	globalHooks   []Hook
+)
+
+// RegisterHook 이후 생성되는 모든 분석기에 적용할 훅 등록 (플러그인 패키지의 init에서 호출)
+func RegisterHook(hook Hook) {
+	globalHooksMu.Lock()
+	defer globalHooksMu.Unlock()
+	globalHooks = append(globalHooks, hook)
+}
+
+// registeredHooks 전역 등록된 훅 복사본
+func registeredHooks() []Hook {
+	globalHooksMu.Lock()
+	defer globalHooksMu.Unlock()
+	return append([]Hook(nil), globalHooks...)
+}
+
+// AddHook 이 분석기에만 적용할 훅 추가 (등록 순서대로 호출)
+func (a *Analyzer) AddHook(hook Hook) {
+	a.hooks = append(a.hooks, hook)
+}
+
+// applyIssueHooks OnIssue 훅 적용 (하나라도 false를 반환하면 이슈 제외)
+func (a *Analyzer) applyIssueHooks(issues []Issue) []Issue {
+	if len(a.hooks) == 0 {
+		return issues
+	}
+
+	kept := issues[:0]
+	for i := range issues {
+		keep := true
+		for _, hook := range a.hooks {
+			if !hook.OnIssue(&issues[i]) {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			kept = append(kept, issues[i])
+		}
+	}
+	return kept
+}
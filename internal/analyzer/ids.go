@@ -0,0 +1,44 @@
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+
+	"code-quality-checker/internal/types"
+)
+
+// assignIssueIDs 이슈마다 식별자와 고유 ID 기록 (ID는 식별자-순번)
+// 같은 식별자의 이슈(같은 코드가 반복된 경우)는 파일 안 위치 순으로 순번을 매기므로
+// 파일 수집이나 규칙 실행 순서가 달라져도 같은 이슈는 같은 ID를 받습니다
+// 억제된 이슈도 함께 순번을 매겨 억제 여부가 바뀌어도 다른 이슈의 ID가 밀리지 않습니다 (만료된 억제는 Issues 쪽 이슈가 ID를 가짐)
+func assignIssueIDs(issues []Issue, suppressed []types.SuppressedIssue) {
+	targets := make([]*Issue, 0, len(issues)+len(suppressed))
+	for i := range issues {
+		targets = append(targets, &issues[i])
+	}
+	for i := range suppressed {
+		if !suppressed[i].Expired {
+			targets = append(targets, &suppressed[i].Issue)
+		}
+	}
+
+	for _, issue := range targets {
+		issue.Fingerprint = types.Fingerprint(*issue)
+	}
+	sort.SliceStable(targets, func(i, j int) bool {
+		a, b := targets[i], targets[j]
+		if a.Fingerprint != b.Fingerprint {
+			return a.Fingerprint < b.Fingerprint
+		}
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+
+	occurrence := make(map[string]int)
+	for _, issue := range targets {
+		occurrence[issue.Fingerprint]++
+		issue.ID = fmt.Sprintf("%s-%d", issue.Fingerprint, occurrence[issue.Fingerprint])
+	}
+}
@@ -0,0 +1,110 @@
+package analyzer
+
+import (
+	"sort"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+)
+
+// IssueCapRuleID 파일당 이슈 상한 초과 시 생성되는 표시 이슈의 규칙 ID
+const IssueCapRuleID = "cqc-issue-cap"
+
+// capIssues 신뢰도 필터, 억제, 베이스라인, 트리아지를 거친 이슈에 규칙별/파일별 상한 적용
+// 초과분은 파일마다 표시 이슈로 대체하며, 표시 이슈는 낮은 심각도로 종료 코드와 품질 게이트에 반영하지 않습니다
+func (a *Analyzer) capIssues(issues []Issue) []Issue {
+	perRule, perFile := a.config.Analysis.MaxIssuesPerRule, a.config.Analysis.MaxIssuesPerFile
+	if perRule <= 0 && perFile <= 0 {
+		return issues
+	}
+
+	var files []string
+	byFile := make(map[string][]Issue)
+	for _, issue := range issues {
+		if _, ok := byFile[issue.File]; !ok {
+			files = append(files, issue.File)
+		}
+		byFile[issue.File] = append(byFile[issue.File], issue)
+	}
+
+	capped := make([]Issue, 0, len(issues))
+	var markers []Issue
+	for _, file := range files {
+		kept, ruleMarkers := capRuleIssues(byFile[file], perRule)
+		kept, fileMarker := capFileIssues(file, kept, perFile)
+		capped = append(capped, kept...)
+		markers = append(markers, ruleMarkers...)
+		if fileMarker != nil {
+			markers = append(markers, *fileMarker)
+		}
+	}
+
+	// 표시 이슈도 결과 파일의 다른 이슈처럼 식별자와 ID를 가짐
+	assignIssueIDs(markers, nil)
+	return append(capped, markers...)
+}
+
+// capRuleIssues 한 파일에서 규칙마다 보고하는 이슈 수 제한 (보고 순서 유지)
+// 남긴 이슈와, 상한을 넘은 규칙마다 하나씩 만든 표시 이슈를 반환합니다
+func capRuleIssues(issues []Issue, limit int) ([]Issue, []Issue) {
+	if limit <= 0 {
+		return issues, nil
+	}
+
+	counts := make(map[string]int)
+	firstOmitted := make(map[string]Issue)
+	var omitted []string
+	kept := make([]Issue, 0, len(issues))
+	for _, issue := range issues {
+		counts[issue.RuleID]++
+		switch count := counts[issue.RuleID]; {
+		case count <= limit:
+			kept = append(kept, issue)
+		case count == limit+1:
+			omitted = append(omitted, issue.RuleID)
+			firstOmitted[issue.RuleID] = issue
+		}
+	}
+
+	var markers []Issue
+	for _, ruleID := range omitted {
+		first := firstOmitted[ruleID]
+		markers = append(markers, Issue{
+			RuleID:      ruleID,
+			File:        first.File,
+			Line:        first.Line,
+			Severity:    config.SeverityLow,
+			Confidence:  config.ConfidenceHigh,
+			Category:    first.Category,
+			Message:     i18n.T("limit.rule.message", ruleID, counts[ruleID]-limit),
+			Description: first.Description,
+			Suggestion:  i18n.T("limit.rule.suggestion"),
+			Advisory:    true,
+		})
+	}
+	return kept, markers
+}
+
+// capFileIssues 한 파일의 전체 이슈 수 제한 (심각도가 높은 이슈를 우선 유지, 초과하지 않으면 표시 이슈는 nil)
+func capFileIssues(filePath string, issues []Issue, limit int) ([]Issue, *Issue) {
+	if limit <= 0 || len(issues) <= limit {
+		return issues, nil
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Severity > issues[j].Severity
+	})
+
+	marker := &Issue{
+		RuleID:     IssueCapRuleID,
+		File:       filePath,
+		Line:       1,
+		Severity:   config.SeverityLow,
+		Confidence: config.ConfidenceHigh,
+		Category:   "maintainability",
+		Message:    i18n.T("limit.file.message", len(issues)-limit),
+		Suggestion: i18n.T("limit.file.suggestion"),
+		Advisory:   true,
+	}
+	return issues[:limit:limit], marker
+}
@@ -0,0 +1,76 @@
+package analyzer
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code-quality-checker/internal/config"
+)
+
+// commitEnvVars 커밋 SHA를 담는 CI 환경 변수 (GitHub Actions, GitLab CI, Jenkins 순)
+var commitEnvVars = []string{"GITHUB_SHA", "CI_COMMIT_SHA", "GIT_COMMIT"}
+
+// configuredCommit 설정값이나 CI 환경 변수로 지정된 커밋 (없으면 빈 문자열)
+func configuredCommit(repo config.RepositoryConfig) string {
+	if repo.Commit != "" {
+		return repo.Commit
+	}
+	for _, name := range commitEnvVars {
+		if commit := os.Getenv(name); commit != "" {
+			return commit
+		}
+	}
+	return ""
+}
+
+// resolveCommit 링크에 사용할 커밋 (설정값, CI 환경 변수, HEAD 순)
+func resolveCommit(repo config.RepositoryConfig) string {
+	if commit := configuredCommit(repo); commit != "" {
+		return commit
+	}
+	return "HEAD"
+}
+
+// linkIssues 이슈마다 코드 호스팅의 해당 라인 링크 기록
+// 저장소 루트 밖의 파일은 링크를 만들지 않습니다
+func linkIssues(issues []Issue, repo config.RepositoryConfig) {
+	if repo.URLTemplate == "" {
+		return
+	}
+
+	root := repo.Root
+	if root == "" {
+		root = "."
+	}
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return
+	}
+	commit := resolveCommit(repo)
+
+	for i := range issues {
+		path, err := filepath.Abs(issues[i].File)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			continue
+		}
+
+		// 경로 구간별로 인코딩 (공백, # 등이 URL을 깨뜨리지 않도록)
+		segments := strings.Split(filepath.ToSlash(rel), "/")
+		for j, segment := range segments {
+			segments[j] = url.PathEscape(segment)
+		}
+
+		issues[i].URL = strings.NewReplacer(
+			"{commit}", commit,
+			"{path}", strings.Join(segments, "/"),
+			"{line}", strconv.Itoa(issues[i].Line),
+		).Replace(repo.URLTemplate)
+	}
+}
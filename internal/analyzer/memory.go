@@ -0,0 +1,42 @@
+package analyzer
+
+import (
+	"runtime"
+	"runtime/debug"
+)
+
+// memoryMonitor 분석 중 메모리 사용량 추적과 소프트 상한 관리
+// 파일마다 사용량을 확인하고, GC 후에도 힙 사용량이 상한을 넘으면 이후 파일은 대용량 파일처럼 라인 단위로 분석합니다.
+type memoryMonitor struct {
+	limit    uint64 // 소프트 상한 (바이트, 0이면 제한 없음)
+	peak     uint64 // 운영체제에서 확보한 메모리의 최대값 (RSS 근사치)
+	exceeded bool   // 상한을 넘어 라인 단위 분석으로 전환했는지
+}
+
+func newMemoryMonitor(limitMB int) *memoryMonitor {
+	m := &memoryMonitor{}
+	if limitMB > 0 {
+		m.limit = uint64(limitMB) << 20
+		// 상한에 가까워지면 GC가 더 자주 회수하도록 런타임 메모리 상한도 함께 지정
+		debug.SetMemoryLimit(int64(m.limit))
+	}
+	m.sample()
+	return m
+}
+
+// sample 현재 사용량으로 최대 사용량을 갱신하고 상한 초과 여부 확인 (한 번 넘으면 분석이 끝날 때까지 유지)
+func (m *memoryMonitor) sample() {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	if used := stats.Sys - stats.HeapReleased; used > m.peak {
+		m.peak = used
+	}
+
+	if m.limit == 0 || m.exceeded || stats.HeapAlloc <= m.limit {
+		return
+	}
+	// 아직 회수하지 않은 메모리일 수 있으므로 GC 후에도 넘을 때만 전환
+	runtime.GC()
+	runtime.ReadMemStats(&stats)
+	m.exceeded = stats.HeapAlloc > m.limit
+}
@@ -0,0 +1,45 @@
+package analyzer
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"code-quality-checker/internal/types"
+)
+
+// SetVersion 결과 메타데이터에 기록할 도구 버전 설정
+func (a *Analyzer) SetVersion(version string) {
+	a.version = version
+}
+
+// buildMetadata 리포트를 만든 도구 버전, 규칙 세트 해시, 분석한 트리의 커밋
+func (a *Analyzer) buildMetadata(targetPath string) *types.Metadata {
+	version := a.version
+	if version == "" {
+		version = "dev"
+	}
+	commit := configuredCommit(a.config.Repository)
+	if commit == "" {
+		commit = gitHead(targetPath)
+	}
+	return &types.Metadata{
+		ToolVersion: version,
+		RulesetHash: a.config.RulesetHash(),
+		Commit:      commit,
+	}
+}
+
+// gitHead 분석 경로가 속한 git 저장소의 HEAD 커밋 (git 저장소가 아니거나 git이 없으면 빈 문자열)
+func gitHead(targetPath string) string {
+	dir := targetPath
+	if info, err := os.Stat(targetPath); err == nil && !info.IsDir() {
+		dir = filepath.Dir(targetPath)
+	}
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "HEAD").Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(out))
+}
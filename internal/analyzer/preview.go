@@ -0,0 +1,78 @@
+package analyzer
+
+import (
+	"sort"
+
+	"code-quality-checker/internal/types"
+)
+
+// applyPreview 규칙별로 대표 이슈만 남기고 규칙별 전체 이슈 수 기록 (처음 도입하는 팀이 한 번에 검토할 요약용)
+// 대표 이슈는 심각도가 높은 순으로, 가능하면 서로 다른 파일에서 고릅니다
+func applyPreview(result *types.AnalysisResult, perRule int) {
+	var order []string
+	byRule := make(map[string][]types.Issue)
+	for _, issue := range result.Issues {
+		if _, ok := byRule[issue.RuleID]; !ok {
+			order = append(order, issue.RuleID)
+		}
+		byRule[issue.RuleID] = append(byRule[issue.RuleID], issue)
+	}
+
+	preview := &types.Preview{PerRule: perRule}
+	var kept []types.Issue
+	for _, ruleID := range order {
+		issues := byRule[ruleID]
+		sort.SliceStable(issues, func(i, j int) bool { return issues[i].Severity > issues[j].Severity })
+
+		examples := representativeIssues(issues, perRule)
+		files := make(map[string]bool)
+		for _, issue := range issues {
+			files[issue.File] = true
+		}
+		preview.Rules = append(preview.Rules, types.PreviewRule{
+			RuleID:   ruleID,
+			Category: issues[0].Category,
+			Severity: issues[0].Severity,
+			Total:    len(issues),
+			Files:    len(files),
+			Shown:    len(examples),
+		})
+		kept = append(kept, examples...)
+	}
+
+	sort.SliceStable(preview.Rules, func(i, j int) bool { return preview.Rules[i].Total > preview.Rules[j].Total })
+	result.Issues = kept
+	result.Preview = preview
+}
+
+// representativeIssues 서로 다른 파일의 이슈를 먼저 고르고 모자라면 나머지로 채움 (issues의 순서 유지)
+func representativeIssues(issues []types.Issue, limit int) []types.Issue {
+	if len(issues) <= limit {
+		return issues
+	}
+
+	picked := make([]bool, len(issues))
+	files := make(map[string]bool)
+	count := 0
+	for i, issue := range issues {
+		if count < limit && !files[issue.File] {
+			files[issue.File] = true
+			picked[i] = true
+			count++
+		}
+	}
+	for i := range issues {
+		if count < limit && !picked[i] {
+			picked[i] = true
+			count++
+		}
+	}
+
+	var examples []types.Issue
+	for i, issue := range issues {
+		if picked[i] {
+			examples = append(examples, issue)
+		}
+	}
+	return examples
+}
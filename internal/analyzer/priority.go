@@ -0,0 +1,109 @@
+package analyzer
+
+import (
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// priorityBase 심각도별 우선순위 기본 점수 (가중치를 모두 곱해도 100을 넘지 않도록 잡은 값)
+var priorityBase = map[config.Severity]float64{
+	config.SeverityCritical: 40,
+	config.SeverityHigh:     25,
+	config.SeverityMedium:   12,
+	config.SeverityLow:      5,
+}
+
+// confidenceFactor 신뢰도별 우선순위 배율 (휴리스틱이 약할수록 낮춤)
+var confidenceFactor = map[config.Confidence]float64{
+	config.ConfidenceHigh:   1.0,
+	config.ConfidenceMedium: 0.8,
+	config.ConfidenceLow:    0.6,
+}
+
+// churnSaturation 이 횟수 이상 변경된 파일은 변경 빈도 가중치가 최대 (1.5배)
+const churnSaturation = 10
+
+// prioritize 이슈마다 우선순위 점수와 예상 수정 시간 기록
+func (a *Analyzer) prioritize(targetPath string, issues []types.Issue) {
+	if len(issues) == 0 {
+		return
+	}
+
+	a.scoreIssues(issues, a.loadChurn(targetPath))
+}
+
+// loadChurn 우선순위 계산에 쓸 파일별 변경 횟수 (priority.no_churn이면 nil)
+func (a *Analyzer) loadChurn(targetPath string) map[string]int {
+	if a.config.Priority.NoChurn {
+		return nil
+	}
+	return gitChurn(targetPath, a.config.Priority.ChurnWindow())
+}
+
+// scoreIssues 변경 횟수를 반영해 이슈마다 우선순위 점수와 예상 수정 시간 기록
+func (a *Analyzer) scoreIssues(issues []types.Issue, churn map[string]int) {
+	priority := a.config.Priority
+	for i := range issues {
+		issue := &issues[i]
+		issue.Priority = priorityScore(*issue, priority.CategoryWeight(issue.Category), churn[resolvePath(issue.File)])
+		issue.EffortMinutes = types.EstimateEffort(issue.Severity)
+	}
+}
+
+// priorityScore 심각도 기본 점수 x 신뢰도 x 카테고리 가중치 x 변경 빈도 가중치 (0~100)
+func priorityScore(issue types.Issue, categoryWeight float64, changes int) int {
+	confidence := 1.0
+	if factor, ok := confidenceFactor[issue.Confidence]; ok {
+		confidence = factor
+	}
+	churn := 1 + 0.5*math.Min(float64(changes), churnSaturation)/churnSaturation
+
+	score := priorityBase[issue.Severity] * confidence * categoryWeight * churn
+	return int(math.Min(math.Round(score), 100))
+}
+
+// gitChurn 최근 days일 동안 파일별 커밋 수 (키는 절대 경로, git 저장소가 아니거나 git이 없으면 nil)
+func gitChurn(targetPath string, days int) map[string]int {
+	dir := targetPath
+	if info, err := os.Stat(targetPath); err == nil && !info.IsDir() {
+		dir = filepath.Dir(targetPath)
+	}
+	out, err := exec.Command("git", "-C", dir, "rev-parse", "--show-toplevel").Output()
+	if err != nil {
+		return nil
+	}
+	root := strings.TrimSpace(string(out))
+
+	out, err = exec.Command("git", "-C", root, "log", "--since="+strconv.Itoa(days)+".days.ago",
+		"--name-only", "--format=", "--no-renames").Output()
+	if err != nil {
+		return nil
+	}
+
+	churn := make(map[string]int)
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			churn[filepath.Join(root, filepath.FromSlash(line))]++
+		}
+	}
+	return churn
+}
+
+// resolvePath git이 보고하는 경로와 비교할 수 있도록 절대 경로로 바꾸고 심볼릭 링크 해석
+func resolvePath(path string) string {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return path
+	}
+	if resolved, err := filepath.EvalSymlinks(abs); err == nil {
+		return resolved
+	}
+	return abs
+}
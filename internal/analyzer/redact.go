@@ -0,0 +1,83 @@
+package analyzer
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// 스니펫에서 가릴 값
+var (
+	// 문자열 리터럴 ("...", '...', `...`)
+	redactLiteralRegex = regexp.MustCompile("\"(?:[^\"\\\\]|\\\\.)*\"|'(?:[^'\\\\]|\\\\.)*'|`[^`]*`")
+	// 따옴표 없이 쓴 키/토큰처럼 보이는 긴 값 (속성 파일의 api.key=... 등, 숫자가 섞인 경우만 가림)
+	redactTokenRegex = regexp.MustCompile(`[A-Za-z0-9+/_\-]{16,}={0,2}`)
+)
+
+// redactedValue 가린 값 표시
+const redactedValue = "***"
+
+// redactSnippets 공유 리포트에 비밀 값이 복사되지 않도록 이슈의 코드 스니펫을 가림
+// 기본은 security 카테고리 이슈만 가리고, 규칙의 redact_snippet으로 규칙별로 켜거나 끌 수 있습니다
+// 식별자와 ID를 매기기 전에 호출하므로 가린 결과 파일을 다시 읽어 계산한 식별자(베이스라인, 이전 결과, cqc diff)와 같습니다
+func (a *Analyzer) redactSnippets(issues []Issue, suppressed []types.SuppressedIssue) {
+	redact := a.snippetRedactor()
+	if redact == nil {
+		return
+	}
+	for i := range issues {
+		redact(&issues[i])
+	}
+	for i := range suppressed {
+		redact(&suppressed[i].Issue)
+	}
+}
+
+// snippetRedactor 설정에 따라 이슈 하나의 스니펫을 가리는 함수 (가릴 설정이 없으면 nil)
+func (a *Analyzer) snippetRedactor() func(issue *Issue) {
+	mode := a.config.Analysis.RedactSnippets
+	overrides := make(map[string]bool)
+	for _, lang := range a.config.Languages {
+		for _, rule := range lang.Rules {
+			if rule.Redact != nil {
+				overrides[rule.ID] = *rule.Redact
+			}
+		}
+	}
+	if mode == "" && len(overrides) == 0 {
+		return nil
+	}
+
+	return func(issue *Issue) {
+		enabled, ok := overrides[issue.RuleID]
+		if !ok {
+			enabled = mode != "" && issue.Category == "security"
+		}
+		if !enabled || issue.CodeSnippet == "" {
+			return
+		}
+		issue.Redacted = true
+		if mode == config.RedactOmit {
+			issue.CodeSnippet = ""
+			return
+		}
+		issue.CodeSnippet = maskSnippet(issue.CodeSnippet)
+	}
+}
+
+// maskSnippet 문자열 리터럴 내용과 토큰처럼 보이는 값을 *** 로 바꿈 (코드 구조는 남김)
+func maskSnippet(snippet string) string {
+	snippet = redactLiteralRegex.ReplaceAllStringFunc(snippet, func(literal string) string {
+		quote := literal[:1]
+		return quote + redactedValue + quote
+	})
+	return redactTokenRegex.ReplaceAllStringFunc(snippet, func(token string) string {
+		// 긴 식별자(getElementById 등)는 남기고 숫자가 섞인 값만 가림
+		if strings.IndexAny(token, "0123456789") < 0 {
+			return token
+		}
+		return redactedValue
+	})
+}
@@ -0,0 +1,84 @@
+package analyzer
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"path/filepath"
+	"sort"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// sampleFiles 시드 기반으로 파일 일부를 선택 (같은 시드와 파일 목록이면 항상 같은 표본)
+func sampleFiles(files []string, ratio float64, maxFiles int, seed int64) []string {
+	count := len(files)
+	if ratio < 1 {
+		count = int(math.Ceil(float64(len(files)) * ratio))
+	}
+	if maxFiles > 0 && count > maxFiles {
+		count = maxFiles
+	}
+	if count >= len(files) {
+		return files
+	}
+
+	// 경로와 시드의 해시 순으로 정렬 (디렉토리 순서에 치우치지 않도록)
+	keys := make(map[string]uint64, len(files))
+	for _, file := range files {
+		keys[file] = sampleKey(file, seed)
+	}
+	shuffled := append([]string(nil), files...)
+	sort.Slice(shuffled, func(i, j int) bool {
+		if keys[shuffled[i]] != keys[shuffled[j]] {
+			return keys[shuffled[i]] < keys[shuffled[j]]
+		}
+		return shuffled[i] < shuffled[j]
+	})
+	selected := shuffled[:count]
+
+	// 분석 순서는 원래 파일 순서를 유지
+	chosen := make(map[string]bool, count)
+	for _, file := range selected {
+		chosen[file] = true
+	}
+	sampled := make([]string, 0, count)
+	for _, file := range files {
+		if chosen[file] {
+			sampled = append(sampled, file)
+		}
+	}
+	return sampled
+}
+
+// sampleKey 파일 경로와 시드의 해시
+func sampleKey(file string, seed int64) uint64 {
+	h := fnv.New64a()
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(seed))
+	h.Write(buf[:])
+	h.Write([]byte(filepath.ToSlash(file)))
+	return h.Sum64()
+}
+
+// estimateSampling 표본의 이슈 수를 전체 파일 수 기준으로 환산 (요약 계산 이후 호출)
+func estimateSampling(sampling *types.Sampling, summary Summary) {
+	if sampling.SampledFiles == 0 {
+		return
+	}
+	scale := float64(sampling.TotalFiles) / float64(sampling.SampledFiles)
+	estimate := func(count int) int {
+		return int(math.Round(float64(count) * scale))
+	}
+
+	sampling.EstimatedIssues = estimate(summary.TotalIssues)
+	sampling.EstimatedSeverity = make(map[config.Severity]int)
+	for severity, count := range summary.SeverityCount {
+		sampling.EstimatedSeverity[severity] = estimate(count)
+	}
+	sampling.EstimatedCategory = make(map[string]int)
+	for category, count := range summary.CategoryCount {
+		sampling.EstimatedCategory[category] = estimate(count)
+	}
+}
@@ -0,0 +1,26 @@
+package analyzer
+
+import (
+	"fmt"
+
+	"code-quality-checker/internal/parser"
+)
+
+// AnalyzeSource 디스크의 파일 대신 content를 filePath의 내용으로 분석 (에디터의 저장하지 않은 버퍼, cqc --stdin)
+// 언어 감지, 규칙의 파일 패턴, .editorconfig, 트리아지는 filePath 기준으로 적용하며 파일이 디스크에 없어도 됩니다
+// 저장하지 않은 내용이 남지 않도록 결과 캐시와 심볼 색인은 사용하지 않습니다
+func (a *Analyzer) AnalyzeSource(filePath, content string) (*AnalysisResult, error) {
+	if !a.isSupportedFile(filePath) {
+		return nil, fmt.Errorf("지원하지 않는 파일 형식입니다: %s", filePath)
+	}
+	parser.SetOverlay(filePath, content)
+	defer parser.ClearOverlay(filePath)
+
+	cache, index := a.cache, a.config.Analysis.Index
+	a.cache, a.config.Analysis.Index = nil, false
+	defer func() {
+		a.cache, a.config.Analysis.Index = cache, index
+	}()
+
+	return a.Analyze(filePath)
+}
@@ -0,0 +1,91 @@
+package analyzer
+
+import (
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// IssueStream 파일 분석이 끝날 때마다 이슈를 하나씩 받는 함수 (에러를 반환하면 분석 중단)
+type IssueStream func(issue Issue) error
+
+// SetStream 이슈를 결과에 모으지 않고 파일마다 stream으로 내보내도록 설정 (nil이면 스트리밍 안 함)
+// 결과에는 요약, 핫스팟, 품질 게이트, 억제된 이슈만 남습니다.
+// 전체 이슈 목록이 있어야 하는 파일 간 XSS 결합, 이전 결과 비교, 미리보기는 적용하지 않습니다
+func (a *Analyzer) SetStream(stream IssueStream) {
+	a.stream = stream
+}
+
+// issueStream 스트리밍 분석 상태 (파일마다 이슈를 마무리해 내보내고 집계만 누적)
+type issueStream struct {
+	analyzer    *Analyzer
+	send        IssueStream
+	targetPath  string
+	triage      map[string]types.Triage
+	churn       map[string]int
+	churnLoaded bool
+	hotspots    types.HotspotTally
+	gates       map[string]int
+	stats       types.Stream
+}
+
+func (a *Analyzer) newIssueStream(targetPath string, triage map[string]types.Triage) *issueStream {
+	if a.stream == nil {
+		return nil
+	}
+	return &issueStream{
+		analyzer:   a,
+		send:       a.stream,
+		targetPath: targetPath,
+		triage:     triage,
+		gates:      make(map[string]int),
+	}
+}
+
+// emit 파일 하나의 이슈에 가림, ID, 트리아지, 링크, 우선순위를 적용해 내보내고 요약에 반영
+// 식별자에 파일 경로가 들어가므로 파일 단위로 매긴 ID는 전체를 모아 매긴 ID와 같습니다
+// 트리아지로 억제된 이슈를 더한 억제 목록을 반환합니다
+func (s *issueStream) emit(summary *Summary, issues []Issue, suppressed []types.SuppressedIssue) ([]types.SuppressedIssue, error) {
+	a := s.analyzer
+	a.redactSnippets(issues, suppressed)
+	assignIssueIDs(issues, suppressed)
+	issues, triaged := applyTriage(issues, s.triage)
+	suppressed = append(suppressed, triaged...)
+	issues = a.capIssues(issues)
+	linkIssues(issues, a.config.Repository)
+	if len(issues) == 0 {
+		return suppressed, nil
+	}
+
+	// 변경 횟수는 이슈가 처음 나왔을 때 한 번만 계산
+	if !s.churnLoaded {
+		s.churn = a.loadChurn(s.targetPath)
+		s.churnLoaded = true
+	}
+	a.scoreIssues(issues, s.churn)
+	markAdvisory(issues, a.config.Analysis.GateExperimental)
+	countGateIssues(a.config.Gates, issues, s.gates)
+
+	for _, issue := range issues {
+		summary.TotalIssues++
+		summary.SeverityCount[issue.Severity]++
+		summary.CategoryCount[issue.Category]++
+		s.hotspots.Add(issue)
+		s.stats.Issues++
+		if issue.Severity == config.SeverityCritical && !issue.Advisory {
+			s.stats.Critical++
+		}
+
+		if err := s.send(issue); err != nil {
+			return nil, err
+		}
+	}
+	return suppressed, nil
+}
+
+// finish 누적한 핫스팟, 게이트, 스트리밍 통계를 결과에 기록
+func (s *issueStream) finish(result *AnalysisResult) {
+	result.Summary.Hotspots = s.hotspots.Rank(types.HotspotCount)
+	result.Gates = gateResults(s.analyzer.config.Gates, s.gates)
+	stats := s.stats
+	result.Stream = &stats
+}
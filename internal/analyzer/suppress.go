@@ -0,0 +1,166 @@
+package analyzer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// suppressDirective 인라인 억제 주석 (cqc-disable [규칙ID,...] [until=YYYY-MM-DD])
+const suppressDirective = "cqc-disable"
+
+// suppression 인라인 억제 주석 하나 (주석이 있는 라인과 바로 다음 라인의 이슈에 적용)
+type suppression struct {
+	line    int
+	ruleIDs []string // 비어있으면 모든 규칙
+	until   *time.Time
+}
+
+// matches 이슈가 억제 대상인지 확인
+func (s suppression) matches(issue Issue) bool {
+	if issue.Line != s.line && issue.Line != s.line+1 {
+		return false
+	}
+	if len(s.ruleIDs) == 0 {
+		return true
+	}
+	for _, ruleID := range s.ruleIDs {
+		if ruleID == issue.RuleID {
+			return true
+		}
+	}
+	return false
+}
+
+// parseSuppressions 파일의 억제 주석 수집 (until 날짜가 잘못된 주석은 무시하고 경고 반환)
+func parseSuppressions(filePath string) ([]suppression, []string, error) {
+	var suppressions []suppression
+	var warnings []string
+
+	err := parser.ScanLines(filePath, func(lineNum int, line string) {
+		index := strings.Index(line, suppressDirective)
+		if index < 0 {
+			return
+		}
+		rest := line[index+len(suppressDirective):]
+		if rest != "" && rest[0] != ' ' && rest[0] != '\t' && !strings.HasPrefix(rest, "*/") && !strings.HasPrefix(rest, "-->") {
+			return // cqc-disabled 같은 다른 단어
+		}
+
+		// 블록 주석 닫는 기호 제거
+		for _, closer := range []string{"*/", "-->"} {
+			if end := strings.Index(rest, closer); end >= 0 {
+				rest = rest[:end]
+			}
+		}
+
+		s := suppression{line: lineNum}
+		for _, field := range strings.Fields(rest) {
+			if value, ok := strings.CutPrefix(field, "until="); ok {
+				until, err := time.ParseInLocation("2006-01-02", value, time.Local)
+				if err != nil {
+					warnings = append(warnings, fmt.Sprintf("%s:%d %s의 until 날짜 형식이 잘못되어 무시했습니다 (YYYY-MM-DD)", filePath, lineNum, suppressDirective))
+					return
+				}
+				s.until = &until
+				continue
+			}
+			for _, ruleID := range strings.Split(field, ",") {
+				if ruleID != "" {
+					s.ruleIDs = append(s.ruleIDs, ruleID)
+				}
+			}
+		}
+		suppressions = append(suppressions, s)
+	})
+	return suppressions, warnings, err
+}
+
+// suppressIssues 인라인 억제 주석과 베이스라인에 해당하는 이슈를 분리
+// 만료된 억제는 이슈를 그대로 보고하고 억제 목록에 만료로 기록합니다
+func (a *Analyzer) suppressIssues(filePath string, issues []Issue, now time.Time) ([]Issue, []types.SuppressedIssue, []string) {
+	if len(issues) == 0 {
+		return issues, nil, nil
+	}
+
+	suppressions, warnings, err := parseSuppressions(filePath)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("%s 억제 주석 확인 실패: %v", filePath, err))
+	}
+
+	// 베이스라인은 가린 스니펫으로 기록되었을 수 있으므로 가린 스니펫으로도 비교
+	var redact func(issue *Issue)
+	if a.baseline != nil {
+		redact = a.snippetRedactor()
+	}
+
+	var reported []Issue
+	var suppressed []types.SuppressedIssue
+	for _, issue := range issues {
+		entry, ok := a.findSuppression(suppressions, issue, redact)
+		if !ok {
+			reported = append(reported, issue)
+			continue
+		}
+
+		entry.Expired = entry.Until != nil && !now.Before(*entry.Until)
+		if entry.Expired {
+			reported = append(reported, issue)
+		}
+		suppressed = append(suppressed, entry)
+	}
+	return reported, suppressed, warnings
+}
+
+// loadBaseline 설정된 베이스라인 JSON 결과 로드
+func (a *Analyzer) loadBaseline() error {
+	a.baseline, a.baselineUntil = nil, nil
+	analysis := a.config.Analysis
+	if analysis.Baseline == "" {
+		return nil
+	}
+
+	baseline, err := types.LoadResult(analysis.Baseline)
+	if err != nil {
+		return fmt.Errorf("베이스라인 로드 실패: %w", err)
+	}
+	a.baseline = types.NewIssueSet(baseline.Issues)
+
+	if analysis.BaselineUntil != "" {
+		until, err := time.ParseInLocation("2006-01-02", analysis.BaselineUntil, time.Local)
+		if err != nil {
+			return fmt.Errorf("baseline_until 날짜 형식이 잘못되었습니다 (YYYY-MM-DD): %s", analysis.BaselineUntil)
+		}
+		a.baselineUntil = &until
+	}
+	return nil
+}
+
+// findSuppression 이슈에 적용되는 억제 찾기 (인라인 주석 우선)
+// redact가 있으면 베이스라인은 가린 스니펫 기준으로 먼저 찾고, 가리기 전에 만든 베이스라인을 위해 원래 스니펫으로도 찾습니다
+func (a *Analyzer) findSuppression(suppressions []suppression, issue Issue, redact func(issue *Issue)) (types.SuppressedIssue, bool) {
+	for _, s := range suppressions {
+		if s.matches(issue) {
+			return types.SuppressedIssue{Issue: issue, Source: types.SuppressionInline, Line: s.line, Until: s.until}, true
+		}
+	}
+	if a.baseline != nil && a.takeBaseline(issue, redact) {
+		return types.SuppressedIssue{Issue: issue, Source: types.SuppressionBaseline, Until: a.baselineUntil}, true
+	}
+	return types.SuppressedIssue{}, false
+}
+
+// takeBaseline 베이스라인에 같은 이슈가 남아 있으면 하나를 소비 (가린 스니펫 우선, 스니펫이 달라진 경우에만 원래 스니펫으로 다시 찾음)
+func (a *Analyzer) takeBaseline(issue Issue, redact func(issue *Issue)) bool {
+	if redact != nil {
+		redacted := issue
+		redact(&redacted)
+		if redacted.CodeSnippet != issue.CodeSnippet {
+			return a.baseline.Take(redacted) || a.baseline.Take(issue)
+		}
+	}
+	return a.baseline.Take(issue)
+}
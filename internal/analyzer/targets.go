@@ -0,0 +1,140 @@
+package analyzer
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// target 분석 대상 하나 (디렉터리/파일 경로 또는 glob 패턴)
+type target struct {
+	arg     string // 명령줄에 쓴 그대로의 인자 (오류 메시지용)
+	root    string // 탐색을 시작할 경로 (glob이면 와일드카드가 나오기 전까지의 디렉터리)
+	pattern string // glob이면 수집한 파일 경로와 맞춰 볼 패턴, 경로면 빈 문자열
+}
+
+// parseTargets 명령줄 분석 대상 해석
+// 존재하는 경로는 그대로 쓰고, 존재하지 않으면서 *, ?, [, {가 들어간 인자는 glob으로 해석합니다
+// glob은 현재 디렉터리(절대 경로면 루트) 기준 경로 전체와 맞춰 보며, * 는 한 구간 안에서만, ** 는 0개 이상의 디렉터리와 일치합니다
+// .cqcignore와 달리 앞에 **/ 를 붙이지 않으므로 src/*.js 는 src 바로 아래 파일만 가리킵니다
+func parseTargets(args []string) ([]target, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("분석할 경로를 지정하세요")
+	}
+
+	targets := make([]target, 0, len(args))
+	for _, arg := range args {
+		if _, err := os.Stat(arg); err == nil || !isGlob(arg) {
+			targets = append(targets, target{arg: arg, root: arg})
+			continue
+		}
+
+		glob := path.Clean(filepath.ToSlash(arg))
+		if !doublestar.ValidatePattern(glob) {
+			return nil, fmt.Errorf("잘못된 glob 패턴 %s", arg)
+		}
+		targets = append(targets, target{arg: arg, root: globRoot(glob), pattern: glob})
+	}
+	return targets, nil
+}
+
+// isGlob glob 메타 문자가 있는지
+func isGlob(arg string) bool {
+	return strings.ContainsAny(arg, "*?[{")
+}
+
+// globRoot glob에서 와일드카드가 들어간 첫 구간 앞까지의 디렉터리 (없으면 현재 디렉터리)
+func globRoot(glob string) string {
+	segments := strings.Split(glob, "/")
+	for i, segment := range segments {
+		if isGlob(segment) {
+			root := strings.Join(segments[:i], "/")
+			switch {
+			case root == "" && strings.HasPrefix(glob, "/"):
+				return "/"
+			case root == "":
+				return "."
+			}
+			return filepath.FromSlash(root)
+		}
+	}
+	return filepath.FromSlash(glob)
+}
+
+// matches glob 대상이면 파일 경로가 패턴과 일치하는지 (경로 대상은 항상 true)
+func (t target) matches(file string) bool {
+	if t.pattern == "" {
+		return true
+	}
+	// 패턴과 같은 형태로 맞춤 (./ 접두사 제거, 구분자는 /)
+	matched, err := doublestar.Match(t.pattern, path.Clean(filepath.ToSlash(file)))
+	return err == nil && matched
+}
+
+// targetBase 트리아지 파일, git 변경 이력, 메타데이터 커밋을 찾을 기준 경로
+// 대상이 하나면 그 경로를, 여러 개면 대상 경로들의 공통 상위 디렉터리를 사용합니다
+func targetBase(targets []target) string {
+	if len(targets) == 1 {
+		// 표준 입력으로 받은 파일은 디스크에 없을 수 있으므로 그 디렉터리 기준
+		if _, ok := parser.Overlay(targets[0].root); ok {
+			return filepath.Dir(targets[0].root)
+		}
+		return targets[0].root
+	}
+
+	base := ""
+	for i, t := range targets {
+		dir, err := filepath.Abs(t.root)
+		if err != nil {
+			return "."
+		}
+		if t.pattern == "" {
+			if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+				dir = filepath.Dir(dir)
+			}
+		}
+		if i == 0 {
+			base = dir
+			continue
+		}
+		for !within(base, dir) {
+			parent := filepath.Dir(base)
+			if parent == base {
+				break
+			}
+			base = parent
+		}
+	}
+	return base
+}
+
+// within path가 dir 자신이거나 그 아래에 있는지
+func within(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
+
+// pruneRoots 심볼 색인에서 분석하지 않은 파일을 지울 범위
+// 경로 대상 아래에서 이번에 보지 않은 파일만 지우고, 표본 분석이나 glob 대상은 일부만 보므로 사라진 파일만 지웁니다 ("" 범위)
+func pruneRoots(targets []target, sampling *types.Sampling) []string {
+	if sampling != nil {
+		return []string{""}
+	}
+	var roots []string
+	for _, t := range targets {
+		if t.pattern == "" {
+			roots = append(roots, t.root)
+		}
+	}
+	if len(roots) == 0 {
+		return []string{""}
+	}
+	return roots
+}
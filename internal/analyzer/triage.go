@@ -0,0 +1,94 @@
+package analyzer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code-quality-checker/internal/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// TriageFileName 분석 경로에서 자동으로 찾는 트리아지 파일명
+const TriageFileName = ".cqc-triage.yaml"
+
+// triageEntry 트리아지 파일의 이슈 항목 (fingerprint는 같은 코드가 반복된 이슈 전체, id는 그중 한 이슈)
+type triageEntry struct {
+	Fingerprint  string `yaml:"fingerprint,omitempty"`
+	ID           string `yaml:"id,omitempty"`
+	types.Triage `yaml:",inline"`
+}
+
+// triageFile .cqc-triage.yaml 구조
+type triageFile struct {
+	Issues []triageEntry `yaml:"issues"`
+}
+
+// loadTriage 트리아지 파일 로드 (지정하지 않았고 분석 경로에도 없으면 nil)
+func (a *Analyzer) loadTriage(targetPath string) (map[string]types.Triage, error) {
+	path := a.config.Analysis.TriageFile
+	if path == "" {
+		path = filepath.Join(targetPath, TriageFileName)
+		if info, err := os.Stat(targetPath); err == nil && !info.IsDir() {
+			path = filepath.Join(filepath.Dir(targetPath), TriageFileName)
+		}
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("트리아지 파일 읽기 실패: %w", err)
+	}
+	var file triageFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("트리아지 파일 파싱 실패: %w", err)
+	}
+
+	// ID(식별자-순번)와 식별자는 형식이 달라 한 맵에 함께 둠
+	triage := make(map[string]types.Triage, len(file.Issues))
+	for _, entry := range file.Issues {
+		key := entry.ID
+		if key == "" {
+			key = entry.Fingerprint
+		}
+		if key == "" {
+			return nil, fmt.Errorf("%s: fingerprint 또는 id가 없는 항목이 있습니다", path)
+		}
+		switch entry.State {
+		case "":
+			entry.State = types.TriageOpen
+		case types.TriageOpen, types.TriageAccepted, types.TriageFalsePositive, types.TriageWontFix:
+		default:
+			return nil, fmt.Errorf("%s: %s의 상태가 잘못되었습니다 (open/accepted/false-positive/wontfix): %s", path, key, entry.State)
+		}
+		triage[key] = entry.Triage
+	}
+	return triage, nil
+}
+
+// applyTriage 이슈에 트리아지 상태를 기록하고, 오탐/수정 안 함으로 표시된 이슈는 억제 목록으로 이동
+// ID로 지정한 항목이 식별자로 지정한 항목보다 우선합니다
+func applyTriage(issues []Issue, triage map[string]types.Triage) ([]Issue, []types.SuppressedIssue) {
+	var reported []Issue
+	var suppressed []types.SuppressedIssue
+	for _, issue := range issues {
+		entry, ok := triage[issue.ID]
+		if !ok {
+			entry, ok = triage[issue.Fingerprint]
+		}
+		if ok {
+			entry := entry
+			issue.Triage = &entry
+		}
+
+		if issue.Triage.Excluded() {
+			suppressed = append(suppressed, types.SuppressedIssue{Issue: issue, Source: types.SuppressionTriage})
+			continue
+		}
+		reported = append(reported, issue)
+	}
+	return reported, suppressed
+}
@@ -0,0 +1,229 @@
+package annotate
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/types"
+)
+
+// protectedBranches 주석을 삽입하지 않는 기본 브랜치
+var protectedBranches = []string{"main", "master"}
+
+// markerRegex 삽입한 주석 라인 (주석 기호를 뺀 본문이 CQC[규칙 ID]: 로 시작)
+var markerRegex = regexp.MustCompile(`^\s*(?://|/\*|<!--|<%--|#) CQC\[[\w.:-]+\]: .*$`)
+
+// commentStyle 파일 형식별 한 줄 주석 기호
+type commentStyle struct {
+	prefix string
+	suffix string
+}
+
+// commentStyles 확장자별 주석 기호 (주석이 없는 JSON 등은 주석을 삽입하지 않음)
+var commentStyles = map[string]commentStyle{
+	".java":       {"// ", ""},
+	".kt":         {"// ", ""},
+	".js":         {"// ", ""},
+	".jsx":        {"// ", ""},
+	".ts":         {"// ", ""},
+	".tsx":        {"// ", ""},
+	".gradle":     {"// ", ""},
+	".css":        {"/* ", " */"},
+	".scss":       {"/* ", " */"},
+	".html":       {"<!-- ", " -->"},
+	".htm":        {"<!-- ", " -->"},
+	".jsp":        {"<%-- ", " --%>"}, // HTML 주석은 응답에 그대로 나가므로 JSP 주석 사용
+	".jspf":       {"<%-- ", " --%>"},
+	".xml":        {"<!-- ", " -->"},
+	".properties": {"# ", ""},
+	".yml":        {"# ", ""},
+	".yaml":       {"# ", ""},
+}
+
+// Stats 주석 삽입/제거 결과
+type Stats struct {
+	Files    int // 변경한 파일 수
+	Comments int // 삽입하거나 제거한 주석 수
+	Skipped  int // 주석을 달 수 없는 형식이라 건너뛴 이슈 수
+}
+
+// CheckBranch 작업 디렉토리가 기본 브랜치이면 오류 (git 저장소가 아니면 통과)
+func CheckBranch(targetPath string) error {
+	dir := targetPath
+	if info, err := os.Stat(targetPath); err == nil && !info.IsDir() {
+		dir = filepath.Dir(targetPath)
+	}
+	// 커밋이 없는 새 저장소에서도 브랜치 이름을 알 수 있도록 symbolic-ref 사용 (detached HEAD면 실패)
+	out, err := exec.Command("git", "-C", dir, "symbolic-ref", "--short", "HEAD").Output()
+	if err != nil {
+		return nil
+	}
+	branch := strings.TrimSpace(string(out))
+	for _, protected := range protectedBranches {
+		if branch == protected {
+			return fmt.Errorf("기본 브랜치(%s)에서는 주석을 삽입하지 않습니다. 작업 브랜치를 만든 뒤 실행하세요", branch)
+		}
+	}
+	return nil
+}
+
+// Comment 이슈 하나를 나타내는 주석 본문 (권장사항이 있으면 권장사항, 없으면 메시지)
+func Comment(issue types.Issue) string {
+	text := issue.Suggestion
+	if text == "" {
+		text = issue.Message
+	}
+	// 주석이 중간에 끝나거나 여러 줄로 나뉘지 않도록 정리
+	text = strings.NewReplacer("\r", " ", "\n", " ", "*/", "* /", "-->", "-- >", "--%>", "-- %>").Replace(text)
+	return fmt.Sprintf("CQC[%s]: %s", issue.RuleID, strings.TrimSpace(text))
+}
+
+// Insert 이슈가 있는 라인 위에 주석 삽입 (이미 같은 주석이 있으면 건너뜀)
+func Insert(issues []types.Issue) (Stats, error) {
+	var stats Stats
+
+	byFile := make(map[string][]types.Issue)
+	for _, issue := range issues {
+		if _, ok := commentStyles[strings.ToLower(filepath.Ext(issue.File))]; !ok || issue.Line < 1 {
+			stats.Skipped++
+			continue
+		}
+		byFile[issue.File] = append(byFile[issue.File], issue)
+	}
+
+	files := make([]string, 0, len(byFile))
+	for file := range byFile {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	for _, file := range files {
+		count, err := insertFile(file, byFile[file])
+		if err != nil {
+			return stats, fmt.Errorf("%s 주석 삽입 실패: %w", file, err)
+		}
+		if count > 0 {
+			stats.Files++
+			stats.Comments += count
+		}
+	}
+	return stats, nil
+}
+
+// insertFile 파일 하나에 주석 삽입 (뒤쪽 라인부터 삽입해야 앞쪽 라인 번호가 유지됨)
+func insertFile(path string, issues []types.Issue) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	lines := strings.Split(string(data), "\n")
+	style := commentStyles[strings.ToLower(filepath.Ext(path))]
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Line > issues[j].Line
+	})
+
+	inserted := 0
+	for _, issue := range issues {
+		if issue.Line > len(lines) {
+			continue
+		}
+		target := lines[issue.Line-1]
+		indent := target[:len(target)-len(strings.TrimLeft(target, " \t"))]
+		comment := indent + style.prefix + Comment(issue) + style.suffix
+		if strings.HasSuffix(target, "\r") {
+			comment += "\r"
+		}
+
+		// 라인에 붙어 있는 주석 묶음에 같은 주석이 있으면 다시 달지 않음
+		// (파일 단위 이슈는 1번 라인으로 보고되므로 이미 삽입한 주석이 대상 라인 자리에 있을 수 있음)
+		if hasComment(lines, issue.Line-1, comment) {
+			continue
+		}
+
+		updated := append([]string{}, lines[:issue.Line-1]...)
+		updated = append(updated, comment)
+		lines = append(updated, lines[issue.Line-1:]...)
+		inserted++
+	}
+
+	if inserted == 0 {
+		return 0, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return inserted, os.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode())
+}
+
+// hasComment index 라인 위아래로 이어진 주석 라인 중 comment가 있는지
+func hasComment(lines []string, index int, comment string) bool {
+	for i := index - 1; i >= 0 && isMarker(lines[i]); i-- {
+		if lines[i] == comment {
+			return true
+		}
+	}
+	for i := index; i < len(lines) && isMarker(lines[i]); i++ {
+		if lines[i] == comment {
+			return true
+		}
+	}
+	return false
+}
+
+// isMarker 삽입한 주석 라인인지
+func isMarker(line string) bool {
+	return markerRegex.MatchString(strings.TrimRight(line, "\r"))
+}
+
+// Remove 파일에서 삽입한 주석 라인을 모두 제거
+func Remove(files []string) (Stats, error) {
+	var stats Stats
+	for _, file := range files {
+		if _, ok := commentStyles[strings.ToLower(filepath.Ext(file))]; !ok {
+			continue
+		}
+		count, err := removeFile(file)
+		if err != nil {
+			return stats, fmt.Errorf("%s 주석 제거 실패: %w", file, err)
+		}
+		if count > 0 {
+			stats.Files++
+			stats.Comments += count
+		}
+	}
+	return stats, nil
+}
+
+func removeFile(path string) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	lines := strings.Split(string(data), "\n")
+
+	kept := lines[:0]
+	removed := 0
+	for _, line := range lines {
+		if isMarker(line) {
+			removed++
+			continue
+		}
+		kept = append(kept, line)
+	}
+
+	if removed == 0 {
+		return 0, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return removed, os.WriteFile(path, []byte(strings.Join(kept, "\n")), info.Mode())
+}
@@ -0,0 +1,165 @@
+package badge
+
+import (
+	"fmt"
+	"html"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// 배지 종류
+const (
+	MetricGrade    = "grade"    // A~F 등급
+	MetricIssues   = "issues"   // 이슈 개수
+	MetricCritical = "critical" // critical 이슈 개수
+)
+
+// 배지 색상 (shields.io 기본 팔레트)
+const (
+	colorBrightGreen = "#4c1"
+	colorGreen       = "#97ca00"
+	colorYellow      = "#dfb317"
+	colorOrange      = "#fe7d37"
+	colorRed         = "#e05d44"
+)
+
+// severityWeights 등급 계산 시 심각도별 가중치
+var severityWeights = map[config.Severity]float64{
+	config.SeverityCritical: 10,
+	config.SeverityHigh:     5,
+	config.SeverityMedium:   2,
+	config.SeverityLow:      1,
+}
+
+// Grade 파일당 가중 이슈 점수로 등급 계산 (Critical 이슈가 있으면 최대 D)
+func Grade(summary types.Summary) string {
+	var points float64
+	for severity, count := range summary.SeverityCount {
+		points += severityWeights[severity] * float64(count)
+	}
+
+	files := summary.TotalFiles
+	if files == 0 {
+		files = 1
+	}
+	density := points / float64(files)
+
+	grade := "F"
+	switch {
+	case density <= 0.5:
+		grade = "A"
+	case density <= 1:
+		grade = "B"
+	case density <= 2:
+		grade = "C"
+	case density <= 4:
+		grade = "D"
+	}
+
+	if summary.SeverityCount[config.SeverityCritical] > 0 && grade < "D" {
+		grade = "D"
+	}
+	return grade
+}
+
+// gradeColors 등급별 배지 색상
+var gradeColors = map[string]string{
+	"A": colorBrightGreen,
+	"B": colorGreen,
+	"C": colorYellow,
+	"D": colorOrange,
+	"F": colorRed,
+}
+
+// Generate 분석 결과로 배지 SVG 생성 (label이 비어있으면 배지 종류별 기본 라벨 사용)
+func Generate(result *types.AnalysisResult, metric, label string) (string, error) {
+	var value, color, defaultLabel string
+	switch metric {
+	case MetricGrade:
+		value = Grade(result.Summary)
+		color, defaultLabel = gradeColors[value], "code quality"
+	case MetricIssues:
+		value = fmt.Sprintf("%d", result.Summary.TotalIssues)
+		color, defaultLabel = issueColor(result.Summary), "quality issues"
+	case MetricCritical:
+		critical := result.Summary.SeverityCount[config.SeverityCritical]
+		value = fmt.Sprintf("%d critical", critical)
+		color, defaultLabel = colorBrightGreen, "quality"
+		if critical > 0 {
+			color = colorRed
+		}
+	default:
+		return "", fmt.Errorf("지원하지 않는 배지 종류: %s (grade/issues/critical)", metric)
+	}
+
+	if label == "" {
+		label = defaultLabel
+	}
+	return Render(label, value, color), nil
+}
+
+// issueColor 가장 높은 심각도에 따른 이슈 개수 배지 색상
+func issueColor(summary types.Summary) string {
+	switch {
+	case summary.SeverityCount[config.SeverityCritical] > 0:
+		return colorRed
+	case summary.SeverityCount[config.SeverityHigh] > 0:
+		return colorOrange
+	case summary.SeverityCount[config.SeverityMedium] > 0:
+		return colorYellow
+	case summary.TotalIssues > 0:
+		return colorGreen
+	default:
+		return colorBrightGreen
+	}
+}
+
+// Render shields.io 스타일의 평면 배지 SVG 생성
+func Render(label, value, color string) string {
+	labelWidth := textWidth(label) + 10
+	valueWidth := textWidth(value) + 10
+	width := labelWidth + valueWidth
+
+	label = html.EscapeString(label)
+	value = html.EscapeString(value)
+
+	var svg strings.Builder
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="20" role="img" aria-label="%s: %s">`, width, label, value)
+	fmt.Fprintf(&svg, `<title>%s: %s</title>`, label, value)
+	svg.WriteString(`<linearGradient id="s" x2="0" y2="100%"><stop offset="0" stop-color="#bbb" stop-opacity=".1"/><stop offset="1" stop-opacity=".1"/></linearGradient>`)
+	fmt.Fprintf(&svg, `<clipPath id="r"><rect width="%d" height="20" rx="3" fill="#fff"/></clipPath>`, width)
+	svg.WriteString(`<g clip-path="url(#r)">`)
+	fmt.Fprintf(&svg, `<rect width="%d" height="20" fill="#555"/>`, labelWidth)
+	fmt.Fprintf(&svg, `<rect x="%d" width="%d" height="20" fill="%s"/>`, labelWidth, valueWidth, color)
+	fmt.Fprintf(&svg, `<rect width="%d" height="20" fill="url(#s)"/>`, width)
+	svg.WriteString(`</g>`)
+	svg.WriteString(`<g fill="#fff" text-anchor="middle" font-family="Verdana,Geneva,DejaVu Sans,sans-serif" font-size="11">`)
+	fmt.Fprintf(&svg, `<text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text>`, labelWidth/2, label)
+	fmt.Fprintf(&svg, `<text x="%d" y="14">%s</text>`, labelWidth/2, label)
+	fmt.Fprintf(&svg, `<text x="%d" y="15" fill="#010101" fill-opacity=".3">%s</text>`, labelWidth+valueWidth/2, value)
+	fmt.Fprintf(&svg, `<text x="%d" y="14">%s</text>`, labelWidth+valueWidth/2, value)
+	svg.WriteString(`</g></svg>`)
+	svg.WriteString("\n")
+
+	return svg.String()
+}
+
+// textWidth Verdana 11px 기준 대략적인 텍스트 폭
+func textWidth(text string) int {
+	width := 0
+	for _, c := range text {
+		switch {
+		case c == ' ' || c == 'i' || c == 'l' || c == '.' || c == ',':
+			width += 4
+		case c >= 'A' && c <= 'Z', c == 'm' || c == 'w':
+			width += 9
+		case c > 0x7f:
+			width += 12
+		default:
+			width += 7
+		}
+	}
+	return width
+}
@@ -0,0 +1,148 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"code-quality-checker/internal/types"
+)
+
+// Stages 측정하는 분석 단계 (출력 순서)
+var Stages = []string{types.StageCollect, types.StageParse, types.StageRules, types.StageReport, StageTotal}
+
+// StageTotal 한 번 실행 전체 소요 시간
+const StageTotal = "total"
+
+// DefaultBaselinePath 벤치마크 기준값 기본 경로
+const DefaultBaselinePath = ".cqc/bench.json"
+
+// StageStats 단계별 소요 시간 분포
+type StageStats struct {
+	P50 time.Duration `json:"p50"`
+	P95 time.Duration `json:"p95"`
+}
+
+// Report 벤치마크 결과 (기준값 파일로도 저장)
+type Report struct {
+	ToolVersion string                `json:"tool_version"`
+	Runs        int                   `json:"runs"`
+	Format      string                `json:"format"` // report 단계에서 생성한 리포트 형식
+	Files       int                   `json:"files"`
+	Stages      map[string]StageStats `json:"stages"`
+	CreatedAt   time.Time             `json:"created_at"`
+}
+
+// Recorder 실행마다 단계별 소요 시간을 모음
+type Recorder struct {
+	samples map[string][]time.Duration
+	runs    int
+}
+
+// NewRecorder 빈 기록기 생성
+func NewRecorder() *Recorder {
+	return &Recorder{samples: make(map[string][]time.Duration)}
+}
+
+// Add 한 번 실행의 단계별 소요 시간 기록
+func (r *Recorder) Add(stageTime map[string]time.Duration) {
+	r.runs++
+	for _, stage := range Stages {
+		r.samples[stage] = append(r.samples[stage], stageTime[stage])
+	}
+}
+
+// Report 기록한 실행으로 p50/p95 계산
+func (r *Recorder) Report(version, format string, files int) *Report {
+	report := &Report{
+		ToolVersion: version,
+		Runs:        r.runs,
+		Format:      format,
+		Files:       files,
+		Stages:      make(map[string]StageStats),
+		CreatedAt:   time.Now(),
+	}
+	for stage, samples := range r.samples {
+		report.Stages[stage] = StageStats{P50: percentile(samples, 50), P95: percentile(samples, 95)}
+	}
+	return report
+}
+
+// percentile 최근접 순위 방식 백분위수 (실행 횟수가 적어도 실제 측정값 중 하나를 반환)
+func percentile(samples []time.Duration, p int) time.Duration {
+	if len(samples) == 0 {
+		return 0
+	}
+	sorted := append([]time.Duration{}, samples...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// Regression 기준값보다 느려진 단계
+type Regression struct {
+	Stage    string
+	Baseline time.Duration
+	Current  time.Duration
+	Percent  float64
+}
+
+// Compare p95가 기준값보다 threshold(%)를 넘게 느려진 단계 목록
+// 기준값이 minDuration보다 짧은 단계는 측정 오차가 커서, 리포트 형식이 다르면 report와 total 단계는 비교하지 않습니다
+func Compare(baseline, current *Report, threshold float64, minDuration time.Duration) []Regression {
+	var regressions []Regression
+	for _, stage := range Stages {
+		before, ok := baseline.Stages[stage]
+		if !ok || before.P95 < minDuration {
+			continue
+		}
+		if baseline.Format != current.Format && (stage == types.StageReport || stage == StageTotal) {
+			continue
+		}
+		after := current.Stages[stage]
+		change := Change(before.P95, after.P95)
+		if change > threshold {
+			regressions = append(regressions, Regression{Stage: stage, Baseline: before.P95, Current: after.P95, Percent: change})
+		}
+	}
+	return regressions
+}
+
+// Change 기준값 대비 변화율 (%)
+func Change(before, after time.Duration) float64 {
+	if before == 0 {
+		return 0
+	}
+	return float64(after-before) / float64(before) * 100
+}
+
+// Load 기준값 파일 로드
+func Load(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report Report
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("벤치마크 기준값 파싱 실패: %w", err)
+	}
+	return &report, nil
+}
+
+// Save 기준값 파일 저장
+func Save(path string, report *Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0644)
+}
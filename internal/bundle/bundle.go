@@ -0,0 +1,150 @@
+package bundle
+
+import (
+	"embed"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/registry"
+
+	"gopkg.in/yaml.v3"
+)
+
+// packSuffix 기본 제공 번들 파일명 접미사 (security → security-pack)
+const packSuffix = "-pack"
+
+//go:embed packs/*.yaml
+var builtinPacks embed.FS
+
+// Builtin 기본 제공 번들 목록 (이름 순)
+func Builtin() ([]*config.RulePack, error) {
+	entries, err := builtinPacks.ReadDir("packs")
+	if err != nil {
+		return nil, err
+	}
+
+	var packs []*config.RulePack
+	for _, entry := range entries {
+		_, pack, err := readBuiltin(strings.TrimSuffix(entry.Name(), ".yaml"))
+		if err != nil {
+			return nil, err
+		}
+		packs = append(packs, pack)
+	}
+	sort.Slice(packs, func(i, j int) bool { return packs[i].Name < packs[j].Name })
+	return packs, nil
+}
+
+// Fetch 번들 읽기 (기본 제공 번들 이름, http(s) URL 또는 로컬 파일 경로)
+func Fetch(source string) ([]byte, *config.RulePack, error) {
+	var data []byte
+	var err error
+
+	switch {
+	case strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://"):
+		data, err = download(source)
+	case strings.HasSuffix(source, ".yaml") || strings.HasSuffix(source, ".yml"):
+		data, err = os.ReadFile(source)
+	default:
+		return readBuiltin(source)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("번들 '%s' 읽기 실패: %w", source, err)
+	}
+
+	pack, err := parse(data, source)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, pack, nil
+}
+
+// Install 번들을 팩 디렉토리에 저장하고 설정 파일의 bundles에 버전을 기록
+// 이전에 설치된 버전을 함께 반환합니다 (새로 설치하면 빈 문자열)
+func Install(configPath, source string) (*config.RulePack, string, error) {
+	cfg, err := config.LoadRawConfig(configPath)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, pack, err := Fetch(source)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := registry.ValidatePack(pack); err != nil {
+		return nil, "", err
+	}
+
+	previous := ""
+	for _, pin := range cfg.Bundles {
+		if pin.Name == pack.Name {
+			previous = pin.Version
+		}
+	}
+
+	bundlePath := cfg.BundlePath(pack.Name)
+	if err := os.MkdirAll(filepath.Dir(bundlePath), 0755); err != nil {
+		return nil, "", fmt.Errorf("번들 디렉토리 생성 실패: %w", err)
+	}
+	if err := os.WriteFile(bundlePath, data, 0644); err != nil {
+		return nil, "", fmt.Errorf("번들 저장 실패: %w", err)
+	}
+
+	pin := config.PackPin{Name: pack.Name, Version: pack.Version, Source: source}
+	if err := config.SetBundle(configPath, pin); err != nil {
+		return nil, "", err
+	}
+	return pack, previous, nil
+}
+
+// readBuiltin 기본 제공 번들 읽기 ("security"와 "security-pack" 모두 허용)
+func readBuiltin(name string) ([]byte, *config.RulePack, error) {
+	if !strings.HasSuffix(name, packSuffix) {
+		name += packSuffix
+	}
+
+	data, err := builtinPacks.ReadFile(path.Join("packs", name+".yaml"))
+	if err != nil {
+		return nil, nil, fmt.Errorf("알 수 없는 번들: %s ('cqc bundle list'로 목록 확인)", strings.TrimSuffix(name, packSuffix))
+	}
+
+	pack, err := parse(data, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, pack, nil
+}
+
+// parse 번들 YAML 파싱 및 필수 항목 확인
+func parse(data []byte, source string) (*config.RulePack, error) {
+	var pack config.RulePack
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("번들 '%s' 파싱 실패: %w", source, err)
+	}
+	if pack.Name == "" || pack.Version == "" {
+		return nil, fmt.Errorf("번들 '%s'에 name과 version이 필요합니다", source)
+	}
+	return &pack, nil
+}
+
+func download(url string) ([]byte, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
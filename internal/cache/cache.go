@@ -0,0 +1,147 @@
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/editorconfig"
+	"code-quality-checker/internal/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// formatVersion 캐시 형식 버전 (Issue 구조나 규칙 구현이 바뀌면 올려서 기존 캐시 무효화)
+const formatVersion = "2"
+
+// Entry 파일 하나의 분석 결과
+type Entry struct {
+	Issues  []types.Issue `json:"issues"`
+	Skipped []string      `json:"skipped,omitempty"` // 대용량 파일 분석에서 건너뛴 규칙
+}
+
+// Stats 캐시 사용 통계
+type Stats struct {
+	Hits   int
+	Misses int
+	Errors int // 캐시 읽기/쓰기 실패
+}
+
+// Cache 파일 내용과 규칙 설정의 SHA-256으로 구분하는 분석 결과 캐시
+type Cache struct {
+	dir     string
+	ruleKey string
+	Stats   Stats
+}
+
+// New 캐시 생성 (디렉토리는 처음 저장할 때 생성)
+func New(dir string, cfg *config.Config) *Cache {
+	return &Cache{
+		dir:     dir,
+		ruleKey: ruleSetKey(cfg),
+	}
+}
+
+// ruleSetKey 분석 결과에 영향을 주는 설정의 해시
+func ruleSetKey(cfg *config.Config) string {
+	keyed := struct {
+		Version            string                 `yaml:"version"`
+		Languages          []config.LanguageRules `yaml:"languages"`
+		LargeFileThreshold int64                  `yaml:"large_file_threshold"`
+		Locale             string                 `yaml:"locale"` // 메시지 템플릿 문구가 로케일에 따라 달라짐
+	}{
+		Version:            formatVersion,
+		Languages:          cfg.Languages,
+		LargeFileThreshold: cfg.LargeFileThreshold(),
+		Locale:             cfg.Locale,
+	}
+
+	data, _ := yaml.Marshal(keyed)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Key 파일 내용, 언어, 규칙 설정으로 캐시 키 계산
+func (c *Cache) Key(filePath, language string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	io.WriteString(hash, c.ruleKey+"\x00"+language+"\x00")
+
+	// .editorconfig 설정도 결과에 영향을 주므로 키에 포함
+	if props, err := editorconfig.Resolve(filePath); err == nil {
+		keys := make([]string, 0, len(props))
+		for key := range props {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			io.WriteString(hash, key+"="+props[key]+"\x00")
+		}
+	}
+
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// Get 캐시된 결과 조회
+func (c *Cache) Get(key string) (*Entry, bool) {
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		c.Stats.Misses++
+		return nil, false
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		c.Stats.Misses++
+		c.Stats.Errors++
+		return nil, false
+	}
+
+	c.Stats.Hits++
+	return &entry, true
+}
+
+// Put 분석 결과 저장
+func (c *Cache) Put(key string, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		c.Stats.Errors++
+		return err
+	}
+
+	path := c.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		c.Stats.Errors++
+		return err
+	}
+
+	// 다른 프로세스가 읽는 도중 깨진 파일을 보지 않도록 임시 파일에 쓴 뒤 교체
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		c.Stats.Errors++
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		c.Stats.Errors++
+		return err
+	}
+	return nil
+}
+
+// path 캐시 파일 경로 (키 앞 두 글자로 디렉토리 분산)
+func (c *Cache) path(key string) string {
+	return filepath.Join(c.dir, key[:2], key+".json")
+}
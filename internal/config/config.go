@@ -1,9 +1,18 @@
 package config
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
+
+	"code-quality-checker/internal/expr"
 
 	"gopkg.in/yaml.v3"
 )
@@ -49,51 +58,379 @@ func ParseSeverity(s string) Severity {
 	}
 }
 
+// Confidence 탐지 신뢰도 열거형 (휴리스틱의 강도)
+type Confidence int
+
+const (
+	ConfidenceLow Confidence = iota + 1
+	ConfidenceMedium
+	ConfidenceHigh
+)
+
+func (c Confidence) String() string {
+	switch c {
+	case ConfidenceLow:
+		return "low"
+	case ConfidenceMedium:
+		return "medium"
+	case ConfidenceHigh:
+		return "high"
+	default:
+		return "unknown"
+	}
+}
+
+// MarshalText JSON 출력 시 문자열로 변환
+func (c Confidence) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText 문자열에서 Confidence로 변환
+func (c *Confidence) UnmarshalText(text []byte) error {
+	*c = ParseConfidence(string(text))
+	return nil
+}
+
+// ParseConfidence 문자열을 Confidence로 변환 (알 수 없는 값은 0)
+func ParseConfidence(s string) Confidence {
+	switch strings.ToLower(s) {
+	case "low":
+		return ConfidenceLow
+	case "medium":
+		return ConfidenceMedium
+	case "high":
+		return ConfidenceHigh
+	default:
+		return 0
+	}
+}
+
 // RuleConfig 개별 규칙 설정
 type RuleConfig struct {
-	ID          string            `yaml:"id"`
-	Name        string            `yaml:"name"`
-	Severity    string            `yaml:"severity"`
-	Category    string            `yaml:"category"`
-	Description string            `yaml:"description"`
-	Enabled     bool              `yaml:"enabled"`
-	Pattern     PatternConfig     `yaml:"pattern"`
-	Exclude     []string          `yaml:"exclude,omitempty"`
-	Custom      map[string]string `yaml:"custom,omitempty"`
+	ID           string            `yaml:"id"`
+	Name         string            `yaml:"name"`
+	Severity     string            `yaml:"severity"`
+	Confidence   string            `yaml:"confidence,omitempty"` // 규칙이 정한 신뢰도 재정의 (high/medium/low)
+	Category     string            `yaml:"category"`
+	Description  string            `yaml:"-"`           // 활성 로케일로 결정된 설명 (ApplyLocale)
+	Descriptions LocalizedText     `yaml:"description"` // 문자열 또는 로케일별 설명
+	Enabled      bool              `yaml:"enabled"`
+	Pattern      PatternConfig     `yaml:"pattern"`
+	Exclude      []string          `yaml:"exclude,omitempty"`
+	Custom       map[string]string `yaml:"custom,omitempty"`
+	Banned       []BannedEntry     `yaml:"banned,omitempty"`
+	Messages     MessageTemplates  `yaml:"messages,omitempty"`
+	Examples     []RuleExample     `yaml:"examples,omitempty"`
+	CWE          []string          `yaml:"cwe,omitempty"`            // 보안 규칙의 CWE ID (예: CWE-79)
+	OWASP        string            `yaml:"owasp,omitempty"`          // OWASP Top 10 카테고리 (예: A03:2021-Injection)
+	Links        []string          `yaml:"links,omitempty"`          // 팀 위키 등 참고 문서 (cqc explain에 표시)
+	Licenses     map[string]string `yaml:"licenses,omitempty"`       // 의존성별 라이선스 (매니페스트 라이선스 정책용)
+	Redact       *bool             `yaml:"redact_snippet,omitempty"` // 코드 스니펫 가리기 재정의 (true면 항상, false면 가리지 않음, 비어있으면 security 카테고리만)
+	Pack         string            `yaml:"-"`                        // 규칙 팩에서 병합된 경우 팩 이름
+	Maturity     string            `yaml:"maturity,omitempty"`       // 규칙 성숙도 (experimental/stable/deprecated, 비어있으면 stable)
+}
+
+// 규칙 성숙도 (새 규칙을 점진적으로 도입하기 위한 단계)
+const (
+	MaturityExperimental = "experimental" // 이슈는 보고하지만 종료 코드와 품질 게이트에는 반영하지 않음 (--include-experimental로 반영)
+	MaturityStable       = "stable"
+	MaturityDeprecated   = "deprecated" // 폐기 예정 (분석 경고로 알림)
+)
+
+// MessageTemplates 이슈 메시지 재정의 템플릿 ({{method}}, {{value}}, {{threshold}} 등 치환)
+// 각 문구는 문자열 또는 로케일별 맵으로 지정하며, 활성 로케일로 결정된 값은 Message/Description/Suggestion에 저장됩니다
+type MessageTemplates struct {
+	Message     string `yaml:"-"`
+	Description string `yaml:"-"`
+	Suggestion  string `yaml:"-"`
+
+	Messages     LocalizedText `yaml:"message,omitempty"`
+	Descriptions LocalizedText `yaml:"description,omitempty"`
+	Suggestions  LocalizedText `yaml:"suggestion,omitempty"`
+}
+
+// IsEmpty 재정의할 문구가 없는지 확인
+func (m MessageTemplates) IsEmpty() bool {
+	return m.Message == "" && m.Description == "" && m.Suggestion == ""
+}
+
+// RuleExample 규칙 위반/준수 코드 예시 쌍
+type RuleExample struct {
+	Bad  string `yaml:"bad" json:"bad"`   // 규칙 위반 코드
+	Good string `yaml:"good" json:"good"` // 수정된 코드
+}
+
+// BannedEntry 금지된 import/API 항목
+type BannedEntry struct {
+	Import      string `yaml:"import,omitempty"`     // 패키지/모듈 (끝이 '.' 또는 '/'이면 접두사 매칭)
+	API         string `yaml:"api,omitempty"`        // 금지된 호출 정규식
+	Dependency  string `yaml:"dependency,omitempty"` // 매니페스트 의존성 (maven/gradle은 groupId:artifactId, 끝이 ':' 또는 '/'이면 접두사 매칭)
+	Versions    string `yaml:"versions,omitempty"`   // 금지 버전 조건 (예: "<2.17.1", ">=1.0,<1.3", 비어있으면 모든 버전)
+	License     string `yaml:"license,omitempty"`    // 금지 라이선스 (규칙의 licenses로 의존성 라이선스 지정, 끝이 '*'이면 접두사 매칭)
+	Replacement string `yaml:"replacement,omitempty"`
+	Reason      string `yaml:"reason,omitempty"`
 }
 
 // PatternConfig 패턴 매칭 설정
 type PatternConfig struct {
-	Type       string   `yaml:"type"`        // regex, ast-pattern, method-analysis
+	Type       string   `yaml:"type"`        // regex, ast-pattern, method-analysis, expression
 	Regex      string   `yaml:"regex,omitempty"`
 	ASTPattern string   `yaml:"ast_pattern,omitempty"`
-	Conditions []string `yaml:"conditions,omitempty"`
+	Conditions []string `yaml:"conditions,omitempty"` // expression이면 모두 참이어야 하는 조건식 (그 외에는 설명용 표시)
 }
 
+// PatternExpression conditions를 조건식으로 평가하는 사용자 정의 규칙 유형
+const PatternExpression = "expression"
+
 // LanguageRules 언어별 규칙
 type LanguageRules struct {
 	Language string       `yaml:"language"`
+	Taint    TaintConfig  `yaml:"taint,omitempty"` // 오염 분석 소스/싱크 (기본 제공 설정에 추가)
 	Rules    []RuleConfig `yaml:"rules"`
 }
 
+// RegistryConfig 조직 규칙 레지스트리 설정
+type RegistryConfig struct {
+	URL   string    `yaml:"url,omitempty"`
+	Dir   string    `yaml:"dir,omitempty"` // 다운로드한 규칙 팩 저장 경로
+	Packs []PackPin `yaml:"packs,omitempty"`
+}
+
+// PackPin 사용할 규칙 팩과 고정 버전
+type PackPin struct {
+	Name    string `yaml:"name"`
+	Version string `yaml:"version,omitempty"` // 비어있으면 최신 버전
+	Source  string `yaml:"source,omitempty"`  // 번들 출처 (기본 제공 번들 이름, URL 또는 파일 경로)
+}
+
+// RulePack 레지스트리에서 배포되는 규칙 팩
+type RulePack struct {
+	Name        string           `yaml:"name"`
+	Version     string           `yaml:"version"`
+	Description string           `yaml:"description,omitempty"`
+	Languages   []LanguageRules  `yaml:"languages,omitempty"`
+	Plugins     []PluginManifest `yaml:"plugins,omitempty"`
+}
+
+// PluginManifest 규칙 팩에 포함된 플러그인 매니페스트
+type PluginManifest struct {
+	Name        string `yaml:"name"`
+	Version     string `yaml:"version"`
+	Description string `yaml:"description,omitempty"`
+	URL         string `yaml:"url,omitempty"`
+	SHA256      string `yaml:"sha256,omitempty"`
+}
+
+// DefaultPackDir 규칙 팩 기본 저장 경로
+const DefaultPackDir = ".cqc/packs"
+
+// LockFileName 동기화된 팩 버전을 기록하는 파일명 (규칙 팩 저장 경로 기준)
+const LockFileName = "registry.lock.yaml"
+
+// LockFile 동기화 결과 기록
+type LockFile struct {
+	Registry string      `yaml:"registry"`
+	SyncedAt time.Time   `yaml:"synced_at"`
+	Packs    []LockEntry `yaml:"packs"`
+}
+
+// LockEntry 동기화된 팩 정보
+type LockEntry struct {
+	Name    string   `yaml:"name"`
+	Version string   `yaml:"version"`
+	SHA256  string   `yaml:"sha256"`
+	URL     string   `yaml:"url"`
+	Plugins []string `yaml:"plugins,omitempty"`
+}
+
+// AnalysisConfig 분석 동작 설정
+type AnalysisConfig struct {
+	LargeFileSizeMB  int               `yaml:"large_file_size_mb,omitempty"`  // 이 크기를 넘는 파일은 라인 단위 스트리밍 분석
+	MaxIssuesPerRule int               `yaml:"max_issues_per_rule,omitempty"` // 파일당 규칙별 최대 이슈 수 (0이면 제한 없음)
+	MaxIssuesPerFile int               `yaml:"max_issues_per_file,omitempty"` // 파일당 최대 이슈 수 (0이면 제한 없음)
+	MinConfidence    string            `yaml:"min_confidence,omitempty"`      // 이보다 신뢰도가 낮은 이슈는 제외 (high/medium/low)
+	Cache            bool              `yaml:"cache,omitempty"`               // 파일 내용 해시 기반 결과 캐시 사용
+	CacheDir         string            `yaml:"cache_dir,omitempty"`           // 캐시 저장 경로
+	Index            bool              `yaml:"index,omitempty"`               // 프로젝트 심볼 색인 갱신 및 사용 (파일 간 규칙용)
+	IndexDir         string            `yaml:"index_dir,omitempty"`           // 심볼 색인 저장 경로
+	Baseline         string            `yaml:"baseline,omitempty"`            // 이 JSON 결과에 있는 이슈는 억제 (기존 이슈 일괄 유예)
+	BaselineUntil    string            `yaml:"baseline_until,omitempty"`      // 베이스라인 억제 만료일 (YYYY-MM-DD, 이 날짜부터 다시 보고)
+	Correlate        bool              `yaml:"correlate,omitempty"`           // HTML 템플릿과 JS 이슈를 함께 분석 (XSS 결합 이슈)
+	Sample           string            `yaml:"sample,omitempty"`              // 분석할 파일 비율 (예: "10%", "0.1", 비어있으면 전체)
+	MaxFiles         int               `yaml:"max_files,omitempty"`           // 분석할 최대 파일 수 (0이면 제한 없음)
+	SampleSeed       int64             `yaml:"sample_seed,omitempty"`         // 표본 선택 시드 (같은 시드면 같은 파일 선택)
+	TriageFile       string            `yaml:"triage_file,omitempty"`         // 이슈 트리아지 파일 (비어있으면 분석 경로의 .cqc-triage.yaml)
+	ParseTimeout     string            `yaml:"parse_timeout,omitempty"`       // 파일당 구조 파싱 제한 시간 (예: "10s", 초과하면 텍스트 분석으로 대체)
+	ParseTimeouts    map[string]string `yaml:"parse_timeouts,omitempty"`      // 언어별 파싱 제한 시간 (parse_timeout보다 우선)
+	SigningKeyEnv    string            `yaml:"signing_key_env,omitempty"`     // 결과 HMAC 서명 키를 담은 환경 변수 (기본값 CQC_SIGNING_KEY, 키가 없으면 서명 생략)
+	RedactSnippets   string            `yaml:"redact_snippets,omitempty"`     // 보안 이슈 코드 스니펫 처리 (mask: 문자열/토큰 가림, omit: 생략, 비어있으면 그대로)
+	MaxMemoryMB      int               `yaml:"max_memory_mb,omitempty"`       // 메모리 소프트 상한 (MB, 넘으면 이후 파일은 라인 단위 규칙만 검사, 0이면 제한 없음)
+	Preview          int               `yaml:"preview,omitempty"`             // 미리보기 모드: 규칙별로 대표 이슈를 이 수만큼만 보고 (0이면 사용 안 함)
+	GateExperimental bool              `yaml:"gate_experimental,omitempty"`   // 실험 규칙의 이슈도 종료 코드와 품질 게이트에 반영
+	History          string            `yaml:"history,omitempty"`             // 실행 요약과 이슈를 누적 기록할 SQLite 파일 (cqc trends로 조회)
+	Exclude          []string          `yaml:"exclude,omitempty"`             // 분석에서 제외할 경로 패턴 (gitignore 문법, 분석 경로(여러 개면 공통 상위 디렉터리) 기준, .cqcignore와 함께 적용)
+}
+
+// DefaultLargeFileSizeMB 스트리밍 분석 기준 파일 크기 기본값 (MB)
+const DefaultLargeFileSizeMB = 10
+
+// DefaultParseTimeout 파일당 구조 파싱 제한 시간 기본값
+const DefaultParseTimeout = 10 * time.Second
+
+// 코드 스니펫 가리기 방식
+const (
+	RedactMask = "mask" // 문자열 리터럴과 토큰처럼 보이는 값을 *** 로 가림
+	RedactOmit = "omit" // 스니펫을 생략
+)
+
+// DefaultSigningKeyEnv 결과 서명 키 환경 변수 기본값
+const DefaultSigningKeyEnv = "CQC_SIGNING_KEY"
+
+// SigningKey 결과 서명 키 (키를 설정 파일에 두지 않도록 환경 변수에서만 읽음, 없으면 nil)
+func (c *Config) SigningKey() []byte {
+	name := c.Analysis.SigningKeyEnv
+	if name == "" {
+		name = DefaultSigningKeyEnv
+	}
+	if key := os.Getenv(name); key != "" {
+		return []byte(key)
+	}
+	return nil
+}
+
+// RulesetHash 필터링까지 적용된 규칙 설정의 SHA-256 해시 (리포트가 어떤 규칙 세트로 만들어졌는지 확인용)
+func (c *Config) RulesetHash() string {
+	data, _ := yaml.Marshal(struct {
+		Version   string          `yaml:"version"`
+		Languages []LanguageRules `yaml:"languages"`
+	}{c.Version, c.Languages})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// GateConfig 카테고리 품질 게이트 (min_severity 이상 이슈가 max개를 넘으면 실패)
+type GateConfig struct {
+	Max         int    `yaml:"max"`
+	MinSeverity string `yaml:"min_severity,omitempty"` // 비어있으면 모든 심각도
+}
+
+// EscalationConfig 카테고리별 심각도 자동 상향 (after_runs회 연속 또는 after_days일 이상 방치된 이슈)
+type EscalationConfig struct {
+	AfterRuns int    `yaml:"after_runs,omitempty"`
+	AfterDays int    `yaml:"after_days,omitempty"`
+	To        string `yaml:"to,omitempty"` // 상향할 심각도 (비어있으면 한 단계 위)
+}
+
+// NotifyConfig 분석 후 결과 알림 설정
+type NotifyConfig struct {
+	Email EmailConfig `yaml:"email,omitempty"`
+}
+
+// EmailConfig SMTP 메일 알림 설정 (host가 비어있으면 사용 안 함)
+type EmailConfig struct {
+	Host             string   `yaml:"host,omitempty"`
+	Port             int      `yaml:"port,omitempty"` // 기본값 587
+	Username         string   `yaml:"username,omitempty"`
+	PasswordEnv      string   `yaml:"password_env,omitempty"` // 비밀번호를 담은 환경 변수 이름
+	From             string   `yaml:"from,omitempty"`
+	To               []string `yaml:"to,omitempty"`
+	Subject          string   `yaml:"subject,omitempty"`
+	Format           string   `yaml:"format,omitempty"`             // html(기본값), markdown
+	OnlyOnRegression bool     `yaml:"only_on_regression,omitempty"` // 기준 결과보다 나빠졌을 때만 발송
+	Baseline         string   `yaml:"baseline,omitempty"`           // 회귀 비교 기준 JSON 결과 파일
+}
+
+// PublishConfig 리포트 업로드 설정 (provider가 비어있으면 사용 안 함)
+type PublishConfig struct {
+	Provider     string `yaml:"provider,omitempty"` // s3, gcs
+	Bucket       string `yaml:"bucket,omitempty"`
+	Prefix       string `yaml:"prefix,omitempty"`         // 버킷 내 경로 접두사
+	Region       string `yaml:"region,omitempty"`         // 기본값: s3는 us-east-1, gcs는 auto
+	Endpoint     string `yaml:"endpoint,omitempty"`       // S3 호환 저장소(MinIO 등) 주소
+	AccessKeyEnv string `yaml:"access_key_env,omitempty"` // 기본값 AWS_ACCESS_KEY_ID
+	SecretKeyEnv string `yaml:"secret_key_env,omitempty"` // 기본값 AWS_SECRET_ACCESS_KEY
+	// 임시 자격 증명(STS, AssumeRole)의 세션 토큰, 기본값 AWS_SESSION_TOKEN (설정되어 있지 않으면 생략)
+	SessionTokenEnv string `yaml:"session_token_env,omitempty"`
+}
+
+// ConfluenceConfig Confluence 페이지 게시 설정 (url이 비어있으면 사용 안 함)
+type ConfluenceConfig struct {
+	URL      string `yaml:"url,omitempty"`   // 예: https://example.atlassian.net/wiki
+	Space    string `yaml:"space,omitempty"` // 스페이스 키
+	Title    string `yaml:"title,omitempty"` // 같은 제목의 페이지가 있으면 갱신
+	ParentID string `yaml:"parent_id,omitempty"`
+	Username string `yaml:"username,omitempty"`  // 비어있으면 토큰을 Bearer(개인 액세스 토큰)로 사용
+	TokenEnv string `yaml:"token_env,omitempty"` // API 토큰을 담은 환경 변수 이름
+}
+
+// TelemetryConfig 집계 지표 전송 설정 (enabled와 endpoint가 모두 있어야 전송, 파일 경로와 코드는 보내지 않음)
+type TelemetryConfig struct {
+	Enabled  bool   `yaml:"enabled,omitempty"`
+	Endpoint string `yaml:"endpoint,omitempty"`  // 집계 지표를 POST할 주소
+	Project  string `yaml:"project,omitempty"`   // 대시보드에 표시할 프로젝트 이름
+	TokenEnv string `yaml:"token_env,omitempty"` // Bearer 토큰을 담은 환경 변수 이름 (비어있으면 인증 없음)
+}
+
+// RepositoryConfig 이슈에서 코드 호스팅의 해당 라인으로 가는 링크 설정 (url_template이 비어있으면 링크 없음)
+type RepositoryConfig struct {
+	URLTemplate string `yaml:"url_template,omitempty"` // {commit}, {path}, {line} 치환 (예: https://github.com/org/repo/blob/{commit}/{path}#L{line})
+	Commit      string `yaml:"commit,omitempty"`       // 비어있으면 CI 환경 변수(GITHUB_SHA, CI_COMMIT_SHA, GIT_COMMIT), 그것도 없으면 HEAD
+	Root        string `yaml:"root,omitempty"`         // 저장소 루트 (기본값: 작업 디렉터리), 파일 경로를 이 기준 상대 경로로 변환
+}
+
+// DefaultCacheDir 분석 결과 캐시 기본 저장 경로
+const DefaultCacheDir = ".cqc/cache"
+
+// DefaultIndexDir 심볼 색인 기본 저장 경로
+const DefaultIndexDir = ".cqc/index"
+
 // Config 전체 설정
 type Config struct {
-	Version   string          `yaml:"version"`
-	Languages []LanguageRules `yaml:"languages"`
+	Version    string                      `yaml:"version"`
+	Languages  []LanguageRules             `yaml:"languages"`
+	Registry   RegistryConfig              `yaml:"registry,omitempty"`
+	Analysis   AnalysisConfig              `yaml:"analysis,omitempty"`
+	Gates      map[string]GateConfig       `yaml:"gates,omitempty"`      // 카테고리별 품질 게이트
+	Escalation map[string]EscalationConfig `yaml:"escalation,omitempty"` // 카테고리별 심각도 자동 상향
+	Priority   PriorityConfig              `yaml:"priority,omitempty"`   // 이슈 우선순위 점수
+	Notify     NotifyConfig                `yaml:"notify,omitempty"`
+	Publish    PublishConfig               `yaml:"publish,omitempty"`
+	Confluence ConfluenceConfig            `yaml:"confluence,omitempty"`
+	Telemetry  TelemetryConfig             `yaml:"telemetry,omitempty"`
+	Repository RepositoryConfig            `yaml:"repository,omitempty"`
+	Locale     string                      `yaml:"locale,omitempty"`  // 규칙 설명/메시지 로케일 (비어있으면 CQC_LOCALE, 기본값 ko)
+	Bundles    []PackPin                   `yaml:"bundles,omitempty"` // 'cqc bundle add'로 설치한 규칙 번들
 }
 
 // LoadConfig 설정 파일 로드
 func LoadConfig(configPath string) (*Config, error) {
-	data, err := ioutil.ReadFile(configPath)
+	config, err := LoadRawConfig(configPath)
 	if err != nil {
-		return nil, fmt.Errorf("설정 파일 읽기 실패: %w", err)
+		return nil, err
 	}
 
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
-		return nil, fmt.Errorf("설정 파일 파싱 실패: %w", err)
+	// 고정된 규칙 팩 병합
+	if err := config.loadRulePacks(); err != nil {
+		return nil, err
+	}
+
+	// 사용자 정의 규칙 조건식 확인 (분석 중에 조용히 무시되지 않도록)
+	if err := config.validateConditions(); err != nil {
+		return nil, err
+	}
+	if err := config.validateMaturity(); err != nil {
+		return nil, err
+	}
+	if err := config.validateRegexes(); err != nil {
+		return nil, err
+	}
+	if err := config.validateTaint(); err != nil {
+		return nil, err
 	}
 
+	// 로케일별 문구 결정
+	config.ApplyLocale(config.ActiveLocale())
+
 	// 기본값 설정
 	for i := range config.Languages {
 		for j := range config.Languages[i].Rules {
@@ -104,6 +441,84 @@ func LoadConfig(configPath string) (*Config, error) {
 		}
 	}
 
+	return config, nil
+}
+
+// validateConditions expression 규칙의 조건식 문법과 사실 이름 확인
+func (c *Config) validateConditions() error {
+	for _, langRules := range c.Languages {
+		for _, rule := range langRules.Rules {
+			if rule.Pattern.Type != PatternExpression {
+				continue
+			}
+			if len(rule.Pattern.Conditions) == 0 {
+				return fmt.Errorf("규칙 %s: expression 규칙에는 conditions가 필요합니다", rule.ID)
+			}
+			for _, condition := range rule.Pattern.Conditions {
+				if _, err := expr.Parse(condition); err != nil {
+					return fmt.Errorf("규칙 %s 조건식 오류 (%s): %w", rule.ID, condition, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateRegexes 규칙 옵션의 정규식 확인 (잘못된 정규식이 분석 중에 조용히 무시되지 않도록)
+func (c *Config) validateRegexes() error {
+	for _, langRules := range c.Languages {
+		for _, rule := range langRules.Rules {
+			// 명명 규칙의 <종류>_pattern
+			for key, value := range rule.Custom {
+				if !strings.HasSuffix(key, "_pattern") {
+					continue
+				}
+				if _, err := regexp.Compile(value); err != nil {
+					return fmt.Errorf("규칙 %s의 %s 정규식 오류 (%s): %w", rule.ID, key, value, err)
+				}
+			}
+			for _, entry := range rule.Banned {
+				if entry.API == "" {
+					continue
+				}
+				if _, err := regexp.Compile(entry.API); err != nil {
+					return fmt.Errorf("규칙 %s의 banned api 정규식 오류 (%s): %w", rule.ID, entry.API, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateMaturity 규칙 성숙도 값 확인
+func (c *Config) validateMaturity() error {
+	for _, langRules := range c.Languages {
+		for _, rule := range langRules.Rules {
+			switch rule.Maturity {
+			case "", MaturityExperimental, MaturityStable, MaturityDeprecated:
+			default:
+				return fmt.Errorf("규칙 %s: 지원하지 않는 maturity 값입니다: %s (experimental/stable/deprecated)", rule.ID, rule.Maturity)
+			}
+		}
+	}
+	return nil
+}
+
+// LoadRawConfig 규칙 팩 병합이나 기본값 적용 없이 설정 파일만 로드
+func LoadRawConfig(configPath string) (*Config, error) {
+	data, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+	return ParseRawConfig(data)
+}
+
+// ParseRawConfig 설정 YAML 파싱 (규칙 팩 병합, 검증, 기본값 적용 없이)
+func ParseRawConfig(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("설정 파일 파싱 실패: %w", err)
+	}
 	return &config, nil
 }
 
@@ -174,4 +589,212 @@ func (c *Config) GetAllCategories() []string {
 		categories = append(categories, category)
 	}
 	return categories
+}
+
+// PackDir 규칙 팩 저장 경로 반환
+func (c *Config) PackDir() string {
+	if c.Registry.Dir != "" {
+		return c.Registry.Dir
+	}
+	return DefaultPackDir
+}
+
+// LargeFileThreshold 스트리밍 분석 기준 파일 크기 반환 (바이트)
+func (c *Config) LargeFileThreshold() int64 {
+	sizeMB := c.Analysis.LargeFileSizeMB
+	if sizeMB <= 0 {
+		sizeMB = DefaultLargeFileSizeMB
+	}
+	return int64(sizeMB) << 20
+}
+
+// ParseTimeout 언어의 구조 파싱 제한 시간 (지정하지 않았거나 형식이 잘못되면 기본값)
+func (c *Config) ParseTimeout(language string) time.Duration {
+	value := c.Analysis.ParseTimeouts[language]
+	if value == "" {
+		value = c.Analysis.ParseTimeout
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil || timeout <= 0 {
+		return DefaultParseTimeout
+	}
+	return timeout
+}
+
+// SampleRatio 표본 비율 (0~1, 지정하지 않으면 1)
+func (c *Config) SampleRatio() (float64, error) {
+	value := strings.TrimSpace(c.Analysis.Sample)
+	if value == "" {
+		return 1, nil
+	}
+
+	percent := strings.HasSuffix(value, "%")
+	ratio, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("잘못된 표본 비율: %s", value)
+	}
+	if percent {
+		ratio /= 100
+	}
+	if ratio <= 0 || ratio > 1 {
+		return 0, fmt.Errorf("표본 비율은 0보다 크고 100%% 이하여야 합니다: %s", value)
+	}
+	return ratio, nil
+}
+
+// CacheDir 분석 결과 캐시 저장 경로 반환
+func (c *Config) CacheDir() string {
+	if c.Analysis.CacheDir != "" {
+		return c.Analysis.CacheDir
+	}
+	return DefaultCacheDir
+}
+
+// IndexDir 심볼 색인 저장 경로 반환
+func (c *Config) IndexDir() string {
+	if c.Analysis.IndexDir != "" {
+		return c.Analysis.IndexDir
+	}
+	return DefaultIndexDir
+}
+
+// PackPath 규칙 팩 파일 경로 반환
+func (c *Config) PackPath(name string) string {
+	return filepath.Join(c.PackDir(), name+".yaml")
+}
+
+// BundlePath 설치된 규칙 번들 파일 경로 반환
+func (c *Config) BundlePath(name string) string {
+	return filepath.Join(c.PackDir(), "bundles", name+".yaml")
+}
+
+// LoadRulePack 규칙 팩 파일 로드
+func LoadRulePack(path string) (*RulePack, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("규칙 팩 읽기 실패: %w", err)
+	}
+
+	var pack RulePack
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("규칙 팩 파싱 실패: %w", err)
+	}
+	return &pack, nil
+}
+
+// LoadLockFile 규칙 팩 잠금 파일 로드 (파일이 없으면 빈 잠금 파일)
+func LoadLockFile(path string) (*LockFile, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &LockFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("잠금 파일 읽기 실패: %w", err)
+	}
+
+	var lock LockFile
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, fmt.Errorf("잠금 파일 파싱 실패: %w", err)
+	}
+	return &lock, nil
+}
+
+// Verify 동기화된 팩 파일의 sha256이 잠금 파일에 기록된 값과 같은지 확인
+// 동기화 이후 팩 파일이 바뀌었거나 잠금 파일에 없는 팩은 병합하지 않도록 오류를 반환합니다
+func (l *LockFile) Verify(name, path string) error {
+	var entry *LockEntry
+	for i := range l.Packs {
+		if l.Packs[i].Name == name {
+			entry = &l.Packs[i]
+			break
+		}
+	}
+	if entry == nil {
+		return fmt.Errorf("규칙 팩 '%s'이(가) %s에 없습니다 - 'cqc rules sync'를 실행하세요", name, LockFileName)
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("규칙 팩 읽기 실패: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	if checksum := hex.EncodeToString(sum[:]); !strings.EqualFold(entry.SHA256, checksum) {
+		return fmt.Errorf("규칙 팩 '%s' 체크섬 불일치 (잠금 파일: %s, 실제: %s) - 'cqc rules sync'를 실행하세요", name, entry.SHA256, checksum)
+	}
+	return nil
+}
+
+// loadRulePacks 설정에 고정된 규칙 팩과 설치된 번들을 읽어 규칙 목록에 병합
+// 레지스트리에서 동기화한 팩은 잠금 파일의 체크섬으로 검증합니다 (번들은 바이너리에 포함되거나 직접 설치하므로 제외)
+func (c *Config) loadRulePacks() error {
+	if len(c.Registry.Packs) > 0 {
+		lock, err := LoadLockFile(filepath.Join(c.PackDir(), LockFileName))
+		if err != nil {
+			return err
+		}
+		for _, pin := range c.Registry.Packs {
+			if err := c.mergeRulePack(c.PackPath(pin.Name), pin, "cqc rules sync", lock); err != nil {
+				return err
+			}
+		}
+	}
+	for _, pin := range c.Bundles {
+		if err := c.mergeRulePack(c.BundlePath(pin.Name), pin, "cqc bundle add "+pin.Name, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeRulePack 규칙 팩 파일을 읽어 체크섬(lock이 있을 때)과 고정 버전을 확인하고 병합 (파일이 없으면 건너뜀)
+func (c *Config) mergeRulePack(path string, pin PackPin, installCommand string, lock *LockFile) error {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil // 아직 동기화되지 않은 팩
+	}
+	if lock != nil {
+		if err := lock.Verify(pin.Name, path); err != nil {
+			return err
+		}
+	}
+
+	pack, err := LoadRulePack(path)
+	if err != nil {
+		return err
+	}
+	if pin.Version != "" && pin.Version != "latest" && pack.Version != pin.Version {
+		return fmt.Errorf("규칙 팩 '%s' 버전 불일치 (고정: %s, 설치: %s) - '%s'를 실행하세요", pin.Name, pin.Version, pack.Version, installCommand)
+	}
+
+	for _, langRules := range pack.Languages {
+		for i := range langRules.Rules {
+			langRules.Rules[i].Pack = pack.Name
+		}
+		c.MergeLanguageRules(langRules)
+	}
+	return nil
+}
+
+// MergeLanguageRules 언어별 규칙을 병합 (같은 ID는 덮어쓰기)
+func (c *Config) MergeLanguageRules(langRules LanguageRules) {
+	for i := range c.Languages {
+		if c.Languages[i].Language != langRules.Language {
+			continue
+		}
+		c.Languages[i].Taint = c.Languages[i].Taint.merge(langRules.Taint)
+		for _, rule := range langRules.Rules {
+			replaced := false
+			for j := range c.Languages[i].Rules {
+				if c.Languages[i].Rules[j].ID == rule.ID {
+					c.Languages[i].Rules[j] = rule
+					replaced = true
+					break
+				}
+			}
+			if !replaced {
+				c.Languages[i].Rules = append(c.Languages[i].Rules, rule)
+			}
+		}
+		return
+	}
+	c.Languages = append(c.Languages, langRules)
 }
\ No newline at end of file
@@ -0,0 +1,85 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SetBundle 설정 파일의 bundles 목록에 번들 버전을 기록 (같은 이름이 있으면 갱신)
+// 사용자가 작성한 주석과 서식을 보존하기 위해 bundles 블록만 다시 작성합니다
+func SetBundle(configPath string, pin PackPin) error {
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+	lines := strings.Split(strings.TrimRight(string(data), "\n"), "\n")
+
+	// 최상위 bundles: 블록 위치 (다음 최상위 키 전까지)
+	start, end := -1, len(lines)
+	for i, line := range lines {
+		if start < 0 {
+			if strings.HasPrefix(line, "bundles:") {
+				start = i
+			}
+			continue
+		}
+		if line != "" && !strings.HasPrefix(line, " ") && !strings.HasPrefix(line, "#") && !strings.HasPrefix(line, "-") {
+			end = i
+			break
+		}
+	}
+
+	var bundles []PackPin
+	if start >= 0 {
+		var block struct {
+			Bundles []PackPin `yaml:"bundles"`
+		}
+		if err := yaml.Unmarshal([]byte(strings.Join(lines[start:end], "\n")), &block); err != nil {
+			return fmt.Errorf("설정 파일의 bundles 파싱 실패: %w", err)
+		}
+		bundles = block.Bundles
+	}
+
+	replaced := false
+	for i := range bundles {
+		if bundles[i].Name == pin.Name {
+			bundles[i] = pin
+			replaced = true
+		}
+	}
+	if !replaced {
+		bundles = append(bundles, pin)
+	}
+
+	block := renderBundles(bundles)
+	if start < 0 {
+		lines = append(lines, "", "# 'cqc bundle add'로 설치한 규칙 번들")
+		lines = append(lines, block...)
+	} else {
+		// 블록 끝의 빈 줄과 주석은 다음 키에 속하므로 유지
+		for end > start+1 && (strings.TrimSpace(lines[end-1]) == "" || strings.HasPrefix(lines[end-1], "#")) {
+			end--
+		}
+		lines = append(lines[:start], append(block, lines[end:]...)...)
+	}
+
+	return os.WriteFile(configPath, []byte(strings.Join(lines, "\n")+"\n"), 0644)
+}
+
+// renderBundles bundles 블록 YAML 라인 생성
+func renderBundles(bundles []PackPin) []string {
+	lines := []string{"bundles:"}
+	for _, pin := range bundles {
+		lines = append(lines, fmt.Sprintf("  - name: %q", pin.Name))
+		if pin.Version != "" {
+			lines = append(lines, fmt.Sprintf("    version: %q", pin.Version))
+		}
+		if pin.Source != "" {
+			lines = append(lines, fmt.Sprintf("    source: %q", pin.Source))
+		}
+	}
+	return lines
+}
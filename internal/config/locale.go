@@ -0,0 +1,98 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultLocale 로케일을 지정하지 않았을 때 사용하는 로케일
+const DefaultLocale = "ko"
+
+// LocalizedText 로케일별 문구 (YAML에서 문자열 하나 또는 {ko: ..., en: ...} 맵으로 지정, 문자열은 "" 키로 저장)
+type LocalizedText map[string]string
+
+// UnmarshalYAML 문자열과 로케일 맵을 모두 허용
+func (t *LocalizedText) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		*t = LocalizedText{"": node.Value}
+		return nil
+	case yaml.MappingNode:
+		var locales map[string]string
+		if err := node.Decode(&locales); err != nil {
+			return err
+		}
+		*t = make(LocalizedText, len(locales))
+		for locale, text := range locales {
+			(*t)[normalizeLocale(locale)] = text
+		}
+		return nil
+	default:
+		return fmt.Errorf("%d번째 줄: 문자열 또는 로케일별 맵이어야 합니다", node.Line)
+	}
+}
+
+// Resolve 로케일에 맞는 문구 선택 (정확한 로케일, 언어 코드(en-US → en), 문자열 값, 기본 로케일, 이름순 첫 로케일 순)
+func (t LocalizedText) Resolve(locale string) string {
+	if len(t) == 0 {
+		return ""
+	}
+
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if language, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, "", DefaultLocale)
+
+	for _, candidate := range candidates {
+		if text, ok := t[candidate]; ok && text != "" {
+			return text
+		}
+	}
+
+	locales := make([]string, 0, len(t))
+	for key := range t {
+		locales = append(locales, key)
+	}
+	sort.Strings(locales)
+	return t[locales[0]]
+}
+
+// normalizeLocale "en_US.UTF-8" 같은 시스템 로케일을 "en-us" 형식으로 변환
+func normalizeLocale(locale string) string {
+	locale, _, _ = strings.Cut(locale, ".")
+	return strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+}
+
+// ActiveLocale 사용할 로케일 결정 (설정의 locale, CQC_LOCALE 환경 변수, 기본 로케일 순)
+func (c *Config) ActiveLocale() string {
+	if c.Locale != "" {
+		return c.Locale
+	}
+	if locale := os.Getenv("CQC_LOCALE"); locale != "" {
+		return locale
+	}
+	return DefaultLocale
+}
+
+// ApplyLocale 규칙 설명과 메시지 템플릿을 로케일에 맞는 문구로 결정하고 내장 메시지 카탈로그 언어도 바꿈 (로케일을 바꾸면 다시 호출)
+func (c *Config) ApplyLocale(locale string) {
+	c.Locale = locale
+	i18n.SetLanguage(locale)
+	for i := range c.Languages {
+		for j := range c.Languages[i].Rules {
+			rule := &c.Languages[i].Rules[j]
+			rule.Description = rule.Descriptions.Resolve(locale)
+			rule.Messages.Message = rule.Messages.Messages.Resolve(locale)
+			rule.Messages.Description = rule.Messages.Descriptions.Resolve(locale)
+			rule.Messages.Suggestion = rule.Messages.Suggestions.Resolve(locale)
+		}
+	}
+}
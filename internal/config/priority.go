@@ -0,0 +1,39 @@
+package config
+
+// DefaultChurnDays 변경 빈도를 세는 git 기록 기간 기본값 (일)
+const DefaultChurnDays = 90
+
+// PriorityConfig 이슈 우선순위 점수 설정 (priority)
+// 우선순위는 심각도, 신뢰도, 카테고리 가중치, 파일의 최근 변경 빈도로 계산합니다
+type PriorityConfig struct {
+	CategoryWeights map[string]float64 `yaml:"category_weights,omitempty"` // 카테고리별 가중치 (기본 제공 가중치를 덮어씀, 보통은 1.0)
+	ChurnDays       int                `yaml:"churn_days,omitempty"`       // 변경 빈도를 셀 git 기록 기간 (일, 기본값 90)
+	NoChurn         bool               `yaml:"no_churn,omitempty"`         // git 기록을 조회하지 않음 (변경 빈도 가중치 제외)
+}
+
+// defaultCategoryWeights 기본 카테고리 가중치 (없는 카테고리는 1.0)
+var defaultCategoryWeights = map[string]float64{
+	"security":    1.5,
+	"reliability": 1.25,
+	"transaction": 1.25,
+	"performance": 1.1,
+}
+
+// CategoryWeight 카테고리의 우선순위 가중치
+func (p PriorityConfig) CategoryWeight(category string) float64 {
+	if weight, ok := p.CategoryWeights[category]; ok && weight > 0 {
+		return weight
+	}
+	if weight, ok := defaultCategoryWeights[category]; ok {
+		return weight
+	}
+	return 1.0
+}
+
+// ChurnWindow 변경 빈도를 셀 기간 (일)
+func (p PriorityConfig) ChurnWindow() int {
+	if p.ChurnDays > 0 {
+		return p.ChurnDays
+	}
+	return DefaultChurnDays
+}
@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// 오염 분석 싱크 종류 (이슈 메시지와 CWE 분류에 사용)
+const (
+	TaintSQL      = "sql"      // SQL 실행 (CWE-89)
+	TaintXSS      = "xss"      // HTML 출력 (CWE-79)
+	TaintRedirect = "redirect" // 리다이렉트 (CWE-601)
+)
+
+// TaintConfig 언어별 오염 분석 설정 (languages[].taint)
+// 사용자 입력(소스)이 정제되지 않고 위험한 API(싱크)로 전달되는지 찾는 휴리스틱 규칙이 사용합니다
+type TaintConfig struct {
+	Sources    []TaintPattern `yaml:"sources,omitempty"`
+	Sinks      []TaintPattern `yaml:"sinks,omitempty"`
+	Sanitizers []string       `yaml:"sanitizers,omitempty"`  // 이 문자열이 있는 라인은 정제된 것으로 보고 제외
+	NoDefaults bool           `yaml:"no_defaults,omitempty"` // 기본 제공 소스/싱크/정제 함수를 쓰지 않고 설정한 것만 사용
+}
+
+// TaintPattern 소스 또는 싱크 하나
+type TaintPattern struct {
+	Name    string `yaml:"name"`
+	Pattern string `yaml:"pattern"`        // 정규식
+	Kind    string `yaml:"kind,omitempty"` // 싱크 종류 (sql/xss/redirect, 그 외 값은 일반 메시지로 보고)
+}
+
+// defaultTaint 기본 제공 소스/싱크 (사내 프레임워크는 rules.yaml의 languages[].taint로 추가)
+var defaultTaint = map[string]TaintConfig{
+	"java": {
+		Sources: []TaintPattern{
+			{Name: "request-param", Pattern: `\brequest\.getParameter(?:Values|Map)?\s*\(|@RequestParam\b|@PathVariable\b`},
+			{Name: "request-header", Pattern: `\brequest\.getHeader\s*\(|@RequestHeader\b`},
+			{Name: "cookie", Pattern: `\brequest\.getCookies\s*\(|@CookieValue\b`},
+		},
+		Sinks: []TaintPattern{
+			{Name: "sql-exec", Kind: TaintSQL, Pattern: `\.(?:executeQuery|executeUpdate|execute|addBatch|createQuery|createNativeQuery|queryForObject|queryForList|queryForMap|query|update)\s*\(`},
+			{Name: "response-write", Kind: TaintXSS, Pattern: `\.getWriter\(\)\s*\.(?:print|println|write)\s*\(`},
+			{Name: "redirect", Kind: TaintRedirect, Pattern: `\.sendRedirect\s*\(|"redirect:"\s*\+|new\s+RedirectView\s*\(`},
+		},
+		Sanitizers: []string{"HtmlUtils.htmlEscape", "ESAPI.encoder", "StringEscapeUtils.escape", "Encode.for"},
+	},
+	"javascript": {
+		Sources: []TaintPattern{
+			{Name: "url", Pattern: `\blocation\.(?:hash|search|href)\b|\bdocument\.(?:URL|documentURI|referrer)\b|\bwindow\.name\b`},
+			{Name: "url-params", Pattern: `\bnew\s+URLSearchParams\b|\.searchParams\.get\s*\(`},
+			{Name: "request", Pattern: `\breq\.(?:query|params|body|headers|cookies)\b`},
+		},
+		Sinks: []TaintPattern{
+			{Name: "html", Kind: TaintXSS, Pattern: `\.(?:innerHTML|outerHTML)\s*=[^=]|\.insertAdjacentHTML\s*\(|\bdocument\.write(?:ln)?\s*\(`},
+			{Name: "redirect", Kind: TaintRedirect, Pattern: `\blocation(?:\.href)?\s*=[^=]|\blocation\.(?:assign|replace)\s*\(|\bres\.redirect\s*\(`},
+			{Name: "sql-exec", Kind: TaintSQL, Pattern: `\.(?:query|execute|raw)\s*\(`},
+		},
+		Sanitizers: []string{"DOMPurify.sanitize", "escapeHtml", "encodeURIComponent", "sanitize"},
+	},
+}
+
+// Taint 언어의 오염 분석 설정 (기본 제공 설정에 languages[].taint 항목을 추가)
+func (c *Config) Taint(language string) TaintConfig {
+	var configured TaintConfig
+	for _, langRules := range c.Languages {
+		if langRules.Language == language {
+			configured = configured.merge(langRules.Taint)
+		}
+	}
+	if configured.NoDefaults {
+		return configured
+	}
+	return defaultTaint[language].merge(configured)
+}
+
+// merge 두 설정의 소스/싱크/정제 함수를 합침
+func (t TaintConfig) merge(other TaintConfig) TaintConfig {
+	return TaintConfig{
+		Sources:    append(append([]TaintPattern{}, t.Sources...), other.Sources...),
+		Sinks:      append(append([]TaintPattern{}, t.Sinks...), other.Sinks...),
+		Sanitizers: append(append([]string{}, t.Sanitizers...), other.Sanitizers...),
+		NoDefaults: t.NoDefaults || other.NoDefaults,
+	}
+}
+
+// validateTaint 소스/싱크 정규식 확인 (분석 중에 조용히 무시되지 않도록)
+func (c *Config) validateTaint() error {
+	for _, langRules := range c.Languages {
+		patterns := append(append([]TaintPattern{}, langRules.Taint.Sources...), langRules.Taint.Sinks...)
+		for _, pattern := range patterns {
+			if pattern.Pattern == "" {
+				return fmt.Errorf("%s 오염 분석 설정 %s: pattern이 비어있습니다", langRules.Language, pattern.Name)
+			}
+			if _, err := regexp.Compile(pattern.Pattern); err != nil {
+				return fmt.Errorf("%s 오염 분석 설정 %s 정규식 오류: %w", langRules.Language, pattern.Name, err)
+			}
+		}
+	}
+	return nil
+}
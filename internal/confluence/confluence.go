@@ -0,0 +1,252 @@
+package confluence
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// maxIssuesPerRule 페이지에 규칙별로 표시할 최대 이슈 수 (페이지 크기 제한)
+const maxIssuesPerRule = 50
+
+// severityColours 심각도별 status 매크로 색상
+var severityColours = map[config.Severity]string{
+	config.SeverityCritical: "Red",
+	config.SeverityHigh:     "Yellow",
+	config.SeverityMedium:   "Blue",
+	config.SeverityLow:      "Green",
+}
+
+// Client Confluence REST API 클라이언트
+type Client struct {
+	config config.ConfluenceConfig
+	token  string
+	client *http.Client
+}
+
+// NewClient 설정으로 클라이언트 생성 (토큰은 환경 변수에서 읽음)
+func NewClient(cfg config.ConfluenceConfig) (*Client, error) {
+	if cfg.URL == "" || cfg.Space == "" || cfg.Title == "" {
+		return nil, fmt.Errorf("confluence 설정에 url, space, title이 필요합니다")
+	}
+
+	token := os.Getenv(cfg.TokenEnv)
+	if token == "" {
+		return nil, fmt.Errorf("Confluence 토큰 환경 변수(%s)가 설정되지 않았습니다", cfg.TokenEnv)
+	}
+
+	return &Client{
+		config: cfg,
+		token:  token,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// content Confluence 페이지 (REST API 요청/응답)
+type content struct {
+	ID        string     `json:"id,omitempty"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Space     *space     `json:"space,omitempty"`
+	Ancestors []ancestor `json:"ancestors,omitempty"`
+	Version   *version   `json:"version,omitempty"`
+	Body      *body      `json:"body,omitempty"`
+}
+
+type space struct {
+	Key string `json:"key"`
+}
+
+type ancestor struct {
+	ID string `json:"id"`
+}
+
+type version struct {
+	Number int `json:"number"`
+}
+
+type body struct {
+	Storage storage `json:"storage"`
+}
+
+type storage struct {
+	Value          string `json:"value"`
+	Representation string `json:"representation"`
+}
+
+// Publish 리포트 페이지 생성 또는 갱신, 페이지 URL 반환
+func (c *Client) Publish(result *types.AnalysisResult) (string, error) {
+	page := &content{
+		Type:  "page",
+		Title: c.config.Title,
+		Space: &space{Key: c.config.Space},
+		Body:  &body{Storage: storage{Value: RenderStorage(result), Representation: "storage"}},
+	}
+	if c.config.ParentID != "" {
+		page.Ancestors = []ancestor{{ID: c.config.ParentID}}
+	}
+
+	existing, err := c.findPage()
+	if err != nil {
+		return "", err
+	}
+
+	var saved content
+	if existing == nil {
+		err = c.do(http.MethodPost, "/rest/api/content", page, &saved)
+	} else {
+		page.ID = existing.ID
+		page.Version = &version{Number: existing.Version.Number + 1}
+		err = c.do(http.MethodPut, "/rest/api/content/"+existing.ID, page, &saved)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimRight(c.config.URL, "/") + "/pages/viewpage.action?pageId=" + saved.ID, nil
+}
+
+// findPage 스페이스에서 같은 제목의 페이지 검색 (없으면 nil)
+func (c *Client) findPage() (*content, error) {
+	query := url.Values{}
+	query.Set("spaceKey", c.config.Space)
+	query.Set("title", c.config.Title)
+	query.Set("expand", "version")
+
+	var found struct {
+		Results []content `json:"results"`
+	}
+	if err := c.do(http.MethodGet, "/rest/api/content?"+query.Encode(), nil, &found); err != nil {
+		return nil, err
+	}
+	if len(found.Results) == 0 || found.Results[0].Version == nil {
+		return nil, nil
+	}
+	return &found.Results[0], nil
+}
+
+// do REST API 호출 (username이 있으면 Basic 인증, 없으면 개인 액세스 토큰 Bearer 인증)
+func (c *Client) do(method, path string, request, response interface{}) error {
+	var reqBody io.Reader
+	if request != nil {
+		data, err := json.Marshal(request)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimRight(c.config.URL, "/")+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if request != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.config.Username != "" {
+		req.SetBasicAuth(c.config.Username, c.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		data, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("Confluence API %s %s: HTTP %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// RenderStorage 분석 결과를 Confluence storage 형식(XHTML)으로 변환
+func RenderStorage(result *types.AnalysisResult) string {
+	var page strings.Builder
+
+	page.WriteString("<h2>분석 요약</h2>")
+	page.WriteString("<table><tbody>")
+	writeRow(&page, "분석 완료 시간", html.EscapeString(result.EndTime.Format("2006-01-02 15:04:05")))
+	writeRow(&page, "검사 파일 수", fmt.Sprintf("%d개", result.Summary.TotalFiles))
+	writeRow(&page, "발견된 이슈", fmt.Sprintf("%d개", result.Summary.TotalIssues))
+	for _, severity := range []config.Severity{config.SeverityCritical, config.SeverityHigh, config.SeverityMedium, config.SeverityLow} {
+		writeRow(&page, statusMacro(severity), fmt.Sprintf("%d개", result.Summary.SeverityCount[severity]))
+	}
+	page.WriteString("</tbody></table>")
+
+	// 품질 게이트
+	if len(result.Gates) > 0 {
+		page.WriteString("<h2>품질 게이트</h2><table><tbody>")
+		page.WriteString("<tr><th>카테고리</th><th>이슈</th><th>허용</th><th>결과</th></tr>")
+		for _, gate := range result.Gates {
+			status := `<ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">Green</ac:parameter><ac:parameter ac:name="title">PASS</ac:parameter></ac:structured-macro>`
+			if !gate.Passed {
+				status = `<ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">Red</ac:parameter><ac:parameter ac:name="title">FAIL</ac:parameter></ac:structured-macro>`
+			}
+			page.WriteString(fmt.Sprintf("<tr><td>%s</td><td>%d</td><td>%d</td><td>%s</td></tr>",
+				html.EscapeString(gate.Category), gate.Count, gate.Max, status))
+		}
+		page.WriteString("</tbody></table>")
+	}
+
+	// 규칙별 이슈 (규칙마다 접을 수 있는 expand 매크로)
+	issuesByRule := make(map[string][]types.Issue)
+	for _, issue := range result.Issues {
+		issuesByRule[issue.RuleID] = append(issuesByRule[issue.RuleID], issue)
+	}
+	ruleIDs := make([]string, 0, len(issuesByRule))
+	for ruleID := range issuesByRule {
+		ruleIDs = append(ruleIDs, ruleID)
+	}
+	sort.Strings(ruleIDs)
+
+	if len(ruleIDs) > 0 {
+		page.WriteString("<h2>규칙별 이슈</h2>")
+	}
+	for _, ruleID := range ruleIDs {
+		issues := issuesByRule[ruleID]
+		page.WriteString(`<ac:structured-macro ac:name="expand">`)
+		page.WriteString(fmt.Sprintf(`<ac:parameter ac:name="title">%s (%d개)</ac:parameter>`, html.EscapeString(ruleID), len(issues)))
+		page.WriteString("<ac:rich-text-body><table><tbody>")
+		page.WriteString("<tr><th>심각도</th><th>위치</th><th>메시지</th><th>코드</th></tr>")
+		for i, issue := range issues {
+			if i >= maxIssuesPerRule {
+				page.WriteString(fmt.Sprintf(`<tr><td colspan="4">... 및 %d개 추가 이슈</td></tr>`, len(issues)-i))
+				break
+			}
+			page.WriteString(fmt.Sprintf("<tr><td>%s</td><td><code>%s:%d</code></td><td>%s</td><td><code>%s</code></td></tr>",
+				statusMacro(issue.Severity),
+				html.EscapeString(issue.File), issue.Line,
+				html.EscapeString(issue.Message),
+				html.EscapeString(issue.CodeSnippet)))
+		}
+		page.WriteString("</tbody></table></ac:rich-text-body></ac:structured-macro>")
+	}
+
+	return page.String()
+}
+
+// writeRow 2열 표의 한 행 추가
+func writeRow(page *strings.Builder, header, value string) {
+	page.WriteString("<tr><th>" + header + "</th><td>" + value + "</td></tr>")
+}
+
+// statusMacro 심각도 status 매크로
+func statusMacro(severity config.Severity) string {
+	return `<ac:structured-macro ac:name="status"><ac:parameter ac:name="colour">` + severityColours[severity] +
+		`</ac:parameter><ac:parameter ac:name="title">` + strings.ToUpper(severity.String()) + `</ac:parameter></ac:structured-macro>`
+}
@@ -0,0 +1,291 @@
+package editorconfig
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// FileName EditorConfig 파일명
+const FileName = ".editorconfig"
+
+// Properties 파일 하나에 적용되는 EditorConfig 속성 (키/값 모두 소문자)
+type Properties map[string]string
+
+// IndentStyle indent_style 값 ("tab", "space", 지정 안 됨은 "")
+func (p Properties) IndentStyle() string {
+	return p["indent_style"]
+}
+
+// IndentSize indent_size 값 ("tab"이면 tab_width 사용, 지정 안 됨은 0)
+func (p Properties) IndentSize() int {
+	value := p["indent_size"]
+	if value == "tab" {
+		value = p["tab_width"]
+	}
+	size, _ := strconv.Atoi(value)
+	return size
+}
+
+// MaxLineLength max_line_length 값 (지정 안 됨은 0, "off"는 -1)
+func (p Properties) MaxLineLength() int {
+	value := p["max_line_length"]
+	if value == "off" {
+		return -1
+	}
+	length, _ := strconv.Atoi(value)
+	return length
+}
+
+// InsertFinalNewline insert_final_newline 값 (지정 안 됨은 ok=false)
+func (p Properties) InsertFinalNewline() (value bool, ok bool) {
+	switch p["insert_final_newline"] {
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// section .editorconfig의 [glob] 섹션
+type section struct {
+	pattern    *regexp.Regexp
+	properties map[string]string
+}
+
+// file 파싱된 .editorconfig 파일
+type file struct {
+	root     bool
+	sections []section
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = make(map[string]*file) // 경로별 파싱 결과 (파일이 없으면 nil)
+)
+
+// Resolve 파일에 적용되는 속성 계산 (상위 디렉토리의 .editorconfig를 root=true까지 탐색, 가까운 파일이 우선)
+func Resolve(filePath string) (Properties, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// 가까운 디렉토리부터 수집
+	var dirs []string
+	var files []*file
+	for dir := filepath.Dir(absPath); ; dir = filepath.Dir(dir) {
+		config, err := load(filepath.Join(dir, FileName))
+		if err != nil {
+			return nil, err
+		}
+		if config != nil {
+			dirs = append(dirs, dir)
+			files = append(files, config)
+			if config.root {
+				break
+			}
+		}
+		if filepath.Dir(dir) == dir {
+			break
+		}
+	}
+
+	// 먼 파일부터 적용하여 가까운 파일의 값이 덮어쓰도록 함
+	props := Properties{}
+	for i := len(files) - 1; i >= 0; i-- {
+		relPath, err := filepath.Rel(dirs[i], absPath)
+		if err != nil {
+			continue
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		for _, sec := range files[i].sections {
+			if sec.pattern.MatchString(relPath) {
+				for key, value := range sec.properties {
+					props[key] = value
+				}
+			}
+		}
+	}
+
+	// indent_style=tab이고 indent_size가 없으면 tab 크기를 따름
+	if props["indent_style"] == "tab" && props["indent_size"] == "" {
+		props["indent_size"] = "tab"
+	}
+
+	return props, nil
+}
+
+// load .editorconfig 파일 로드 (없으면 nil, 한 번 읽은 파일은 재사용)
+func load(path string) (*file, error) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	if config, ok := cache[path]; ok {
+		return config, nil
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		cache[path] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	config := &file{}
+	var current *section
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		// [glob] 섹션 시작
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			pattern, err := CompileGlob(line[1 : len(line)-1])
+			if err != nil {
+				current = nil
+				continue
+			}
+			config.sections = append(config.sections, section{pattern: pattern, properties: make(map[string]string)})
+			current = &config.sections[len(config.sections)-1]
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.ToLower(strings.TrimSpace(value))
+
+		if current == nil {
+			// 섹션 앞의 속성은 root만 의미가 있음
+			if key == "root" {
+				config.root = value == "true"
+			}
+			continue
+		}
+		current.properties[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	cache[path] = config
+	return config, nil
+}
+
+// CompileGlob EditorConfig 문법의 glob을 정규식으로 변환 (*, **, ?, [...], {a,b}, {n1..n2}, 규칙의 파일 패턴 설정에도 사용)
+func CompileGlob(glob string) (*regexp.Regexp, error) {
+	// '/'가 없는 패턴은 모든 하위 디렉토리의 파일명에 매칭
+	if !strings.Contains(glob, "/") {
+		glob = "**/" + glob
+	} else {
+		glob = strings.TrimPrefix(glob, "/")
+	}
+
+	var expr strings.Builder
+	expr.WriteString("^")
+
+	braceDepth := 0
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				// "**/"는 0개 이상의 디렉토리
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					expr.WriteString("(?:.*/)?")
+				} else {
+					expr.WriteString(".*")
+				}
+			} else {
+				expr.WriteString("[^/]*")
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end == -1 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end
+		case '{':
+			end := strings.IndexByte(glob[i:], '}')
+			if end == -1 {
+				expr.WriteString(`\{`)
+				continue
+			}
+			if rangeExpr, ok := numericRange(glob[i+1 : i+end]); ok {
+				expr.WriteString(rangeExpr)
+				i += end
+				continue
+			}
+			braceDepth++
+			expr.WriteString("(?:")
+		case '}':
+			if braceDepth > 0 {
+				braceDepth--
+				expr.WriteString(")")
+			} else {
+				expr.WriteString(`\}`)
+			}
+		case ',':
+			if braceDepth > 0 {
+				expr.WriteString("|")
+			} else {
+				expr.WriteString(",")
+			}
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				expr.WriteString(regexp.QuoteMeta(string(glob[i])))
+			}
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	expr.WriteString("$")
+	return regexp.Compile(expr.String())
+}
+
+// numericRange {n1..n2} 숫자 범위를 정규식 선택지로 변환
+func numericRange(body string) (string, bool) {
+	from, to, found := strings.Cut(body, "..")
+	if !found {
+		return "", false
+	}
+	start, err1 := strconv.Atoi(from)
+	end, err2 := strconv.Atoi(to)
+	if err1 != nil || err2 != nil || start > end || end-start > 1000 {
+		return "", false
+	}
+
+	values := make([]string, 0, end-start+1)
+	for n := start; n <= end; n++ {
+		values = append(values, strconv.Itoa(n))
+	}
+	return "(?:" + strings.Join(values, "|") + ")", true
+}
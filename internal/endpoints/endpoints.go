@@ -0,0 +1,266 @@
+package endpoints
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"code-quality-checker/internal/parser"
+)
+
+// mappingMethods 매핑 어노테이션별 HTTP 메소드 (RequestMapping은 method 속성에서 읽음)
+var mappingMethods = map[string]string{
+	"RequestMapping": "",
+	"GetMapping":     "GET",
+	"PostMapping":    "POST",
+	"PutMapping":     "PUT",
+	"DeleteMapping":  "DELETE",
+	"PatchMapping":   "PATCH",
+}
+
+// methodAny method 속성이 없는 @RequestMapping (모든 HTTP 메소드 허용)
+const methodAny = "ANY"
+
+// securityAnnotations 메소드 권한 검사 어노테이션
+var securityAnnotations = []string{"PreAuthorize", "PostAuthorize", "Secured", "RolesAllowed"}
+
+var (
+	annotationNameRegex = regexp.MustCompile(`^@(\w+)\s*(?:\((.*)\))?`)
+	namedPathRegex      = regexp.MustCompile(`\b(?:value|path)\s*=\s*(\{[^}]*\}|"[^"]*")`)
+	leadingPathRegex    = regexp.MustCompile(`^\s*(\{[^}]*\}|"[^"]*")`)
+	stringLiteralRegex  = regexp.MustCompile(`"([^"]*)"`)
+	requestMethodRegex  = regexp.MustCompile(`RequestMethod\.(\w+)`)
+)
+
+// Endpoint Spring 컨트롤러 엔드포인트
+type Endpoint struct {
+	Method      string   `json:"method"` // GET, POST, ... 또는 ANY
+	Path        string   `json:"path"`
+	Handler     string   `json:"handler"` // 클래스.메소드
+	File        string   `json:"file"`
+	Line        int      `json:"line"`
+	Security    []string `json:"security,omitempty"` // 적용된 보안 어노테이션 (클래스 포함)
+	Mutating    bool     `json:"mutating"`
+	Unprotected bool     `json:"unprotected"` // 보안 어노테이션이 없거나 permitAll인 변경 엔드포인트
+}
+
+// Inventory 엔드포인트 목록
+type Inventory struct {
+	Endpoints   []Endpoint `json:"endpoints"`
+	Unprotected int        `json:"unprotected"`
+}
+
+// Collector 분석 중 파싱된 Java 파일에서 엔드포인트 수집 (analyzer.Hook의 OnFileParsed로 사용)
+type Collector struct {
+	mu        sync.Mutex
+	endpoints []Endpoint
+}
+
+// Add 파싱된 파일의 엔드포인트 추가
+func (c *Collector) Add(file *parser.ParsedFile) {
+	found := Extract(file)
+	if len(found) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.endpoints = append(c.endpoints, found...)
+}
+
+// Inventory 수집한 엔드포인트를 경로, 메소드 순으로 정렬하여 반환
+func (c *Collector) Inventory() *Inventory {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inventory := &Inventory{Endpoints: append([]Endpoint{}, c.endpoints...)}
+	sort.SliceStable(inventory.Endpoints, func(i, j int) bool {
+		a, b := inventory.Endpoints[i], inventory.Endpoints[j]
+		if a.Path != b.Path {
+			return a.Path < b.Path
+		}
+		return a.Method < b.Method
+	})
+	for _, endpoint := range inventory.Endpoints {
+		if endpoint.Unprotected {
+			inventory.Unprotected++
+		}
+	}
+	return inventory
+}
+
+// Extract 컨트롤러 클래스의 매핑 어노테이션에서 엔드포인트 추출
+func Extract(file *parser.ParsedFile) []Endpoint {
+	class, ok := file.AST.(*parser.JavaClass)
+	if !ok || !isController(class.Annotations) {
+		return nil
+	}
+
+	prefixes := []string{""}
+	var classSecurity []string
+	for _, annotation := range class.Annotations {
+		if name, args := parseAnnotation(annotation); name == "RequestMapping" {
+			prefixes = mappingPaths(args)
+		} else if isSecurityAnnotation(name) {
+			classSecurity = append(classSecurity, annotation)
+		}
+	}
+
+	var endpoints []Endpoint
+	for _, method := range class.Methods {
+		security := append([]string{}, classSecurity...)
+		var httpMethods, paths []string
+		mapped := false
+		for _, annotation := range method.Annotations {
+			name, args := parseAnnotation(annotation)
+			if fixed, ok := mappingMethods[name]; ok {
+				mapped = true
+				paths = mappingPaths(args)
+				if fixed != "" {
+					httpMethods = []string{fixed}
+				} else {
+					httpMethods = requestMethods(args)
+				}
+			} else if isSecurityAnnotation(name) {
+				security = append(security, annotation)
+			}
+		}
+		if !mapped {
+			continue
+		}
+
+		protected := len(security) > 0
+		for _, annotation := range security {
+			if strings.Contains(annotation, "permitAll") {
+				protected = false
+			}
+		}
+
+		for _, prefix := range prefixes {
+			for _, path := range paths {
+				for _, httpMethod := range httpMethods {
+					mutating := httpMethod != "GET" && httpMethod != "HEAD" && httpMethod != "OPTIONS"
+					endpoints = append(endpoints, Endpoint{
+						Method:      httpMethod,
+						Path:        joinPath(prefix, path),
+						Handler:     class.Name + "." + method.Name,
+						File:        file.Path,
+						Line:        method.Line,
+						Security:    security,
+						Mutating:    mutating,
+						Unprotected: mutating && !protected,
+					})
+				}
+			}
+		}
+	}
+	return endpoints
+}
+
+// isController @Controller/@RestController 클래스인지 확인
+func isController(annotations []string) bool {
+	for _, annotation := range annotations {
+		if name, _ := parseAnnotation(annotation); name == "Controller" || name == "RestController" {
+			return true
+		}
+	}
+	return false
+}
+
+func isSecurityAnnotation(name string) bool {
+	for _, security := range securityAnnotations {
+		if name == security {
+			return true
+		}
+	}
+	return false
+}
+
+// parseAnnotation 어노테이션 이름과 괄호 안 인자 분리
+func parseAnnotation(annotation string) (string, string) {
+	match := annotationNameRegex.FindStringSubmatch(annotation)
+	if match == nil {
+		return "", ""
+	}
+	return match[1], match[2]
+}
+
+// mappingPaths 매핑 어노테이션의 경로 목록 (value/path 속성 또는 첫 인자, 없으면 "")
+func mappingPaths(args string) []string {
+	var literal string
+	if match := namedPathRegex.FindStringSubmatch(args); match != nil {
+		literal = match[1]
+	} else if match := leadingPathRegex.FindStringSubmatch(args); match != nil {
+		literal = match[1]
+	}
+
+	var paths []string
+	for _, match := range stringLiteralRegex.FindAllStringSubmatch(literal, -1) {
+		paths = append(paths, match[1])
+	}
+	if len(paths) == 0 {
+		return []string{""}
+	}
+	return paths
+}
+
+// requestMethods @RequestMapping의 method 속성 (없으면 ANY)
+func requestMethods(args string) []string {
+	var methods []string
+	for _, match := range requestMethodRegex.FindAllStringSubmatch(args, -1) {
+		methods = append(methods, match[1])
+	}
+	if len(methods) == 0 {
+		return []string{methodAny}
+	}
+	return methods
+}
+
+// joinPath 클래스와 메소드 경로 결합
+func joinPath(prefix, path string) string {
+	joined := strings.TrimRight(prefix, "/") + "/" + strings.TrimLeft(path, "/")
+	if joined != "/" {
+		joined = strings.TrimRight(joined, "/")
+	}
+	if !strings.HasPrefix(joined, "/") {
+		joined = "/" + joined
+	}
+	return joined
+}
+
+// WriteJSON 엔드포인트 목록을 JSON으로 출력
+func WriteJSON(w io.Writer, inventory *Inventory) error {
+	data, err := json.MarshalIndent(inventory, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON 마샬링 실패: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteConsole 엔드포인트 목록을 표 형태로 출력 (인증 없는 변경 엔드포인트 표시)
+func WriteConsole(w io.Writer, inventory *Inventory) error {
+	var output strings.Builder
+
+	output.WriteString("🌐 엔드포인트 목록\n")
+	output.WriteString(strings.Repeat("=", 50) + "\n\n")
+
+	for _, endpoint := range inventory.Endpoints {
+		marker := "  "
+		if endpoint.Unprotected {
+			marker = "🔓"
+		}
+		output.WriteString(fmt.Sprintf("%s %-7s %s\n", marker, endpoint.Method, endpoint.Path))
+		output.WriteString(fmt.Sprintf("     %s (%s:%d)\n", endpoint.Handler, endpoint.File, endpoint.Line))
+		if len(endpoint.Security) > 0 {
+			output.WriteString(fmt.Sprintf("     🔐 %s\n", strings.Join(endpoint.Security, ", ")))
+		}
+	}
+
+	output.WriteString(fmt.Sprintf("\n총 %d개 엔드포인트, 인증 없는 변경 엔드포인트 %d개\n", len(inventory.Endpoints), inventory.Unprotected))
+	_, err := io.WriteString(w, output.String())
+	return err
+}
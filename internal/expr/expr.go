@@ -0,0 +1,515 @@
+// Package expr 사용자 정의 규칙의 조건식 (pattern.conditions)
+//
+// 파서가 추출한 사실(fact)을 조합하는 작은 식 언어입니다.
+//
+//	class.hasAnnotation('@Service') && method.callCount('Repository') >= 2
+//
+// 지원 문법: || && ! 괄호, 비교 연산자(== != < <= > >=), 숫자/문자열('...' 또는 "...")/true/false 리터럴,
+// 객체.속성과 객체.함수('인자') 형태의 사실 참조. 사용할 수 있는 사실은 Facts에 정의되어 있습니다.
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kind 식의 값 종류
+type Kind int
+
+const (
+	Bool Kind = iota
+	Number
+	String
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Number:
+		return "숫자"
+	case String:
+		return "문자열"
+	default:
+		return "참/거짓"
+	}
+}
+
+// 사실을 제공하는 객체 (범위가 좁은 순서: method > class > file)
+const (
+	ObjectFile   = "file"
+	ObjectClass  = "class"
+	ObjectMethod = "method"
+)
+
+// Fact 식에서 참조할 수 있는 사실
+type Fact struct {
+	Object      string
+	Name        string
+	Result      Kind
+	Arg         bool   // 문자열 인자 하나를 받는 함수
+	Regex       bool   // 인자가 정규식 (파싱할 때 컴파일)
+	Description string // README/도움말용 설명
+}
+
+// Facts 사용할 수 있는 사실 목록 (Env가 모두 제공해야 함)
+var Facts = []Fact{
+	{Object: ObjectFile, Name: "path", Result: String, Description: "파일 경로"},
+	{Object: ObjectFile, Name: "lines", Result: Number, Description: "파일 라인 수"},
+	{Object: ObjectFile, Name: "contains", Result: Bool, Arg: true, Description: "파일에 문자열이 있는지"},
+	{Object: ObjectFile, Name: "matches", Result: Bool, Arg: true, Regex: true, Description: "파일에 정규식과 일치하는 부분이 있는지"},
+
+	{Object: ObjectClass, Name: "name", Result: String, Description: "클래스 이름"},
+	{Object: ObjectClass, Name: "package", Result: String, Description: "패키지 이름"},
+	{Object: ObjectClass, Name: "nameMatches", Result: Bool, Arg: true, Regex: true, Description: "클래스 이름이 정규식과 일치하는지"},
+	{Object: ObjectClass, Name: "hasAnnotation", Result: Bool, Arg: true, Description: "클래스에 어노테이션이 있는지 ('@' 생략 가능)"},
+	{Object: ObjectClass, Name: "hasImport", Result: Bool, Arg: true, Description: "문자열을 포함하는 import가 있는지"},
+	{Object: ObjectClass, Name: "hasFieldType", Result: Bool, Arg: true, Description: "타입 이름에 문자열을 포함하는 필드가 있는지"},
+	{Object: ObjectClass, Name: "methodCount", Result: Number, Description: "메소드 수"},
+	{Object: ObjectClass, Name: "fieldCount", Result: Number, Description: "필드 수"},
+
+	{Object: ObjectMethod, Name: "name", Result: String, Description: "메소드 이름"},
+	{Object: ObjectMethod, Name: "returnType", Result: String, Description: "반환 타입"},
+	{Object: ObjectMethod, Name: "nameMatches", Result: Bool, Arg: true, Regex: true, Description: "메소드 이름이 정규식과 일치하는지"},
+	{Object: ObjectMethod, Name: "hasAnnotation", Result: Bool, Arg: true, Description: "메소드에 어노테이션이 있는지 ('@' 생략 가능)"},
+	{Object: ObjectMethod, Name: "params", Result: Number, Description: "파라미터 수"},
+	{Object: ObjectMethod, Name: "lines", Result: Number, Description: "본문 라인 수"},
+	{Object: ObjectMethod, Name: "isPublic", Result: Bool, Description: "public 여부"},
+	{Object: ObjectMethod, Name: "isPrivate", Result: Bool, Description: "private 여부"},
+	{Object: ObjectMethod, Name: "isStatic", Result: Bool, Description: "static 여부"},
+	{Object: ObjectMethod, Name: "callCount", Result: Number, Arg: true, Description: "호출 대상(변수) 또는 메소드 이름에 문자열을 포함하는 호출 수 (대소문자 무시)"},
+	{Object: ObjectMethod, Name: "contains", Result: Bool, Arg: true, Description: "본문에 문자열이 있는지 (주석 제외)"},
+	{Object: ObjectMethod, Name: "matches", Result: Bool, Arg: true, Regex: true, Description: "본문에 정규식과 일치하는 부분이 있는지 (주석 제외)"},
+}
+
+// Env 식을 평가할 때 사실 값을 제공 (Bool은 bool, Number는 int 또는 float64, String은 string 반환)
+type Env interface {
+	Fact(fact *Fact, arg string, regex *regexp.Regexp) interface{}
+}
+
+// Expr 파싱된 조건식
+type Expr struct {
+	source  string
+	root    node
+	objects map[string]bool
+}
+
+// Parse 조건식 파싱 (문법, 사실 이름, 인자, 값 종류까지 확인)
+func Parse(source string) (*Expr, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, objects: make(map[string]bool)}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorAt(tok, fmt.Sprintf("예상하지 못한 '%s'", tok.text))
+	}
+	if root.kind() != Bool {
+		return nil, fmt.Errorf("조건식의 결과가 참/거짓이 아닙니다 (%s)", root.kind())
+	}
+
+	return &Expr{source: source, root: root, objects: p.objects}, nil
+}
+
+// Uses 식이 객체(file/class/method)의 사실을 참조하는지
+func (e *Expr) Uses(object string) bool {
+	return e.objects[object]
+}
+
+// Eval 식 평가
+func (e *Expr) Eval(env Env) bool {
+	return e.root.eval(env).(bool)
+}
+
+func (e *Expr) String() string {
+	return e.source
+}
+
+// lookupFact 사실 정의 찾기
+func lookupFact(object, name string) *Fact {
+	for i := range Facts {
+		if Facts[i].Object == object && Facts[i].Name == name {
+			return &Facts[i]
+		}
+	}
+	return nil
+}
+
+// 토큰
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOp
+)
+
+type token struct {
+	kind tokenKind
+	text string // 문자열 토큰은 따옴표를 벗긴 값
+	pos  int
+}
+
+// tokenize 조건식을 토큰으로 분리
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case isIdentStart(c):
+			start := i
+			for i < len(source) && (isIdentStart(source[i]) || isDigit(source[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: source[start:i], pos: start})
+		case isDigit(c):
+			start := i
+			for i < len(source) && (isDigit(source[i]) || source[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: source[start:i], pos: start})
+		case c == '\'' || c == '"':
+			start := i
+			var value strings.Builder
+			for i++; i < len(source) && source[i] != c; i++ {
+				if source[i] == '\\' && i+1 < len(source) && (source[i+1] == c || source[i+1] == '\\') {
+					i++
+				}
+				value.WriteByte(source[i])
+			}
+			if i >= len(source) {
+				return nil, fmt.Errorf("%d번째 문자: 문자열이 닫히지 않았습니다", start+1)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: value.String(), pos: start})
+		default:
+			op := ""
+			for _, candidate := range []string{"&&", "||", "==", "!=", "<=", ">=", "<", ">", "!", "(", ")", "."} {
+				if strings.HasPrefix(source[i:], candidate) {
+					op = candidate
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("%d번째 문자: 알 수 없는 문자 '%c'", i+1, c)
+			}
+			tokens = append(tokens, token{kind: tokenOp, text: op, pos: i})
+			i += len(op)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, text: "식의 끝", pos: len(source)}), nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// 파서 (우선순위: || < && < ! < 비교 < 항)
+
+type parser struct {
+	tokens  []token
+	pos     int
+	objects map[string]bool
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+// accept 다음 토큰이 연산자 op이면 소비
+func (p *parser) accept(op string) bool {
+	if tok := p.peek(); tok.kind == tokenOp && tok.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) errorAt(tok token, message string) error {
+	return fmt.Errorf("%d번째 문자: %s", tok.pos+1, message)
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if !p.accept("||") {
+			return left, nil
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if left.kind() != Bool || right.kind() != Bool {
+			return nil, p.errorAt(tok, "'||'의 양쪽은 참/거짓이어야 합니다")
+		}
+		left = &logicalNode{and: false, left: left, right: right}
+	}
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		tok := p.peek()
+		if !p.accept("&&") {
+			return left, nil
+		}
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		if left.kind() != Bool || right.kind() != Bool {
+			return nil, p.errorAt(tok, "'&&'의 양쪽은 참/거짓이어야 합니다")
+		}
+		left = &logicalNode{and: true, left: left, right: right}
+	}
+}
+
+func (p *parser) parseNot() (node, error) {
+	tok := p.peek()
+	if !p.accept("!") {
+		return p.parseComparison()
+	}
+	operand, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	if operand.kind() != Bool {
+		return nil, p.errorAt(tok, "'!' 뒤에는 참/거짓이 와야 합니다")
+	}
+	return &notNode{operand: operand}, nil
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	tok := p.peek()
+	if tok.kind != tokenOp {
+		return left, nil
+	}
+	switch tok.text {
+	case "==", "!=", "<", "<=", ">", ">=":
+	default:
+		return left, nil
+	}
+	p.next()
+
+	right, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if left.kind() != right.kind() {
+		return nil, p.errorAt(tok, fmt.Sprintf("%s 값과 %s 값은 비교할 수 없습니다", left.kind(), right.kind()))
+	}
+	if tok.text != "==" && tok.text != "!=" && left.kind() != Number {
+		return nil, p.errorAt(tok, fmt.Sprintf("'%s'는 숫자끼리만 비교할 수 있습니다", tok.text))
+	}
+	return &compareNode{op: tok.text, left: left, right: right}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	tok := p.next()
+	switch tok.kind {
+	case tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorAt(tok, fmt.Sprintf("잘못된 숫자 '%s'", tok.text))
+		}
+		return &literalNode{value: value, valueKind: Number}, nil
+	case tokenString:
+		return &literalNode{value: tok.text, valueKind: String}, nil
+	case tokenOp:
+		if tok.text != "(" {
+			break
+		}
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(")") {
+			return nil, p.errorAt(p.peek(), "')'가 필요합니다")
+		}
+		return inner, nil
+	case tokenIdent:
+		switch tok.text {
+		case "true", "false":
+			return &literalNode{value: tok.text == "true", valueKind: Bool}, nil
+		}
+		return p.parseFact(tok)
+	}
+	return nil, p.errorAt(tok, fmt.Sprintf("예상하지 못한 '%s'", tok.text))
+}
+
+// parseFact 객체.속성 또는 객체.함수('인자')
+func (p *parser) parseFact(object token) (node, error) {
+	if object.text != ObjectFile && object.text != ObjectClass && object.text != ObjectMethod {
+		return nil, p.errorAt(object, fmt.Sprintf("알 수 없는 객체 '%s' (file, class, method 중 하나)", object.text))
+	}
+	if !p.accept(".") {
+		return nil, p.errorAt(p.peek(), fmt.Sprintf("'%s.' 뒤에 사실 이름이 필요합니다", object.text))
+	}
+	name := p.next()
+	if name.kind != tokenIdent {
+		return nil, p.errorAt(name, fmt.Sprintf("'%s.' 뒤에 사실 이름이 필요합니다", object.text))
+	}
+
+	fact := lookupFact(object.text, name.text)
+	if fact == nil {
+		return nil, p.errorAt(name, fmt.Sprintf("알 수 없는 사실 '%s.%s'", object.text, name.text))
+	}
+	p.objects[fact.Object] = true
+
+	n := &factNode{fact: fact}
+	called := p.accept("(")
+	if !fact.Arg {
+		if called {
+			return nil, p.errorAt(name, fmt.Sprintf("'%s.%s'는 인자를 받지 않습니다", object.text, name.text))
+		}
+		return n, nil
+	}
+	if !called {
+		return nil, p.errorAt(name, fmt.Sprintf("'%s.%s'에는 문자열 인자가 필요합니다 (예: %s.%s('...'))", object.text, name.text, object.text, name.text))
+	}
+	arg := p.next()
+	if arg.kind != tokenString {
+		return nil, p.errorAt(arg, fmt.Sprintf("'%s.%s'의 인자는 문자열 리터럴이어야 합니다", object.text, name.text))
+	}
+	if !p.accept(")") {
+		return nil, p.errorAt(p.peek(), "')'가 필요합니다")
+	}
+	n.arg = arg.text
+	if fact.Regex {
+		regex, err := regexp.Compile(arg.text)
+		if err != nil {
+			return nil, p.errorAt(arg, fmt.Sprintf("잘못된 정규식: %v", err))
+		}
+		n.regex = regex
+	}
+	return n, nil
+}
+
+// 식 노드 (종류는 파싱할 때 확인하므로 평가 중에는 타입 오류가 없음)
+
+type node interface {
+	kind() Kind
+	eval(env Env) interface{}
+}
+
+type literalNode struct {
+	value     interface{}
+	valueKind Kind
+}
+
+func (n *literalNode) kind() Kind               { return n.valueKind }
+func (n *literalNode) eval(env Env) interface{} { return n.value }
+
+type factNode struct {
+	fact  *Fact
+	arg   string
+	regex *regexp.Regexp
+}
+
+func (n *factNode) kind() Kind { return n.fact.Result }
+
+func (n *factNode) eval(env Env) interface{} {
+	value := env.Fact(n.fact, n.arg, n.regex)
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case nil:
+		// 제공되지 않은 사실은 종류별 빈 값으로 취급
+		switch n.fact.Result {
+		case Number:
+			return float64(0)
+		case String:
+			return ""
+		default:
+			return false
+		}
+	}
+	return value
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n *notNode) kind() Kind               { return Bool }
+func (n *notNode) eval(env Env) interface{} { return !n.operand.eval(env).(bool) }
+
+type logicalNode struct {
+	and         bool
+	left, right node
+}
+
+func (n *logicalNode) kind() Kind { return Bool }
+
+func (n *logicalNode) eval(env Env) interface{} {
+	left := n.left.eval(env).(bool)
+	if n.and != left {
+		return left // && 왼쪽이 거짓이거나 || 왼쪽이 참이면 오른쪽은 평가하지 않음
+	}
+	return n.right.eval(env).(bool)
+}
+
+type compareNode struct {
+	op          string
+	left, right node
+}
+
+func (n *compareNode) kind() Kind { return Bool }
+
+func (n *compareNode) eval(env Env) interface{} {
+	left, right := n.left.eval(env), n.right.eval(env)
+	switch n.op {
+	case "==":
+		return left == right
+	case "!=":
+		return left != right
+	}
+
+	l, r := left.(float64), right.(float64)
+	switch n.op {
+	case "<":
+		return l < r
+	case "<=":
+		return l <= r
+	case ">":
+		return l > r
+	default:
+		return l >= r
+	}
+}
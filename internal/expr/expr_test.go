@@ -0,0 +1,151 @@
+package expr
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// mapEnv 사실 이름(객체.이름 또는 객체.이름('인자'))별 값을 돌려주는 테스트용 Env
+type mapEnv map[string]interface{}
+
+func (e mapEnv) Fact(fact *Fact, arg string, regex *regexp.Regexp) interface{} {
+	key := fact.Object + "." + fact.Name
+	if fact.Arg {
+		key += "('" + arg + "')"
+	}
+	return e[key]
+}
+
+func TestParseEval(t *testing.T) {
+	env := mapEnv{
+		"file.path":                      "src/main/java/OrderService.java",
+		"file.lines":                     420,
+		"class.name":                     "OrderService",
+		"class.hasAnnotation('Service')": true,
+		"class.methodCount":              12,
+		"method.name":                    "placeOrder",
+		"method.params":                  3,
+		"method.lines":                   float64(55),
+		"method.isPublic":                true,
+		"method.isStatic":                false,
+		"method.callCount('repository')": 2,
+	}
+
+	tests := []struct {
+		source string
+		want   bool
+	}{
+		{"true", true},
+		{"false", false},
+		{"!false", true},
+		{"!!true", true},
+		{"method.isPublic", true},
+		{"method.params > 2", true},
+		{"method.params >= 4", false},
+		{"method.lines <= 55", true},
+		{"method.lines < 55", false},
+		{"file.lines == 420", true},
+		{"file.lines != 420", false},
+		{"1.5 < 2", true},
+		{"class.name == 'OrderService'", true},
+		{`class.name == "Order\"Service"`, false},
+		{"'it\\'s' == \"it's\"", true},
+		{"class.hasAnnotation('Service') && class.methodCount > 10", true},
+		{"method.isStatic || method.callCount('repository') >= 2", true},
+		// &&가 ||보다 먼저 묶임
+		{"true || false && false", true},
+		{"(true || false) && false", false},
+		{"!method.isStatic && method.isPublic", true},
+		{"!(method.isPublic && method.params > 1)", false},
+		// 제공되지 않은 사실은 종류별 빈 값
+		{"class.package == ''", true},
+		{"method.hasAnnotation('Transactional')", false},
+		{"class.fieldCount == 0", true},
+		{"method.matches('save\\\\(')", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			e, err := Parse(tt.source)
+			if err != nil {
+				t.Fatalf("Parse(%q) 오류: %v", tt.source, err)
+			}
+			if got := e.Eval(env); got != tt.want {
+				t.Errorf("Eval(%q) = %v, want %v", tt.source, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string // 오류 메시지에 포함되어야 하는 문자열
+	}{
+		{"", "1번째 문자: 예상하지 못한 '식의 끝'"},
+		{"method.params", "결과가 참/거짓이 아닙니다 (숫자)"},
+		{"'abc", "1번째 문자: 문자열이 닫히지 않았습니다"},
+		{"method.params # 2", "15번째 문자: 알 수 없는 문자 '#'"},
+		{"true true", "6번째 문자: 예상하지 못한 'true'"},
+		{"(true", "')'가 필요합니다"},
+		{"true &&", "예상하지 못한 '식의 끝'"},
+		{"method.params && true", "'&&'의 양쪽은 참/거짓이어야 합니다"},
+		{"false || 'x'", "'||'의 양쪽은 참/거짓이어야 합니다"},
+		{"!method.name", "'!' 뒤에는 참/거짓이 와야 합니다"},
+		{"method.params == 'three'", "숫자 값과 문자열 값은 비교할 수 없습니다"},
+		{"class.name < 'B'", "'<'는 숫자끼리만 비교할 수 있습니다"},
+		{"1.2.3 > 0", "잘못된 숫자 '1.2.3'"},
+		{"module.name == 'x'", "알 수 없는 객체 'module'"},
+		{"method", "'method.' 뒤에 사실 이름이 필요합니다"},
+		{"method.(", "'method.' 뒤에 사실 이름이 필요합니다"},
+		{"method.size > 1", "알 수 없는 사실 'method.size'"},
+		{"method.isPublic('x')", "'method.isPublic'는 인자를 받지 않습니다"},
+		{"method.contains", "'method.contains'에는 문자열 인자가 필요합니다"},
+		{"method.contains(1)", "'method.contains'의 인자는 문자열 리터럴이어야 합니다"},
+		{"method.contains('x'", "')'가 필요합니다"},
+		{"method.matches('(')", "잘못된 정규식"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			_, err := Parse(tt.source)
+			if err == nil {
+				t.Fatalf("Parse(%q) 오류 없음, want %q", tt.source, tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse(%q) 오류 = %q, want %q 포함", tt.source, err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestUses(t *testing.T) {
+	tests := []struct {
+		source  string
+		objects []string
+	}{
+		{"true", nil},
+		{"file.lines > 100", []string{ObjectFile}},
+		{"class.hasAnnotation('Service') && method.isPublic", []string{ObjectClass, ObjectMethod}},
+		{"!(file.contains('TODO') || method.params > 3)", []string{ObjectFile, ObjectMethod}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			e, err := Parse(tt.source)
+			if err != nil {
+				t.Fatalf("Parse(%q) 오류: %v", tt.source, err)
+			}
+			want := make(map[string]bool)
+			for _, object := range tt.objects {
+				want[object] = true
+			}
+			for _, object := range []string{ObjectFile, ObjectClass, ObjectMethod} {
+				if got := e.Uses(object); got != want[object] {
+					t.Errorf("Uses(%q) = %v, want %v", object, got, want[object])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package fixer
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/types"
+)
+
+// Apply 이슈에 포함된 자동 수정 사항을 파일에 적용하고 적용된 수정 개수 반환
+func Apply(issues []types.Issue) (int, error) {
+	fixesByFile := make(map[string][]types.Fix)
+	for _, issue := range issues {
+		if issue.Fix != nil {
+			fixesByFile[issue.File] = append(fixesByFile[issue.File], *issue.Fix)
+		}
+	}
+
+	applied := 0
+	for file, fixes := range fixesByFile {
+		count, err := applyFile(file, fixes)
+		if err != nil {
+			return applied, fmt.Errorf("%s 자동 수정 실패: %w", file, err)
+		}
+		applied += count
+	}
+
+	return applied, nil
+}
+
+// applyFile 파일 하나에 수정 사항 적용 (겹치는 수정은 건너뜀)
+func applyFile(path string, fixes []types.Fix) (int, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	lines := strings.Split(string(data), "\n")
+	// CRLF 파일은 교체한 라인도 CRLF로 써서 줄바꿈이 섞이지 않게 함
+	crlf := strings.Contains(string(data), "\r\n")
+
+	// 뒤쪽 수정부터 적용해야 앞쪽 라인 번호가 유지됨
+	sort.Slice(fixes, func(i, j int) bool {
+		return fixes[i].StartLine > fixes[j].StartLine
+	})
+
+	applied := 0
+	lowestStart := len(lines) + 1
+	for _, fix := range fixes {
+		if fix.StartLine < 1 || fix.EndLine < fix.StartLine || fix.EndLine > len(lines) {
+			continue
+		}
+		if fix.EndLine >= lowestStart {
+			continue // 이미 적용된 수정과 겹침
+		}
+
+		replacement := strings.Split(strings.ReplaceAll(fix.Replacement, "\r\n", "\n"), "\n")
+		if crlf {
+			for i := range replacement {
+				replacement[i] += "\r"
+			}
+			// 마지막 라인은 교체되는 원래 마지막 라인의 줄바꿈을 따름 (파일 끝 라인은 줄바꿈이 없을 수 있음)
+			if last := len(replacement) - 1; !strings.HasSuffix(lines[fix.EndLine-1], "\r") {
+				replacement[last] = strings.TrimSuffix(replacement[last], "\r")
+			}
+		}
+		updated := append([]string{}, lines[:fix.StartLine-1]...)
+		updated = append(updated, replacement...)
+		updated = append(updated, lines[fix.EndLine:]...)
+		lines = updated
+
+		lowestStart = fix.StartLine
+		applied++
+	}
+
+	if applied == 0 {
+		return 0, nil
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+	return applied, os.WriteFile(path, []byte(strings.Join(lines, "\n")), info.Mode())
+}
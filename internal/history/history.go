@@ -0,0 +1,186 @@
+package history
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+
+	_ "modernc.org/sqlite" // cgo 없이 빌드되는 순수 Go SQLite 드라이버
+)
+
+// schema 실행 기록 테이블 (저장소를 열 때 생성)
+const schema = `CREATE TABLE IF NOT EXISTS runs (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  started_at TEXT NOT NULL,
+  finished_at TEXT NOT NULL,
+  commit_sha TEXT NOT NULL DEFAULT '',
+  total_files INTEGER NOT NULL,
+  total_issues INTEGER NOT NULL,
+  critical INTEGER NOT NULL,
+  high INTEGER NOT NULL,
+  medium INTEGER NOT NULL,
+  low INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS issues (
+  run_id INTEGER NOT NULL REFERENCES runs(id),
+  rule_id TEXT NOT NULL,
+  severity TEXT NOT NULL,
+  category TEXT NOT NULL,
+  file TEXT NOT NULL,
+  line INTEGER NOT NULL,
+  message TEXT NOT NULL,
+  fingerprint TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS issues_run ON issues(run_id);
+CREATE INDEX IF NOT EXISTS issues_fingerprint ON issues(fingerprint);
+`
+
+// Store SQLite 실행 기록 저장소
+type Store struct {
+	path string
+	db   *sql.DB
+}
+
+// Open 실행 기록 저장소 열기 (파일이 없으면 만들고 테이블 생성)
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &Store{path: path, db: db}, nil
+}
+
+// Close 저장소 닫기
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Append 분석 결과의 요약과 이슈를 한 트랜잭션으로 기록
+func (s *Store) Append(result *types.AnalysisResult) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	if err := appendRun(tx, result); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	return nil
+}
+
+// appendRun 실행 요약 한 행과 이슈 행들을 트랜잭션에 추가
+func appendRun(tx *sql.Tx, result *types.AnalysisResult) error {
+	commit := ""
+	if result.Metadata != nil {
+		commit = result.Metadata.Commit
+	}
+	counts := result.Summary.SeverityCount
+	run, err := tx.Exec(`INSERT INTO runs (started_at, finished_at, commit_sha, total_files, total_issues, critical, high, medium, low) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		result.StartTime.UTC().Format(time.RFC3339), result.EndTime.UTC().Format(time.RFC3339), commit,
+		result.Summary.TotalFiles, len(result.Issues),
+		counts[config.SeverityCritical], counts[config.SeverityHigh], counts[config.SeverityMedium], counts[config.SeverityLow])
+	if err != nil {
+		return err
+	}
+	runID, err := run.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	insert, err := tx.Prepare(`INSERT INTO issues (run_id, rule_id, severity, category, file, line, message, fingerprint) VALUES (?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer insert.Close()
+	for _, issue := range result.Issues {
+		fingerprint := issue.Fingerprint
+		if fingerprint == "" {
+			fingerprint = types.Fingerprint(issue)
+		}
+		if _, err := insert.Exec(runID, issue.RuleID, issue.Severity.String(), issue.Category, issue.File, issue.Line, issue.Message, fingerprint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Latest 마지막 실행의 요약을 분석 결과 형태로 조회 (이슈 목록 없이 요약만 채움, 배지 생성용)
+func (s *Store) Latest() (*types.AnalysisResult, error) {
+	var run struct {
+		StartedAt, FinishedAt, Commit string
+		TotalFiles, TotalIssues       int
+		Critical, High, Medium, Low   int
+	}
+	err := s.db.QueryRow(`SELECT started_at, finished_at, commit_sha, total_files, total_issues, critical, high, medium, low FROM runs ORDER BY id DESC LIMIT 1`).
+		Scan(&run.StartedAt, &run.FinishedAt, &run.Commit, &run.TotalFiles, &run.TotalIssues, &run.Critical, &run.High, &run.Medium, &run.Low)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%s: 기록된 실행이 없습니다", s.path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	startedAt, _ := time.Parse(time.RFC3339, run.StartedAt)
+	finishedAt, _ := time.Parse(time.RFC3339, run.FinishedAt)
+	return &types.AnalysisResult{
+		StartTime: startedAt,
+		EndTime:   finishedAt,
+		Duration:  finishedAt.Sub(startedAt),
+		Summary: types.Summary{
+			TotalFiles:  run.TotalFiles,
+			TotalIssues: run.TotalIssues,
+			SeverityCount: map[config.Severity]int{
+				config.SeverityCritical: run.Critical,
+				config.SeverityHigh:     run.High,
+				config.SeverityMedium:   run.Medium,
+				config.SeverityLow:      run.Low,
+			},
+			CategoryCount: make(map[string]int),
+			LanguageCount: make(map[string]int),
+		},
+		Metadata: &types.Metadata{Commit: run.Commit},
+	}, nil
+}
+
+// Persistence 마지막 실행에 있던 이슈마다 연속으로 발견된 실행 수와 그 첫 실행 시각 조회 (키는 이슈 식별자)
+// 이슈가 없던 실행이 나오면 연속 횟수를 다시 셉니다 (심각도 자동 상향에 사용)
+func (s *Store) Persistence() (map[string]types.Persistence, error) {
+	rows, err := s.db.Query(`SELECT i.fingerprint, count(DISTINCT i.run_id), min(r.started_at)
+FROM issues i JOIN runs r ON r.id = i.run_id
+WHERE i.fingerprint IN (SELECT fingerprint FROM issues WHERE run_id = (SELECT max(id) FROM runs))
+  AND i.run_id > (SELECT coalesce(max(id), 0) FROM runs WHERE id NOT IN (SELECT run_id FROM issues WHERE fingerprint = i.fingerprint))
+GROUP BY i.fingerprint`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	defer rows.Close()
+
+	persistence := make(map[string]types.Persistence)
+	for rows.Next() {
+		var fingerprint, firstSeen string
+		var runs int
+		if err := rows.Scan(&fingerprint, &runs, &firstSeen); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.path, err)
+		}
+		seen, err := time.Parse(time.RFC3339, firstSeen)
+		if err != nil {
+			continue
+		}
+		persistence[fingerprint] = types.Persistence{FirstSeen: seen, Runs: runs}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	return persistence, nil
+}
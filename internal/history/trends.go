@@ -0,0 +1,309 @@
+package history
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// severityNames 추이에 표시할 심각도 (높은 순)
+var severityNames = []string{"critical", "high", "medium", "low"}
+
+// severityColors HTML 차트의 심각도별 색상 (HTML 리포트와 같은 색)
+var severityColors = map[string]string{
+	"critical": "#e74c3c",
+	"high":     "#e67e22",
+	"medium":   "#f1c40f",
+	"low":      "#3498db",
+}
+
+// Run 실행 하나의 이슈 수
+type Run struct {
+	ID          int            `json:"id"`
+	StartedAt   time.Time      `json:"started_at"`
+	Commit      string         `json:"commit,omitempty"`
+	TotalIssues int            `json:"total_issues"`
+	Severity    map[string]int `json:"severity"`
+	Rules       map[string]int `json:"rules"`
+}
+
+// Trends 최근 실행들의 심각도/규칙별 이슈 수 추이 (오래된 실행부터)
+type Trends struct {
+	Runs  []Run    `json:"runs"`
+	Rules []string `json:"rules"` // 최근 실행의 이슈 수가 많은 순 (같으면 규칙 ID 순)
+}
+
+// Trends 최근 last개 실행의 추이 조회 (last가 0 이하면 전체)
+func (s *Store) Trends(last int) (*Trends, error) {
+	// LIMIT -1은 제한 없음
+	if last <= 0 {
+		last = -1
+	}
+	runs, err := s.recentRuns(last)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	trends := &Trends{}
+	if len(runs) == 0 {
+		return trends, nil
+	}
+
+	index := make(map[int]int, len(runs))
+	for i := len(runs) - 1; i >= 0; i-- {
+		index[runs[i].ID] = len(trends.Runs)
+		trends.Runs = append(trends.Runs, runs[i])
+	}
+
+	rows, err := s.db.Query("SELECT run_id, rule_id, count(*) FROM issues WHERE run_id >= ? GROUP BY run_id, rule_id", trends.Runs[0].ID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+	defer rows.Close()
+
+	seen := make(map[string]bool)
+	for rows.Next() {
+		var runID, count int
+		var ruleID string
+		if err := rows.Scan(&runID, &ruleID, &count); err != nil {
+			return nil, fmt.Errorf("%s: %w", s.path, err)
+		}
+		i, ok := index[runID]
+		if !ok {
+			continue
+		}
+		trends.Runs[i].Rules[ruleID] = count
+		if !seen[ruleID] {
+			seen[ruleID] = true
+			trends.Rules = append(trends.Rules, ruleID)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("%s: %w", s.path, err)
+	}
+
+	latest := trends.Runs[len(trends.Runs)-1].Rules
+	sort.Slice(trends.Rules, func(i, j int) bool {
+		a, b := trends.Rules[i], trends.Rules[j]
+		if latest[a] != latest[b] {
+			return latest[a] > latest[b]
+		}
+		return a < b
+	})
+	return trends, nil
+}
+
+// recentRuns 최근 실행부터 limit개 조회 (limit이 음수면 전체)
+func (s *Store) recentRuns(limit int) ([]Run, error) {
+	rows, err := s.db.Query("SELECT id, started_at, commit_sha, total_issues, critical, high, medium, low FROM runs ORDER BY id DESC LIMIT ?", limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []Run
+	for rows.Next() {
+		var run Run
+		var startedAt string
+		var critical, high, medium, low int
+		if err := rows.Scan(&run.ID, &startedAt, &run.Commit, &run.TotalIssues, &critical, &high, &medium, &low); err != nil {
+			return nil, err
+		}
+		run.StartedAt, _ = time.Parse(time.RFC3339, startedAt)
+		run.Severity = map[string]int{"critical": critical, "high": high, "medium": medium, "low": low}
+		run.Rules = make(map[string]int)
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
+
+// change 첫 실행 대비 마지막 실행의 증감 표시
+func change(first, last int) string {
+	switch {
+	case last > first:
+		return fmt.Sprintf("▲%d", last-first)
+	case last < first:
+		return fmt.Sprintf("▼%d", first-last)
+	}
+	return "-"
+}
+
+// WriteConsole 실행별 심각도 이슈 수와 규칙별 추이를 표로 출력
+func WriteConsole(w io.Writer, trends *Trends) error {
+	if len(trends.Runs) == 0 {
+		_, err := fmt.Fprintln(w, "기록된 실행이 없습니다")
+		return err
+	}
+
+	fmt.Fprintf(w, "📈 이슈 추이 (최근 %d회 실행)\n\n", len(trends.Runs))
+	// 한글 제목은 두 칸씩 차지하므로 직접 맞춤
+	fmt.Fprintln(w, "실행  시각             커밋        전체 critical   high medium    low")
+	for _, run := range trends.Runs {
+		commit := run.Commit
+		if len(commit) > 8 {
+			commit = commit[:8]
+		}
+		fmt.Fprintf(w, "#%-4d %-16s %-9s %6d %8d %6d %6d %6d\n", run.ID, run.StartedAt.Local().Format("2006-01-02 15:04"), commit,
+			run.TotalIssues, run.Severity["critical"], run.Severity["high"], run.Severity["medium"], run.Severity["low"])
+	}
+
+	if len(trends.Rules) == 0 {
+		return nil
+	}
+	first, last := trends.Runs[0], trends.Runs[len(trends.Runs)-1]
+	fmt.Fprintf(w, "\n📋 규칙별 추이 (#%d → #%d):\n", first.ID, last.ID)
+	for _, rule := range trends.Rules {
+		values := make([]string, 0, len(trends.Runs))
+		for _, run := range trends.Runs {
+			values = append(values, fmt.Sprint(run.Rules[rule]))
+		}
+		fmt.Fprintf(w, "  %-40s %-6s %s\n", rule, change(first.Rules[rule], last.Rules[rule]), strings.Join(values, " → "))
+	}
+	return nil
+}
+
+// trendSeries HTML 차트의 선 하나
+type trendSeries struct {
+	Label  string
+	Color  string
+	Points string // SVG polyline points
+	Last   int
+	Change string
+}
+
+// trendRule HTML 규칙 표의 행
+type trendRule struct {
+	RuleID string
+	Counts []int
+	Change string
+	Spark  string // 작은 추이 선의 SVG polyline points
+}
+
+// trendsPage HTML 추이 페이지 데이터
+type trendsPage struct {
+	Generated time.Time
+	Runs      []Run
+	Series    []trendSeries
+	Rules     []trendRule
+	ViewBox   string // 선이 잘리지 않도록 여백을 둔 차트 영역
+}
+
+// 차트 크기
+const (
+	chartWidth  = 720
+	chartHeight = 240
+	sparkWidth  = 120
+	sparkHeight = 24
+)
+
+// polyline 값 목록을 width x height 영역의 SVG 좌표로 변환 (max가 0이면 바닥선)
+func polyline(values []int, max, width, height int) string {
+	points := make([]string, len(values))
+	for i, value := range values {
+		x := 0.0
+		if len(values) > 1 {
+			x = float64(i) * float64(width) / float64(len(values)-1)
+		}
+		y := float64(height)
+		if max > 0 {
+			y = float64(height) - float64(value)*float64(height)/float64(max)
+		}
+		points[i] = fmt.Sprintf("%.1f,%.1f", x, y)
+	}
+	return strings.Join(points, " ")
+}
+
+// WriteHTML 심각도별 추이 차트와 규칙별 추이 표를 담은 HTML 페이지 출력 (외부 리소스 없음)
+func WriteHTML(w io.Writer, trends *Trends) error {
+	page := trendsPage{Generated: time.Now(), Runs: trends.Runs, ViewBox: fmt.Sprintf("-10 -10 %d %d", chartWidth+20, chartHeight+20)}
+
+	max := 0
+	for _, run := range trends.Runs {
+		for _, severity := range severityNames {
+			if run.Severity[severity] > max {
+				max = run.Severity[severity]
+			}
+		}
+	}
+	for _, severity := range severityNames {
+		values := make([]int, len(trends.Runs))
+		for i, run := range trends.Runs {
+			values[i] = run.Severity[severity]
+		}
+		series := trendSeries{Label: severity, Color: severityColors[severity], Points: polyline(values, max, chartWidth, chartHeight)}
+		if len(values) > 0 {
+			series.Last = values[len(values)-1]
+			series.Change = change(values[0], values[len(values)-1])
+		}
+		page.Series = append(page.Series, series)
+	}
+
+	for _, rule := range trends.Rules {
+		row := trendRule{RuleID: rule}
+		ruleMax := 0
+		for _, run := range trends.Runs {
+			row.Counts = append(row.Counts, run.Rules[rule])
+			if run.Rules[rule] > ruleMax {
+				ruleMax = run.Rules[rule]
+			}
+		}
+		row.Change = change(row.Counts[0], row.Counts[len(row.Counts)-1])
+		row.Spark = polyline(row.Counts, ruleMax, sparkWidth, sparkHeight)
+		page.Rules = append(page.Rules, row)
+	}
+
+	return trendsTemplate.Execute(w, page)
+}
+
+var trendsTemplate = template.Must(template.New("trends").Funcs(template.FuncMap{
+	"upper": strings.ToUpper,
+}).Parse(`<!DOCTYPE html>
+<html lang="ko">
+<head>
+<meta charset="UTF-8">
+<title>이슈 추이</title>
+<style>
+  body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; margin: 20px; color: #2c3e50; }
+  h1 { font-size: 22px; }
+  .chart { background: #ecf0f1; padding: 15px; border-radius: 8px; max-width: 760px; }
+  .chart svg { display: block; width: 100%; height: auto; }
+  .legend span { margin-right: 14px; font-size: 13px; }
+  .legend span::before { content: ''; display: inline-block; width: 10px; height: 10px; margin-right: 4px; border-radius: 2px; background: var(--swatch); }
+  table { border-collapse: collapse; margin-top: 20px; font-size: 13px; }
+  th, td { border: 1px solid #ddd; padding: 4px 8px; text-align: right; }
+  th:first-child, td:first-child { text-align: left; }
+  .meta { color: #7f8c8d; font-size: 13px; }
+</style>
+</head>
+<body>
+<h1>📈 이슈 추이</h1>
+<p class="meta">최근 {{len .Runs}}회 실행 · 생성 {{.Generated.Format "2006-01-02 15:04"}}</p>
+{{if .Runs}}
+<div class="chart">
+  <svg viewBox="{{.ViewBox}}" role="img">
+    {{range .Series}}<polyline fill="none" stroke="{{.Color}}" stroke-width="2" points="{{.Points}}"><title>{{upper .Label}}: {{.Last}}</title></polyline>
+    {{end}}
+  </svg>
+  <div class="legend">{{range .Series}}<span style="--swatch: {{.Color}}">{{upper .Label}} {{.Last}} ({{.Change}})</span>{{end}}</div>
+</div>
+<table>
+  <tr><th>실행</th><th>시각</th><th>커밋</th><th>전체</th><th>CRITICAL</th><th>HIGH</th><th>MEDIUM</th><th>LOW</th></tr>
+  {{range .Runs}}<tr><td>#{{.ID}}</td><td>{{.StartedAt.Local.Format "2006-01-02 15:04"}}</td><td>{{.Commit}}</td><td>{{.TotalIssues}}</td><td>{{index .Severity "critical"}}</td><td>{{index .Severity "high"}}</td><td>{{index .Severity "medium"}}</td><td>{{index .Severity "low"}}</td></tr>
+  {{end}}
+</table>
+{{if .Rules}}
+<table>
+  <tr><th>규칙</th><th>추이</th><th>변화</th>{{range .Runs}}<th>#{{.ID}}</th>{{end}}</tr>
+  {{range .Rules}}<tr><td>{{.RuleID}}</td><td><svg width="120" height="24" viewBox="-2 -2 124 28"><polyline fill="none" stroke="#3498db" stroke-width="1.5" points="{{.Spark}}"/></svg></td><td>{{.Change}}</td>{{range .Counts}}<td>{{.}}</td>{{end}}</tr>
+  {{end}}
+</table>
+{{end}}
+{{else}}
+<p>기록된 실행이 없습니다</p>
+{{end}}
+</body>
+</html>
+`))
@@ -0,0 +1,771 @@
+package i18n
+
+// en 영어 메시지 카탈로그
+var en = map[string]string{
+	"annotate.inserted": "✍️  Inserted %[2]d comments into %[1]d files",
+	"annotate.removed":  "🧹 Removed %[2]d comments from %[1]d files",
+	"annotate.skipped":  "Skipped %d issues in formats that cannot hold comments (JSON, ...) or without a location",
+	"annotate.undo":     "To undo: cqc annotate %s --remove",
+
+	"badge.created":     "🏷️  Badge generated: %s",
+	"badge.failed":      "Failed to generate badge: %v",
+	"badge.no-input":    "Specify --input, --history or a path to analyze",
+	"badge.save-failed": "Failed to save badge: %v",
+
+	"banned.api.message":    "A banned API is used: %s",
+	"banned.import.message": "A banned import is used: %s",
+	"banned.replacement":    "Use %s instead",
+	"banned.suggestion":     "Use an approved alternative API according to the team guide",
+
+	"bench.baseline":             "Baseline: %s (cqc %s, %s)",
+	"bench.baseline-load-failed": "Warning: failed to load baseline: %v",
+	"bench.baseline-save-failed": "Failed to save baseline: %v",
+	"bench.baseline-saved":       "💾 Baseline saved: %s",
+	"bench.columns":              "stage             p50        p95",
+	"bench.columns-baseline":     "stage             p50        p95 baseline p95    change",
+	"bench.format-mismatch":      "The report format differs from the baseline (%s), so the report/total stages are excluded from regression checks",
+	"bench.header":               "⏱️  Benchmark: %d files, %d runs",
+	"bench.regression":           "🐢 Performance regression: %s p95 %s → %s (+%.1f%%, allowed %.0f%%)",
+
+	"bundle.builtin":        "📦 Built-in bundles",
+	"bundle.install-failed": "Failed to install bundle: %v",
+	"bundle.installed":      "✅ Installed %s@%s (%d rules)",
+	"bundle.installed-list": "✅ Installed bundles",
+	"bundle.list-failed":    "Failed to list bundles: %v",
+	"bundle.recorded":       "Recorded in config file: %s",
+	"bundle.reinstalled":    "✅ Reinstalled %s@%s (%d rules)",
+	"bundle.updated":        "✅ Updated %s %s → %s (%d rules)",
+
+	"cli.analysis-failed":           "Analysis failed: %v",
+	"cli.collect-failed":            "Failed to collect files: %v",
+	"cli.config-load-failed":        "Failed to load config file: %v",
+	"cli.confluence-failed":         "Warning: failed to publish to Confluence: %v",
+	"cli.count":                     "%d",
+	"cli.error":                     "Error: %v",
+	"cli.fix-failed":                "Auto-fix failed: %v",
+	"cli.fixed":                     "🔧 Auto-fixed %d issues",
+	"cli.gate-failed":               "🚦 Quality gate failed: %[2]d %[1]s issues (%[3]d allowed, %[4]s or higher)",
+	"cli.history-query-failed":      "Warning: failed to query run history: %v",
+	"cli.history-save-failed":       "Warning: failed to save run history: %v",
+	"cli.history-saved":             "🗃️  Run history saved: %s",
+	"cli.invalid-redact":            "Invalid --redact-snippets value (mask/omit): %s",
+	"cli.json-failed":               "Failed to encode JSON: %v",
+	"cli.mailed":                    "📧 Report mailed (%d recipients)",
+	"cli.output-create-failed":      "Failed to create output file: %v",
+	"cli.previous-load-failed":      "Warning: failed to load previous result: %v",
+	"cli.report-failed":             "Failed to generate report: %v",
+	"cli.reporter-failed":           "Failed to create reporter: %v",
+	"cli.result-load-failed":        "Failed to load analysis result: %v",
+	"cli.result-load-failed-path":   "Failed to load analysis result (%s): %v",
+	"cli.stdin-filename-required":   "--stdin requires --stdin-filename for language detection",
+	"cli.stdin-fix":                 "--fix cannot be used with --stdin (it modifies files on disk)",
+	"cli.stdin-list-files":          "--list-files cannot be used with --stdin",
+	"cli.stdin-read-failed":         "Failed to read standard input: %v",
+	"cli.stdin-with-paths":          "--stdin cannot be combined with paths to analyze",
+	"cli.telemetry-failed":          "Warning: failed to send aggregate metrics: %v",
+	"cli.telemetry-sent":            "📈 Aggregate metrics sent: %s",
+	"cli.unsupported-format":        "Unsupported output format: %s",
+	"cli.unsupported-format-choice": "Unsupported output format: %s (one of %s)",
+	"cli.unsupported-language":      "Unsupported language: %s (one of %s)",
+	"cli.upload-failed":             "Warning: failed to upload reports: %v",
+	"cli.verbose.cache":             "Cache: %d hits, %d misses, %d errors",
+	"cli.verbose.config":            "Config file: %s",
+	"cli.verbose.done":              "Analysis complete! %d issues found",
+	"cli.verbose.index":             "Symbol index: %d indexed, %d reused, %d removed",
+	"cli.verbose.memory":            "Peak memory: %.1fMB",
+	"cli.verbose.output":            "Output format: %s",
+	"cli.verbose.start":             "Code Quality Checker started",
+	"cli.verbose.stdin-target":      "Target: %s (standard input)",
+	"cli.verbose.target":            "Target: %s",
+	"cli.warning":                   "Warning: %v",
+
+	"compare-config.analysis-failed":  "Analysis failed (%s): %v",
+	"compare-config.header":           "⚖️  Config comparison: %s → %s (%s)",
+	"compare-config.load-failed":      "Failed to load config file (%s): %v",
+	"compare-config.severity-changes": "↕️  Issues with changed severity (%d):",
+	"compare-config.summary":          "Total issues %d → %d (%+d), %d files affected",
+
+	"css.descendant-selector.description": "Complex selectors starting with a tag name perform poorly",
+	"css.descendant-selector.message":     "Inefficient descendant selector",
+	"css.descendant-selector.suggestion":  "Use a selector that starts with a class or ID",
+	"css.duplicate-styles.description":    "The same styles are defined for multiple selectors",
+	"css.duplicate-styles.message":        "Duplicate CSS styles found",
+	"css.duplicate-styles.suggestion":     "Extract a shared class to remove the duplication",
+	"css.fixed-units.description":         "Fixed units limit responsive design",
+	"css.fixed-units.message":             "px units are overused",
+	"css.fixed-units.suggestion":          "Consider relative units such as em, rem, %, vw and vh",
+	"css.media-query.description":         "Media queries are needed for responsive design",
+	"css.media-query.message":             "Fixed widths are used without media queries",
+	"css.media-query.suggestion":          "Add @media queries to support different screen sizes",
+	"css.modern-layout.description":       "Flexbox or Grid allow more flexible layouts",
+	"css.modern-layout.message":           "Modern layout techniques are not used",
+	"css.modern-layout.suggestion":        "Consider display: flex or display: grid",
+	"css.multiple-ids.description":        "IDs must be unique in a document, so multiple ID selectors are unnecessary",
+	"css.multiple-ids.message":            "Multiple ID selectors are used",
+	"css.multiple-ids.suggestion":         "Use a single ID or a class selector",
+	"css.nested-selector.description":     "Deep nesting hurts CSS performance and makes maintenance harder",
+	"css.nested-selector.message":         "Overly nested CSS selector",
+	"css.nested-selector.suggestion":      "Reduce selector nesting to 3 levels or fewer",
+	"css.universal-selector.description":  "The universal selector matches every element and hurts performance",
+	"css.universal-selector.message":      "Universal selector (*) is used",
+	"css.universal-selector.suggestion":   "Use a more specific selector",
+
+	"datetime.js-date-parse.description":      "Date string parsing interprets UTC/local time differently by format, and results can vary across browsers",
+	"datetime.js-date-parse.message":          "A string is parsed directly into a Date: %s",
+	"datetime.js-date-parse.suggestion":       "Parse with an explicit format using date-fns parseISO/parse, and display with Intl.DateTimeFormat",
+	"datetime.legacy-api.description":         "java.util.Date/Calendar are mutable and handle time zones implicitly, which invites bugs",
+	"datetime.legacy-api.message":             "A legacy date API is used: %s",
+	"datetime.legacy-api.suggestion":          "Use LocalDate, LocalDateTime, ZonedDateTime or Instant from java.time",
+	"datetime.simple-date-format.description": "Results depend on the server's default Locale/TimeZone, and SimpleDateFormat is not thread-safe",
+	"datetime.simple-date-format.message":     "SimpleDateFormat has no %s set",
+	"datetime.simple-date-format.suggestion":  "Use DateTimeFormatter.ofPattern(pattern, Locale.KOREA).withZone(ZoneId.of(\"Asia/Seoul\"))",
+
+	"dependency.banned.message":     "A banned dependency is used: %s",
+	"dependency.banned.suggestion":  "Use an approved alternative library according to the team guide",
+	"dependency.license.message":    "A dependency with a banned license (%s) is used: %s",
+	"dependency.version.message":    "A banned version of a dependency is used: %s %s (%s)",
+	"dependency.version.suggestion": "Change to a version allowed by the policy",
+
+	"diff.by-rule":    "📋 Changes by rule:",
+	"diff.fixed":      "✅ Fixed issues",
+	"diff.header":     "🔄 Comparing analysis results: %s → %s",
+	"diff.new":        "🆕 New issues",
+	"diff.persisting": "⏸️  Persisting issues",
+	"diff.section":    "%s (%d):",
+	"diff.summary":    "%d new, %d fixed, %d persisting",
+
+	"doc.option.all_html":                            "When true, also checks static HTML that is not Thymeleaf/JSP",
+	"doc.option.allowed_numbers":                     "Numbers or named sets (http-status, ports) allowed in addition to 0, 1, 2, 10, 100, 1000",
+	"doc.option.allowed_types":                       "Types allowed even though they are not the standard response",
+	"doc.option.attributes":                          "HTML attributes to check",
+	"doc.option.blank_line_between_groups":           "Require a blank line between groups",
+	"doc.option.block_size":                          "Number of lines in a compared block",
+	"doc.option.bounded_calls":                       "Calls allowed because their result size is bounded",
+	"doc.option.builtin_patterns":                    "Built-in patterns to use (all when omitted, none to disable)",
+	"doc.option.business_exceptions":                 "Business exceptions that are not logged at error level",
+	"doc.option.constants_class":                     "Class or object holding the flag name constants",
+	"doc.option.constants_files":                     "Globs of constant files where numbers are allowed",
+	"doc.option.contexts":                            "Call or variable names whose text is considered user-facing",
+	"doc.option.count":                               "lines counts lines, statements excludes blank, comment and brace-only lines",
+	"doc.option.error_annotations":                   "Annotations accepted as exception handling (e.g. Retryable)",
+	"doc.option.exclude_methods":                     "Kinds of methods excluded from the count (accessors, object-methods, builders)",
+	"doc.option.flag_calls":                          "Feature flag SDK call methods",
+	"doc.option.forbidden_types":                     "Types that must not be used instead of the standard response",
+	"doc.option.groups":                              "Order of import group prefixes (* is everything else)",
+	"doc.option.ignore_contexts":                     "Contexts not checked (annotations, array-sizes, constants, tests)",
+	"doc.option.layers":                              "Layers to check (class annotations)",
+	"doc.option.list_calls":                          "Calls that load a whole list",
+	"doc.option.lock_annotations":                    "Annotations accepted as a distributed lock",
+	"doc.option.logger_factories":                    "Approved logger creation calls",
+	"doc.option.max_external_resources":              "Maximum number of external CSS/JS files per page",
+	"doc.option.max_inline_script_bytes":             "Maximum bytes of a single inline <script> block",
+	"doc.option.max_inline_style_bytes":              "Maximum bytes of a single inline <style> block",
+	"doc.option.max_inline_total_bytes":              "Maximum bytes of all inline code in a page",
+	"doc.option.max_lines":                           "Maximum number of lines per method",
+	"doc.option.min_block_lines":                     "Minimum number of code lines in a block",
+	"doc.option.min_method_lines":                    "Minimum number of code lines in a compared method body",
+	"doc.option.min_occurrences":                     "A block appearing at least this many times is duplicated",
+	"doc.option.min_pattern_occurrences":             "A pattern repeated at least this many times is duplicated",
+	"doc.option.min_words":                           "Minimum number of words to treat text as English copy",
+	"doc.option.naming_pattern":                      "Regex for %s names",
+	"doc.option.nonnull_annotations":                 "Annotations meaning the value is never null",
+	"doc.option.normalize":                           "Normalization before comparing (none, literals, identifiers)",
+	"doc.option.nullable_annotations":                "Annotations meaning the value may be null",
+	"doc.option.pageable_types":                      "Pagination parameter types",
+	"doc.option.pattern":                             "Project-specific duplicate pattern regex",
+	"doc.option.scope":                               "method compares method bodies, window compares line blocks (both when empty)",
+	"doc.option.similarity":                          "Method bodies at least this percent alike are duplicates",
+	"doc.option.sort_within_group":                   "Sort alphabetically within a group",
+	"doc.option.status_checks":                       "HTTP methods whose status code is checked",
+	"doc.option.wrapper_types":                       "Standard response types",
+	"doc.rationale.css-color-contrast":               "Low-contrast text is unreadable for users with low vision and in bright outdoor light (WCAG AA requires 4.5:1).",
+	"doc.rationale.css-font-fallbacks":               "When a web font fails to load, a font without fallbacks turns into the browser default and the page looks very different.",
+	"doc.rationale.css-important-overuse":            "!important breaks specificity rules and starts a cycle where overriding a style needs yet another !important.",
+	"doc.rationale.css-naming-convention":            "Consistent class naming (kebab-case/BEM) reduces style collisions and shows relationships between elements by name alone.",
+	"doc.rationale.css-responsive-design":            "Fixed-width layouts cause horizontal scrolling and cut-off content on small screens.",
+	"doc.rationale.css-selectors":                    "Deeply nested selectors are tightly coupled to the markup structure, break on small changes and invite specificity wars.",
+	"doc.rationale.css-unused-styles":                "Unused CSS only adds download and parsing cost, and it keeps piling up because it is hard to tell whether it can be removed.",
+	"doc.rationale.css-vendor-prefixes":              "Properties that need a prefix in a supported browser are not applied in that browser without it.",
+	"doc.rationale.html-accessibility":               "Elements that cannot be reached with the keyboard or do not expose their role keep assistive technology users from using the feature.",
+	"doc.rationale.html-deprecated-tags":             "Tags deprecated in HTML5 mix presentation with structure, and browser support can disappear at any time.",
+	"doc.rationale.html-external-resource-limit":     "Every extra external CSS/JS file adds requests and connection cost, which slows down page loading.",
+	"doc.rationale.html-form-labels":                 "Without a label, a screen reader cannot tell what an input expects, and the click target becomes smaller.",
+	"doc.rationale.html-i18n-hardcoded-string":       "Writing text directly in templates means every template must be found and changed to support other languages.",
+	"doc.rationale.html-img-alt":                     "Without alt, screen reader users cannot tell what an image means, and no fallback text is shown when the image fails to load.",
+	"doc.rationale.html-img-dimensions":              "Images without dimensions take up space only after loading, which shifts the layout (CLS) and causes mis-clicks.",
+	"doc.rationale.html-inline-code-budget":          "Large inline code is not cached, so it is downloaded again on every page view and delays HTML parsing.",
+	"doc.rationale.html-inline-styles":               "Inline styles are hard to reuse and change in bulk, and their high precedence overrides styles from CSS files.",
+	"doc.rationale.html-render-blocking-script":      "A synchronous script in <head> stops HTML parsing until it is downloaded and executed, which delays the first render.",
+	"doc.rationale.html-semantic-markup":             "Meaningful tags make screen reader navigation, search engine understanding of the structure and style maintenance easier.",
+	"doc.rationale.html-seo":                         "Without a title, meta description and h1, search results do not show the page content properly.",
+	"doc.rationale.html-template-unescaped-output":   "Data printed without escaping becomes XSS that runs scripts as-is when user input is mixed in.",
+	"doc.rationale.html-validation":                  "Unclosed tags and invalid nesting are repaired differently by each browser, so layout and script behavior differ.",
+	"doc.rationale.java-banned-api":                  "Libraries and APIs banned by the team are banned for reasons such as discontinued maintenance, security vulnerabilities or internal policy, so use the replacement API.",
+	"doc.rationale.java-coding-conventions":          "Consistent naming and formatting lower the cost of reading code and let reviews focus on behavior instead of style.",
+	"doc.rationale.java-cyclomatic-complexity":       "A method with many branches is hard to test on every path and more likely to contain defects.",
+	"doc.rationale.java-duplicate-code":              "Duplicated code requires every bug fix and policy change to be applied to all copies, so behavior easily diverges when only one is fixed.",
+	"doc.rationale.java-exception-handling":          "Swallowing exceptions or catching them too broadly leaves failures unrecorded, so the cause of an incident cannot be found and processing continues in a broken state.",
+	"doc.rationale.java-feature-flag-hygiene":        "Flag names scattered as strings let typos silently evaluate to false and make it hard to find usages when cleaning up flags.",
+	"doc.rationale.java-i18n-hardcoded-string":       "Writing UI text directly in code means every occurrence must be found and changed to support other languages, and every wording change needs a deployment.",
+	"doc.rationale.java-import-order":                "A consistent import order reduces diff noise and merge conflicts and shows at a glance which external dependencies are used (--fix sorts them automatically).",
+	"doc.rationale.java-input-validation":            "Unvalidated user input is the starting point for bad data being stored, 500 responses caused by exceptions, and injection attacks.",
+	"doc.rationale.java-layer-architecture":          "When a controller uses a DAO or repository directly, transactions and business rules bypass the service layer and tangled layer dependencies widen the impact of every change.",
+	"doc.rationale.java-legacy-date-api":             "java.util.Date/Calendar are mutable, not thread-safe and ambiguous about time zones. java.time is immutable and states its intent clearly.",
+	"doc.rationale.java-logging-convention":          "Inconsistent logger creation and levels undermine log collection and alerting, and string concatenation costs even when logging is disabled.",
+	"doc.rationale.java-magic-number":                "Unnamed numbers do not reveal their intent, and when the same value is scattered across the code it is easy to miss some places when changing it.",
+	"doc.rationale.java-method-length":               "A long method carries several responsibilities at once, which makes it hard to understand and test, and a change can affect unintended parts.",
+	"doc.rationale.java-null-collection-return":      "Returning null instead of a collection forces a null check at every call site, and a single missing check causes a NullPointerException.",
+	"doc.rationale.java-nullable-inconsistency":      "When null annotations disagree with the actual behavior, callers trust the contract, skip the check and hit a NullPointerException.",
+	"doc.rationale.java-optional-get-unchecked":      "get() on an empty Optional throws NoSuchElementException, which defeats the purpose of using Optional.",
+	"doc.rationale.java-simple-date-format":          "Without a Locale/TimeZone the result depends on the server settings, and SimpleDateFormat is not thread-safe, so sharing it mixes up values.",
+	"doc.rationale.java-system-out":                  "System.out has no configurable log level, format or destination, is missed by production log collection, and slows things down under load because it writes synchronously.",
+	"doc.rationale.java-taint-flow":                  "User input passed without sanitizing to SQL, HTML output or redirects enables SQL injection, XSS and open redirect attacks.",
+	"doc.rationale.java-transactional-missing":       "Without a transaction, a data-changing method that combines several repository calls leaves only some changes applied when it fails midway, so the data diverges.",
+	"doc.rationale.js-banned-api":                    "Modules and APIs banned by the team are banned for reasons such as bundle size, security or discontinued maintenance, so use the replacement API.",
+	"doc.rationale.js-callback-hell":                 "Deeply nested callbacks make the flow and error handling hard to follow, and error propagation is easily missed.",
+	"doc.rationale.js-console-log":                   "console.log in production code exposes internal data in the browser console and makes the logs you need harder to find.",
+	"doc.rationale.js-date-parsing":                  "new Date(string) is interpreted as UTC or local time depending on the format, and results differ between browsers, so dates can be off by a day.",
+	"doc.rationale.js-equality-operators":            "== converts types implicitly and gives surprising results such as 0 == \"\", so use === to make the intent clear.",
+	"doc.rationale.js-feature-flag-hygiene":          "Flag names scattered as strings let typos silently evaluate to false and make it hard to find usages when cleaning up flags.",
+	"doc.rationale.js-function-length":               "A long function carries several responsibilities at once, which makes it hard to understand and test.",
+	"doc.rationale.js-global-variables":              "Global variables clash with names from other scripts and can be changed from anywhere, which makes them hard to trace.",
+	"doc.rationale.js-i18n-hardcoded-string":         "Writing UI text directly in code means every occurrence must be found and changed to support other languages.",
+	"doc.rationale.js-import-order":                  "A consistent import order reduces diff noise and merge conflicts and separates external modules from internal ones (--fix sorts them automatically).",
+	"doc.rationale.js-innerHTML-xss":                 "A string assigned to innerHTML is parsed as HTML, so mixing in user input becomes XSS that executes scripts.",
+	"doc.rationale.js-memory-leak":                   "Event listeners and timers that are never released keep objects alive after leaving the page, so memory keeps growing and callbacks run more than once.",
+	"doc.rationale.js-naming-convention":             "Consistent naming lowers the cost of reading code and tells constructors and plain functions apart by name alone.",
+	"doc.rationale.js-strict-mode":                   "Outside strict mode, assigning an undeclared variable creates a global, and errors that are silently ignored stay hidden.",
+	"doc.rationale.js-taint-flow":                    "URL or request parameters passed without sanitizing to innerHTML, redirects or SQL enable XSS, open redirect and injection attacks.",
+	"doc.rationale.js-unused-variables":              "Unused variables mislead readers about intent and are often leftovers of needless computation or bugs from a refactoring.",
+	"doc.rationale.js-var-usage":                     "Function scoping and hoisting of var leak values outside blocks and make loop closures share the same value.",
+	"doc.rationale.manifest-dependency-policy":       "Dependencies with known vulnerable versions or disallowed licenses lead to security incidents and legal problems.",
+	"doc.rationale.spring-api-response-convention":   "When each API uses a different response format, clients cannot handle errors and data consistently, and Map responses escape API documentation and type checking.",
+	"doc.rationale.spring-batch-fault-tolerance":     "A chunk Step without fault tolerance fails entirely on one bad record and does not retry transient errors.",
+	"doc.rationale.spring-controller-advice-missing": "Without a global exception handler, exception handling is scattered across controllers and unhandled exceptions can be returned as-is with a stack trace.",
+	"doc.rationale.spring-field-injection":           "Field injection hides dependencies, prevents final fields, and makes it hard to pass mocks in unit tests without Spring.",
+	"doc.rationale.spring-pagination-required":       "Loading a whole list at once makes response time and memory use grow with the data, eventually leading to OOM.",
+	"doc.rationale.spring-scheduled-error-handling":  "An exception thrown from a @Scheduled method has no caller and silently disappears with the default settings, so nobody notices a failed batch.",
+	"doc.rationale.spring-scheduled-lock-missing":    "When deployed as several instances, a @Scheduled job runs on every instance, causing duplicate mails and duplicate data processing.",
+	"doc.rationale.spring-secured-deprecated":        "@Secured can only compare role names, while @PreAuthorize can express fine-grained conditions such as ownership checks with SpEL.",
+	"doc.rationale.spring-security-missing":          "Without an authorization check on sensitive endpoints such as admin or delete operations, any authenticated user can call them.",
+	"doc.rationale.spring-transactional-private":     "Spring transactions work through proxies, so @Transactional on a private method is ignored and the method runs without a transaction.",
+	"doc.rationale.spring-transactional-rollback":    "@Transactional rolls back only on RuntimeException by default, so some changes are committed when a checked exception is thrown.",
+	"doc.rationale.spring-validation-missing":        "Without @Valid the constraints declared on the DTO (@NotNull, @Size, ...) are not run, so unvalidated requests reach the service.",
+
+	"endpoints.failed": "Failed to print endpoints: %v",
+
+	"explain.bad":           "%s - violating code",
+	"explain.example":       "Example",
+	"explain.example-n":     "Example %d",
+	"explain.good":          "%s - fixed code",
+	"explain.links":         "References",
+	"explain.meta":          "Language: %s, severity: %s, category: %s",
+	"explain.not-found":     "Rule not found: %s (see cqc rules list for rule IDs)",
+	"explain.rationale":     "Why it matters",
+	"explain.unimplemented": "(This rule has no check implementation yet and reports no issues)",
+
+	"feature-flag.literal.description": "Flag names scattered across the code are hard to find, so retired flags never get cleaned up",
+	"feature-flag.literal.message":     "Feature flag '%s' is used as a string literal",
+	"feature-flag.literal.suggestion":  "Declare it as a constant in %s and use the constant",
+
+	"html.button-text.description":          "Screen reader users cannot tell what the button does",
+	"html.button-text.message":              "button element has no accessible text",
+	"html.button-text.suggestion":           "Add an aria-label attribute or button text",
+	"html.div-onclick.description":          "It is not keyboard accessible and is hard for screen readers to recognize",
+	"html.div-onclick.message":              "onclick is used on a div element",
+	"html.div-onclick.suggestion":           "Use a button element or add appropriate ARIA attributes",
+	"html.img-alt.description":              "Alternative text is required for visually impaired users",
+	"html.img-alt.message":                  "img tag is missing an alt attribute or the alt is empty",
+	"html.img-alt.suggestion":               "Add a meaningful alt attribute to the img tag",
+	"html.input-label.description":          "Users cannot easily tell what the input field is for",
+	"html.input-label.message":              "input element has no associated label",
+	"html.input-label.suggestion":           "Use a label element or add an aria-label attribute",
+	"html.seo-h1-missing.description":       "The page needs a main heading",
+	"html.seo-h1-missing.message":           "Missing h1 tag",
+	"html.seo-h1-missing.suggestion":        "Use an h1 tag for the main heading of the page",
+	"html.seo-h1-multiple.description":      "Use only one h1 tag per page",
+	"html.seo-h1-multiple.message":          "Multiple h1 tags are used",
+	"html.seo-h1-multiple.suggestion":       "Use h2, h3, etc. for additional headings",
+	"html.seo-meta-description.description": "A page description is needed for search results",
+	"html.seo-meta-description.message":     "Missing meta description",
+	"html.seo-meta-description.suggestion":  "Add a <meta name=\"description\" content=\"Page description\"> tag",
+	"html.seo-title.description":            "The page title is very important for SEO",
+	"html.seo-title.message":                "Missing title tag",
+	"html.seo-title.suggestion":             "Add a <title> tag to the head section",
+
+	"html-report.chart-category":        "Issues by category",
+	"html-report.chart-files":           "Files with the most issues (top 10)",
+	"html-report.chart-severity":        "Severity distribution",
+	"html-report.clear-selection":       "Clear selection",
+	"html-report.code-link":             "View code ↗",
+	"html-report.column-category":       "Category",
+	"html-report.column-file":           "File",
+	"html-report.column-fixed":          "Fixed",
+	"html-report.column-flag":           "Flag",
+	"html-report.column-hotspot":        "Hotspot file",
+	"html-report.column-issues":         "Issues",
+	"html-report.column-language":       "Language",
+	"html-report.column-literal-usages": "String literal usages",
+	"html-report.column-location":       "Location",
+	"html-report.column-message":        "Message",
+	"html-report.column-new":            "New",
+	"html-report.column-reason":         "Reason",
+	"html-report.column-rule":           "Rule",
+	"html-report.column-score":          "Score",
+	"html-report.column-suppression":    "Suppression",
+	"html-report.column-unchanged":      "Unchanged",
+	"html-report.column-usages":         "Usages",
+	"html-report.column-worst-files":    "Files with the most issues",
+	"html-report.commit":                "commit %s",
+	"html-report.degraded":              "🧩 Files analyzed as plain text",
+	"html-report.degraded-note":         "Parsing failed or timed out, so rules that need the AST were not checked.",
+	"html-report.delta":                 "🔄 Changes since the last run",
+	"html-report.delta-fixed":           "Fixed issues",
+	"html-report.delta-new":             "New issues",
+	"html-report.delta-unchanged":       "Unchanged issues",
+	"html-report.example-bad":           "❌ Violating code",
+	"html-report.example-good":          "✅ Fixed code",
+	"html-report.examples":              "📝 Show fix examples",
+	"html-report.export-columns":        "Severity | Rule | Location | Message | Suggestion",
+	"html-report.files":                 "📁 Issues by file",
+	"html-report.files-analyzed":        "Files analyzed",
+	"html-report.finished":              "Finished at: %s",
+	"html-report.flags-note":            "Feature flags used in the code. Clean up flags that are fully rolled out together with their code.",
+	"html-report.issue-count":           "%d issues",
+	"html-report.issues-found":          "Issues found",
+	"html-report.label-category":        "Category:",
+	"html-report.label-classification":  "Security classification:",
+	"html-report.label-description":     "Description:",
+	"html-report.label-escalation":      "⬆️ Escalated:",
+	"html-report.label-fingerprint":     "Fingerprint:",
+	"html-report.label-priority":        "🎯 Priority:",
+	"html-report.label-rule":            "Rule:",
+	"html-report.label-suggestion":      "💡 Suggestion:",
+	"html-report.label-triage":          "🏷️ Triage:",
+	"html-report.no-issues":             "✅ No issues found!",
+	"html-report.owasp":                 "🛡️ OWASP Top 10 summary",
+	"html-report.priority":              "%d (estimated effort %dmin)",
+	"html-report.ranking":               "🏆 Rules and files with the most issues",
+	"html-report.rule-nav":              "Jump to rule",
+	"html-report.rules":                 "📋 Issues by rule",
+	"html-report.ruleset":               "ruleset %s",
+	"html-report.ruleset-hash":          "Ruleset hash: %s",
+	"html-report.sampling":              "🎲 Sampled analysis",
+	"html-report.sampling-detail":       "%d files in total, %d sampled, seed %d",
+	"html-report.sampling-estimate":     "Estimated total issues",
+	"html-report.sampling-ratio":        "Sample ratio",
+	"html-report.select":                "Select",
+	"html-report.selected":              "Selected issues:",
+	"html-report.selected-unit":         "",
+	"html-report.severities":            "⚠️ Issues by severity",
+	"html-report.signed":                "signed",
+	"html-report.source":                "🗂️ Source view",
+	"html-report.source-missing":        "The file cannot be read (the report was generated somewhere other than where it was analyzed)",
+	"html-report.source-note":           "Lines with issues are highlighted. Hover over a line number to see its issues.",
+	"html-report.source-redacted":       "The source is not included because some issues have redacted snippets",
+	"html-report.source-too-large":      "The source is not included because the file is too large",
+	"html-report.source-unreadable":     "The file cannot be read",
+	"html-report.suppressed":            "🔕 Suppressed issues",
+	"html-report.tab-files":             "By file",
+	"html-report.tab-overview":          "Overview",
+	"html-report.tab-rules":             "By rule",
+	"html-report.tab-severity":          "By severity",
+	"html-report.tab-source":            "Source",
+	"html-report.theme-toggle":          "🌓 Toggle theme",
+
+	"i18n.hardcoded.description": "Hardcoded text cannot be translated per language, so supporting more languages means editing code",
+	"i18n.hardcoded.message":     "User-facing text is hardcoded: \"%s\"",
+	"i18n.java.suggestion":       "Read it from the message bundle (messages.properties) with messageSource.getMessage(\"key\", args, locale)",
+	"i18n.js.suggestion":         "Replace it with a message key such as t('key') from your i18n library and move the text to per-language resource files",
+	"i18n.jsp.suggestion":        "Use text from the message bundle with <spring:message code=\"key\"/> or <fmt:message key=\"key\"/>",
+	"i18n.thymeleaf.suggestion":  "Use text from the message bundle (messages.properties) with th:text=\"#{key}\"",
+
+	"import-order.description":       "Import group order: %s",
+	"import-order.groups.message":    "Import groups must be separated by a single blank line",
+	"import-order.groups.suggestion": "Run with --fix to regroup the imports automatically",
+	"import-order.order.message":     "Imports are out of order: '%s' should come before '%s'",
+	"import-order.order.suggestion":  "Run with --fix to reorder the imports automatically",
+
+	"index.files":   "%d files (%d indexed, %d reused, %d removed)",
+	"index.header":  "🗂️  Symbol index: %s",
+	"index.symbols": "%d symbols",
+
+	"init.create-failed":    "Failed to create config file: %v",
+	"init.created":          "✅ Created starter config: %s (%s, %d rules)",
+	"init.defaults-failed":  "Failed to read default config: %v",
+	"init.detect-failed":    "Failed to detect languages: %v",
+	"init.exists":           "%s already exists (use --force to overwrite)",
+	"init.header":           "Config file generated by cqc init (built-in rules and defaults; delete rules you do not need and adjust severities and options to your team standards)",
+	"init.header-languages": "Detected languages: %s (run cqc init --all-languages --force for rules of other languages)",
+	"init.invalid-defaults": "The default config is malformed",
+	"init.no-sources":       "No supported source files were found, so rules for all languages were included",
+	"init.save-failed":      "Failed to save config file: %v",
+
+	"java.annotation-mix.description":                     "Inconsistent annotation usage lowers code quality",
+	"java.annotation-mix.info-description":                "Prefer a single dependency injection annotation",
+	"java.annotation-mix.info-message":                    "@Resource and @Autowired are mixed in the same class",
+	"java.annotation-mix.info-suggestion":                 "Use @Autowired throughout the project",
+	"java.annotation-mix.message":                         "@Resource and @Autowired are mixed",
+	"java.annotation-mix.suggestion":                      "Use @Autowired consistently (Spring recommendation)",
+	"java.bean-validation.description":                    "Without standard validation, the risk of SQL injection, XSS and other vulnerabilities increases",
+	"java.bean-validation.message":                        "Use standard Bean Validation instead of custom validation logic",
+	"java.bean-validation.suggestion":                     "Use Bean Validation annotations such as @Valid, @NotNull and @Size",
+	"java.complexity.description":                         "High cyclomatic complexity makes code harder to understand and test",
+	"java.complexity.message":                             "Method '%s' has too high cyclomatic complexity (complexity: %d)",
+	"java.complexity.suggestion":                          "Split the method into smaller units to reduce complexity",
+	"java.controller-advice.description":                  "Consistent exception handling needs a global exception handler",
+	"java.controller-advice.message":                      "There is no global exception handler (@ControllerAdvice)",
+	"java.controller-advice.suggestion":                   "Create a @ControllerAdvice class to handle exceptions globally",
+	"java.controller-dao.description":                     "Violating the layered architecture hurts maintainability",
+	"java.controller-dao.message":                         "The controller depends on a DAO directly",
+	"java.controller-dao.suggestion":                      "Access data through the service layer",
+	"java.duplicate-block.description":                    "The same code block is repeated in several places",
+	"java.duplicate-block.message":                        "Duplicated code block found (repeated in %d places)",
+	"java.duplicate-block.suggestion":                     "Extract it into a shared method to remove the duplication",
+	"java.duplicate-method.description":                   "Methods with almost the same body are repeated",
+	"java.duplicate-method.message":                       "Method '%s' is %[4]d%% similar to '%[2]s' (line %[3]d)",
+	"java.duplicate-method.suggestion":                    "Extract a shared method or turn the differences into parameters",
+	"java.duplicate-pattern.code-list.description":        "The code list lookup is repeated",
+	"java.duplicate-pattern.code-list.suggestion":         "Apply caching or extract it into a shared method",
+	"java.duplicate-pattern.custom.description":           "This code pattern is repeated",
+	"java.duplicate-pattern.custom.suggestion":            "Extract it into a shared method to remove the duplication",
+	"java.duplicate-pattern.log-return.description":       "The log then return pattern is repeated",
+	"java.duplicate-pattern.log-return.suggestion":        "Create a shared logging utility and use it",
+	"java.duplicate-pattern.message":                      "Duplicated code pattern found (repeated %d times)",
+	"java.duplicate-pattern.null-check-throw.description": "The null check and throw pattern is duplicated",
+	"java.duplicate-pattern.null-check-throw.suggestion":  "Create a shared validation method and use it",
+	"java.duplicate-pattern.response-put.description":     "The API response building pattern is duplicated",
+	"java.duplicate-pattern.response-put.suggestion":      "Create a shared response class (ApiResponse) and use it",
+	"java.final-newline.description":                      "Does not match insert_final_newline in .editorconfig",
+	"java.final-newline.extra-message":                    "The file ends with a newline",
+	"java.final-newline.extra-suggestion":                 "Remove the newline at the end of the file",
+	"java.final-newline.missing-message":                  "The file does not end with a newline",
+	"java.final-newline.missing-suggestion":               "Add a newline at the end of the file",
+	"java.generic-exception.description":                  "Prefer specific exception types",
+	"java.generic-exception.message":                      "A generic Exception type is used",
+	"java.generic-exception.suggestion":                   "Define and use specific exception classes (BusinessException, etc.)",
+	"java.indent.description":                             "Does not match the .editorconfig indentation (indent_style: %s)",
+	"java.indent.size-message":                            "Indentation is not a multiple of %d (%d columns)",
+	"java.indent.size-suggestion":                         "Indent in multiples of %d columns",
+	"java.indent.space-message":                           "Indented with spaces instead of tabs",
+	"java.indent.space-suggestion":                        "Replace the spaces with tabs",
+	"java.indent.tab-message":                             "Indented with tabs instead of spaces",
+	"java.indent.tab-suggestion":                          "Replace the tabs with spaces",
+	"java.line-length.description":                        "Long lines hurt readability",
+	"java.line-length.message":                            "Line is too long (%d characters)",
+	"java.line-length.suggestion":                         "Split the line to at most %s characters",
+	"java.long-method.description":                        "Long methods hurt readability and maintainability",
+	"java.long-method.message":                            "Method is too long (%s: %d lines, threshold: %d)",
+	"java.long-method.suggestion":                         "Split the method into smaller units",
+	"java.magic-number.description":                       "Hardcoded numbers make code harder to read",
+	"java.magic-number.message":                           "Magic number found: %s",
+	"java.magic-number.suggestion":                        "Define it as a meaningful constant",
+	"java.mixed-indent.description":                       "Consistent indentation improves readability",
+	"java.mixed-indent.message":                           "Tabs and spaces are mixed",
+	"java.mixed-indent.suggestion":                        "Use either tabs or spaces consistently",
+	"java.print-stack-trace.description":                  "Exposing stack traces on the console is a security risk",
+	"java.print-stack-trace.message":                      "printStackTrace() is used",
+	"java.print-stack-trace.suggestion":                   "Log the exception properly through a Logger",
+	"java.request-body-valid.description":                 "Without input validation, invalid data may be processed",
+	"java.request-body-valid.message":                     "The @RequestBody parameter is missing @Valid",
+	"java.request-body-valid.suggestion":                  "Use @RequestBody @Valid to validate automatically",
+	"java.system-out.description":                         "It may expose unnecessary information in production",
+	"java.system-out.message":                             "System.out.println is used",
+	"java.system-out.suggestion":                          "Log through a Logger",
+	"java.transactional.description":                      "Complex data changes need a transaction",
+	"java.transactional.message":                          "Method '%s' needs @Transactional: %s",
+	"java.transactional.reason-conditional":               "conditional data changes",
+	"java.transactional.reason-external":                  "external system calls with DB operations",
+	"java.transactional.reason-mixed":                     "mixed data operations (create/update/delete)",
+	"java.transactional.reason-repositories":              "multiple table operations (%d repository calls)",
+	"java.transactional.suggestion":                       "Add the @Transactional annotation to the method",
+
+	"js.console-log.description":         "Console output can affect performance in production",
+	"js.console-log.message":             "console.log is used",
+	"js.console-log.suggestion":          "Use a proper logging library or remove it in production",
+	"js.event-listener-leak.description": "removeEventListener is not called after addEventListener",
+	"js.event-listener-leak.message":     "Event listener is never removed and may leak memory",
+	"js.event-listener-leak.suggestion":  "Call removeEventListener when the component is torn down",
+	"js.function-length.description":     "Long functions hurt readability and maintainability",
+	"js.function-length.message":         "Function is too long (%s: %d lines)",
+	"js.function-length.suggestion":      "Split the function into smaller units",
+	"js.innerhtml.description":           "Assigning user input directly to innerHTML is vulnerable to XSS attacks",
+	"js.innerhtml.message":               "Possible XSS vulnerability through innerHTML",
+	"js.innerhtml.suggestion":            "Use textContent or escape the input",
+	"js.timer-leak.description":          "No clear function is called after setInterval/setTimeout",
+	"js.timer-leak.message":              "Timer is never cleared and may leak memory",
+	"js.timer-leak.suggestion":           "Call clearInterval/clearTimeout when the component is torn down",
+	"js.var-usage.description":           "var can cause hoisting and scoping problems",
+	"js.var-usage.message":               "var keyword is used",
+	"js.var-usage.suggestion":            "Use let or const",
+
+	"limit.file.message":    "Too many issues; %d were omitted",
+	"limit.file.suggestion": "Adjust the limit with the analysis.max_issues_per_file setting",
+	"limit.rule.message":    "%[2]d more issues from rule %[1]s were omitted",
+	"limit.rule.suggestion": "Fix the repeated pattern in one pass, or exclude the file from analysis if it is generated",
+
+	"list-files.large":    "(large %.1fMB: line rules only)",
+	"list-files.sampling": "Sampling: %[2]d of %[1]d files selected (seed %[3]d)",
+	"list-files.total":    "Files to analyze: %d",
+
+	"logging.business-error.description": "Logging expected failures such as bad user input at error level triggers alerts and buries real errors",
+	"logging.business-error.message":     "A business validation failure is logged at error level",
+	"logging.business-error.suggestion":  "Log business validation failures at warn level or below",
+	"logging.concat.description":         "Concatenation costs time even when the level is disabled, and the message format is inconsistent",
+	"logging.concat.message":             "The log message is built by string concatenation",
+	"logging.concat.suggestion":          "Use placeholders such as log.%s(\"... {}\", value)",
+	"logging.factory.description":        "Depending on a logging implementation directly makes log configuration and MDC propagation diverge from the team standard",
+	"logging.factory.message":            "Logger '%s' is created in an unapproved way: %s",
+	"logging.factory.separator":          " or ",
+	"logging.factory.suggestion":         "Create the logger with %s(...)",
+	"logging.modifiers.description":      "Each class should have a single logger that cannot be changed from outside",
+	"logging.modifiers.message":          "Logger field '%s' is missing the %s modifiers",
+	"logging.modifiers.suggestion":       "Declare it as private static final Logger %s = ...",
+
+	"markdown.by-file":          "📁 Issues by file",
+	"markdown.by-severity":      "📊 Issues by severity",
+	"markdown.category-columns": "Category | Issues",
+	"markdown.commit":           "Commit",
+	"markdown.duration":         "Duration",
+	"markdown.failed-gates":     "🚦 Failed quality gates",
+	"markdown.gate":             "%d (allowed %d, %s and above)",
+	"markdown.issue-columns":    "Severity | Priority | Rule | Location | Message",
+	"markdown.item-columns":     "Item | Value",
+	"markdown.line":             "line %d",
+	"markdown.ruleset-hash":     "Ruleset hash",
+	"markdown.seconds":          "%.2fs",
+	"markdown.severity-columns": "Severity | Issues",
+	"markdown.suggestion-label": "**Suggestion:** %s",
+	"markdown.suppressed":       "Suppressed issues",
+	"markdown.title":            "🔍 Code Quality Report",
+	"markdown.tool-version":     "Tool version",
+	"markdown.top-issues":       "🔥 Top issues (top %d)",
+	"markdown.total-files":      "Files analyzed",
+	"markdown.total-issues":     "Issues found",
+
+	"naming.description":     "Follow the team naming convention (%s_pattern: %s)",
+	"naming.label.class":     "class name",
+	"naming.label.constant":  "constant name",
+	"naming.label.css-class": "CSS class name",
+	"naming.label.field":     "field name",
+	"naming.label.function":  "function name",
+	"naming.label.method":    "method name",
+	"naming.label.package":   "package name",
+	"naming.message":         "The %s does not follow the naming convention: %s",
+	"naming.suggestion":      "Change the %s to match the %s pattern",
+
+	"null-safety.collection-null.description":      "Callers assume collections are never null and iterate right away, so an NPE is likely",
+	"null-safety.collection-null.empty-suggestion": "Return Collections.empty%[1]s() or %[1]s.of() instead of null",
+	"null-safety.collection-null.message":          "Method '%[2]s' returning %[1]s returns null",
+	"null-safety.collection-null.suggestion":       "Return an empty collection instead of null",
+	"null-safety.nonnull-return.description":       "Callers that trust the annotation and skip the null check will hit an NPE",
+	"null-safety.nonnull-return.message":           "Method '%s' is declared not to return null but returns null",
+	"null-safety.nonnull-return.suggestion":        "Return an empty value or throw instead of null, or declare it @Nullable if null is intended",
+	"null-safety.nullable-param.description":       "Dereferencing a parameter declared as possibly null causes an NPE",
+	"null-safety.nullable-param.message":           "@Nullable parameter '%s' is used without a null check",
+	"null-safety.nullable-param.suggestion":        "Check for null before use, or remove @Nullable if null is not accepted",
+	"null-safety.nullable-result.description":      "Dereferencing the result of a method declared as possibly returning null causes an NPE",
+	"null-safety.nullable-result.message":          "The result of @Nullable method '%s' is used without a null check",
+	"null-safety.nullable-result.suggestion":       "Store the result and check for null, or change the method to return Optional",
+	"null-safety.optional-chain-get.description":   "If the lookup finds nothing, NoSuchElementException is thrown",
+	"null-safety.optional-chain-get.message":       "get() is called on an Optional result without a check",
+	"null-safety.optional-chain-get.suggestion":    "Declare the exception for the missing case, e.g. orElseThrow(() -> new NotFoundException(...))",
+	"null-safety.optional-get.description":         "If the value is absent, NoSuchElementException is thrown, which defeats the purpose of Optional",
+	"null-safety.optional-get.message":             "get() is called on Optional '%s' without checking isPresent()",
+	"null-safety.optional-get.suggestion":          "Handle the missing value explicitly with orElseThrow(), orElse(), ifPresent() and so on",
+
+	"outputs.duplicate-target": "Cannot write several reports to the same location: %s",
+	"outputs.invalid-report":   "--report must be in format:path form: %s",
+	"outputs.need-file":        "Specify a file name (without extension) with --output-file to write several formats",
+
+	"publish.confluence":     "📄 Published Confluence page: %s",
+	"publish.marshal-failed": "Failed to marshal JSON",
+	"publish.uploaded":       "☁️  Reports uploaded (%s://%s)",
+	"publish.uploading":      "Uploading: %s/%s",
+
+	"report.by-category":           "📂 Issues by category",
+	"report.by-language":           "💻 Files by language",
+	"report.by-severity":           "⚠️  Issues by severity",
+	"report.code-label":            "Code: %s",
+	"report.confidence":            "(confidence: %s)",
+	"report.count":                 "%d",
+	"report.delta":                 "🔄 Changes since the last run (%s)",
+	"report.delta-counts":          "%d new / %d fixed / %d unchanged",
+	"report.duration":              "Duration: %.2fs",
+	"report.escalation":            "%s → %s (found in %d consecutive runs)",
+	"report.escalation-since":      "%s → %s (found in %d consecutive runs, first seen %s)",
+	"report.flag-literals":         "(%d string literal usages)",
+	"report.flag-usages":           "%d usages in %d files",
+	"report.flags":                 "🚩 Feature flags (%d)",
+	"report.group-issues":          "%s (%d)",
+	"report.hotspot-score":         "score %d, %d issues",
+	"report.hotspots":              "🔥 Hotspot files (top %d, by severity-weighted score)",
+	"report.issues":                "🐛 Issues",
+	"report.maturity-experimental": "(experimental rule, does not affect exit code)",
+	"report.maturity-deprecated":   "(deprecated rule)",
+	"report.more":                  "... and %d more",
+	"report.more-issues":           "... and %d more issues",
+	"report.no-issues":             "✅ No issues found!",
+	"report.owasp":                 "🛡️  OWASP Top 10 summary",
+	"report.peak-memory":           "Peak memory: %.1fMB",
+	"report.preview":               "👀 Preview (up to %d representative issues per rule)",
+	"report.preview-rule":          "%d total in %d files, %d shown",
+	"report.priority":              "Priority %d (estimated effort %dmin)",
+	"report.recommend-critical":    "🚨 Fix critical issues immediately!",
+	"report.recommend-high":        "⚠️  Fix high issues before the release.",
+	"report.recommend-medium":      "📝 Improve medium issues gradually.",
+	"report.recommendations":       "💡 Recommendations",
+	"report.sampling":              "🎲 Sampled analysis (%d files in total, %d sampled, %.1f%%, seed %d)",
+	"report.sampling-count":        "about %d",
+	"report.sampling-estimate":     "Estimated total issues: about %d",
+	"report.severity-issues":       "%s issues (%d)",
+	"report.suggestion-label":      "Suggestion: %s",
+	"report.summary":               "📊 Summary",
+	"report.suppressed":            "🔕 Suppressed issues (%d, %d expired)",
+	"report.suppression-baseline":  "Baseline",
+	"report.suppression-expired":   "expired %s and reported again",
+	"report.suppression-inline":    "Inline comment (line %d)",
+	"report.suppression-no-expiry": "no expiry",
+	"report.suppression-triage":    "Triage: %s",
+	"report.suppression-until":     "until %s",
+	"report.tap-no-issues":         "no issues (%d files analyzed)",
+	"report.throughput":            "Throughput: %.1f files/s (%.1fKB)",
+	"report.title":                 "🔍 Code Quality Checker Report",
+	"report.top-rules":             "🏆 Rules with the most issues (top %d)",
+	"report.total-files":           "Files analyzed: %d",
+	"report.total-issues":          "Issues found: %d",
+	"report.triage-accepted":       "risk accepted",
+	"report.triage-false-positive": "false positive",
+	"report.triage-open":           "investigating",
+	"report.triage-owner":          "(owner: %s)",
+	"report.triage-wont-fix":       "won't fix",
+	"report.warnings":              "⚠️  Analysis warnings",
+
+	"rules.count":                "%d rules",
+	"rules.describe.bad-example": "Violating example:",
+	"rules.describe.banned":      "Banned entries: %d",
+	"rules.describe.category":    "  Category:   %s",
+	"rules.describe.confidence":  "  Confidence: %s",
+	"rules.describe.exclude":     "Excluded paths: %s",
+	"rules.describe.explain":     "Fixed examples and references: cqc explain %s",
+	"rules.describe.language":    "  Language:   %s",
+	"rules.describe.maturity":    "  Maturity:   %s",
+	"rules.describe.name":        "  Name:       %s",
+	"rules.describe.no-examples": "Examples: none (add them with examples in the config file)",
+	"rules.describe.pack":        "  Rule pack:  %s",
+	"rules.describe.severity":    "  Severity:   %s",
+	"rules.none":                 "No rules match the filters",
+	"rules.option.default":       "%q (default)",
+	"rules.option.unset":         "(not set)",
+	"rules.options":              "Options (custom):",
+	"rules.sync.done":            "Synced %d rule packs: %s",
+	"rules.sync.failed":          "Failed to sync rule packs: %v",
+	"rules.sync.no-packs":        "There are no rule packs to sync (set registry.packs)",
+	"rules.sync.no-registry":     "No registry URL is set (--registry or registry.url)",
+
+	"spring.batch-fault-tolerance.description":    "One bad record or a transient failure fails the whole step, and the remaining data is not processed until a restart",
+	"spring.batch-fault-tolerance.message":        "The chunk step is not configured with faultTolerant()",
+	"spring.batch-fault-tolerance.policy-message": "The chunk step has no skip/retry policy",
+	"spring.batch-fault-tolerance.suggestion":     "Define a failure policy with .faultTolerant().skip(...).skipLimit(n) or .retry(...).retryLimit(n)",
+	"spring.controller-advice.description":        "Implement a global exception handler for consistent exception handling",
+	"spring.controller-advice.message":            "There is no global exception handler (@ControllerAdvice)",
+	"spring.controller-advice.suggestion":         "Create a global exception handling class with @ControllerAdvice",
+	"spring.field-injection.description":          "Constructor injection guarantees immutability and is easier to test",
+	"spring.field-injection.message":              "Use constructor injection instead of field injection: %s",
+	"spring.field-injection.suggestion":           "Use final fields with a constructor, or @RequiredArgsConstructor",
+	"spring.pagination.description":               "As data grows, loading the whole result into memory at once can cause an OutOfMemoryError",
+	"spring.pagination.message":                   "Method '%s' returns the result of %s() as a list without pagination",
+	"spring.pagination.suggestion":                "Accept a Pageable parameter and query a Page/Slice, or limit the number of rows",
+	"spring.response-created.description":         "When a POST that creates a resource returns 200, clients cannot tell whether or where it was created",
+	"spring.response-created.message":             "POST handler '%s' does not return 201 Created",
+	"spring.response-created.suggestion":          "Use ResponseEntity.created(location) or ResponseEntity.status(HttpStatus.CREATED)",
+	"spring.response-forbidden.description":       "Building responses by hand with a Map makes the format differ per API and repeats the same code",
+	"spring.response-forbidden.message":           "Handler method '%s' returns %s instead of the standard response type",
+	"spring.response-forbidden.suggestion":        "Wrap the result in the standard response type (%s)",
+	"spring.response-no-content.description":      "A successful response without a body should be 204 No Content per HTTP",
+	"spring.response-no-content.message":          "DELETE handler '%s' returns 200 without a body",
+	"spring.response-no-content.suggestion":       "Use ResponseEntity.noContent().build()",
+	"spring.response-raw.description":             "The body type is hidden, so it is unclear whether the standard response format is followed",
+	"spring.response-raw.message":                 "Handler method '%s' returns a ResponseEntity without a type argument",
+	"spring.response-raw.suggestion":              "Declare the body type as ResponseEntity<%s<T>>",
+	"spring.response-type.description":            "When each API uses a different response format, clients cannot handle success and failure consistently",
+	"spring.response-type.message":                "The response type %[2]s of handler method '%[1]s' is not the standard response type (%[3]s)",
+	"spring.response-type.suggestion":             "Wrap %[1]s as %[2]s<%[1]s>",
+	"spring.response-void.description":            "A void response leaves the status code and body implicit, so clients cannot handle results consistently",
+	"spring.response-void.message":                "Handler method '%s' returns void",
+	"spring.response-void.suggestion":             "Return %s or declare the status code with @ResponseStatus",
+	"spring.scheduled-error.description":          "Exceptions on the scheduler thread leave only a single log line, so job failures are easy to miss",
+	"spring.scheduled-error.message":              "@Scheduled method '%s' has no exception handling",
+	"spring.scheduled-error.suggestion":           "Wrap the body in try/catch to record the failure and send an alert",
+	"spring.scheduled-lock.description":           "When deployed to several instances, the same job runs on every instance at once",
+	"spring.scheduled-lock.message":               "@Scheduled method '%s' has no distributed lock (@%s)",
+	"spring.scheduled-lock.suggestion":            "Use a distributed lock such as @%s so the job runs on only one instance",
+	"spring.secured.description":                  "@PreAuthorize supports SpEL and allows more flexible security rules",
+	"spring.secured.message":                      "Prefer @PreAuthorize over @Secured",
+	"spring.secured.suggestion":                   "Change it to @PreAuthorize(\"hasRole('ROLE_NAME')\")",
+	"spring.security-annotation.description":      "Delete, update and admin operations need proper authorization checks",
+	"spring.security-annotation.message":          "A sensitive method is missing a security annotation: %s",
+	"spring.security-annotation.suggestion":       "Add a security annotation such as @PreAuthorize(\"hasRole('ADMIN')\")",
+	"spring.transactional-private.description":    "Proxies do not apply to private methods, so no transaction is started",
+	"spring.transactional-private.message":        "@Transactional is used on a private method",
+	"spring.transactional-private.suggestion":     "Make the method public or use @Transactional at the class level",
+	"spring.transactional-rollback.description":   "Checked exceptions may not trigger a rollback",
+	"spring.transactional-rollback.message":       "@Transactional is missing rollbackFor",
+	"spring.transactional-rollback.suggestion":    "Use @Transactional(rollbackFor = Exception.class)",
+	"spring.validation.description":               "Missing input validation can lead to security vulnerabilities",
+	"spring.validation.message":                   "The @RequestBody parameter is missing @Valid",
+	"spring.validation.suggestion":                "Add @Valid to validate the input",
+
+	"sql.failed":        "Failed to print SQL statements: %v",
+	"sql.mapper-failed": "Failed to analyze MyBatis mappers: %v",
+
+	"taint.other.message":       "User input (%s) flows into %s without sanitization",
+	"taint.other.suggestion":    "Validate the input or pass it through a sanitizer first",
+	"taint.redirect.message":    "User input (%s) is used as a redirect target (%s) without validation",
+	"taint.redirect.suggestion": "Check the target against an allow-list of paths/domains before redirecting",
+	"taint.sql.message":         "User input (%s) flows directly into SQL execution (%s)",
+	"taint.sql.suggestion":      "Use PreparedStatement or bind variables (?, :name)",
+	"taint.xss.message":         "User input (%s) is written to HTML output (%s) without escaping",
+	"taint.xss.suggestion":      "HTML-escape the value before output or use a safe API such as textContent",
+
+	"template.c-out-unescaped.message":    "<c:out escapeXml=\"false\"> outputs data without HTML escaping",
+	"template.c-out-unescaped.suggestion": "Remove escapeXml=\"false\" (defaults to true)",
+	"template.jsp-expression.message":     "<%= %> expressions output data without HTML escaping",
+	"template.jsp-expression.suggestion":  "Use <c:out value=\"${...}\"/> or ${fn:escapeXml(...)}",
+	"template.th-inline-raw.message":      "[(...)] inline expressions output data without HTML escaping",
+	"template.th-inline-raw.suggestion":   "Use [[...]] inline expressions",
+	"template.th-utext.message":           "th:utext outputs data without HTML escaping",
+	"template.th-utext.suggestion":        "Use th:text, or only output values sanitized on the server to allowed tags with th:utext",
+
+	"trends.created":      "📈 Trend report generated: %s",
+	"trends.failed":       "Failed to print trends: %v",
+	"trends.open-failed":  "Cannot open the run history file: %v",
+	"trends.query-failed": "Failed to query run history: %v",
+
+	"verify.commit":       "Commit:        %s",
+	"verify.no-key":       "❌ The signing key environment variable %s is empty",
+	"verify.no-metadata":  "❌ The result has no metadata",
+	"verify.ok":           "✅ Signature verified",
+	"verify.ruleset-hash": "Ruleset hash:  %s",
+	"verify.tool-version": "Tool version:  %s",
+
+	"web-performance.blocking-script.description": "Synchronous scripts block HTML parsing and first render until they are downloaded and run",
+	"web-performance.blocking-script.message":     "A script is loaded in <head> without defer/async",
+	"web-performance.blocking-script.suggestion":  "Add defer (keeps execution order) or async, or move the script right before </body>",
+	"web-performance.img-size.description":        "Without known image dimensions, the layout shifts after the image loads and CLS (Cumulative Layout Shift) gets worse",
+	"web-performance.img-size.message":            "The img tag has no %s attribute",
+	"web-performance.img-size.suggestion":         "Set width and height to the image's intrinsic size and handle responsive sizing in CSS (height: auto)",
+	"web-performance.inline-block.description":    "Inline code is not cached by the browser, so it is downloaded on every page view and inflates the HTML response",
+	"web-performance.inline-block.message":        "The inline <%s> block is %s, over the budget (%s)",
+	"web-performance.inline-block.suggestion":     "Move it to an external .%s file so it can be cached",
+	"web-performance.inline-total.description":    "The server-rendered HTML response grows and the page renders later after the first byte",
+	"web-performance.inline-total.message":        "The page's inline CSS/JS totals %s, over the budget (%s) (%d blocks)",
+	"web-performance.inline-total.suggestion":     "Move shared styles and scripts to external files and inline only the CSS the first screen needs",
+	"web-performance.resource-count.description":  "Every file adds a request that delays the first render, and CSS blocks rendering until all of it is downloaded",
+	"web-performance.resource-count.message":      "The page loads %d external CSS/JS files (CSS %d, JS %d, max %d)",
+	"web-performance.resource-count.suggestion":   "Bundle the files at build time and lazy-load resources the first screen does not need",
+
+	"xss-correlation.description": "Rewriting a template element that outputs server data through innerHTML can lead to stored/DOM XSS",
+	"xss-correlation.message":     "innerHTML is written to element #%s, which the template renders with user data (%s:%d)",
+	"xss-correlation.suggestion":  "Use textContent, or escape the output in both the template and the script",
+}
@@ -0,0 +1,79 @@
+// Package i18n 이슈 메시지와 리포트 문구의 언어별 카탈로그
+// 규칙과 리포터는 문구 대신 메시지 키를 참조하고, 출력 시점의 언어로 문구를 결정합니다.
+// 선택한 언어에 번역이 없는 키는 기본 언어(ko) 문구, 그것도 없으면 키 자체로 출력됩니다.
+package i18n
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// DefaultLanguage 번역이 없을 때 사용하는 기본 언어
+const DefaultLanguage = "ko"
+
+var (
+	mu       sync.RWMutex
+	language = DefaultLanguage
+
+	// catalogs 언어별 메시지 카탈로그 (키 → 문구, 문구는 fmt 형식 문자열)
+	catalogs = map[string]map[string]string{
+		"ko": ko,
+		"en": en,
+	}
+)
+
+// SetLanguage 메시지 언어 설정 ("en-US", "en_US.UTF-8"처럼 지역이 붙은 로케일은 언어 코드만 사용)
+func SetLanguage(locale string) {
+	mu.Lock()
+	defer mu.Unlock()
+	language = Normalize(locale)
+}
+
+// Language 현재 메시지 언어
+func Language() string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return language
+}
+
+// Languages 카탈로그가 있는 언어 목록
+func Languages() []string {
+	languages := make([]string, 0, len(catalogs))
+	for lang := range catalogs {
+		languages = append(languages, lang)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Supported 카탈로그가 있는 언어인지
+func Supported(locale string) bool {
+	_, ok := catalogs[Normalize(locale)]
+	return ok
+}
+
+// Normalize 로케일에서 언어 코드만 추출 (비어있으면 기본 언어)
+func Normalize(locale string) string {
+	locale, _, _ = strings.Cut(locale, ".")
+	locale, _, _ = strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	if locale = strings.ToLower(strings.TrimSpace(locale)); locale == "" {
+		return DefaultLanguage
+	}
+	return locale
+}
+
+// T 현재 언어로 메시지 키의 문구 결정 (args가 있으면 fmt.Sprintf로 채움)
+func T(key string, args ...interface{}) string {
+	text, ok := catalogs[Language()][key]
+	if !ok {
+		if text, ok = catalogs[DefaultLanguage][key]; !ok {
+			text = key
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
@@ -0,0 +1,771 @@
+package i18n
+
+// ko 한국어 메시지 카탈로그 (기본 언어, 모든 키를 포함해야 함)
+var ko = map[string]string{
+	"annotate.inserted": "✍️  파일 %d개에 주석 %d개 삽입",
+	"annotate.removed":  "🧹 파일 %d개에서 주석 %d개 제거",
+	"annotate.skipped":  "주석을 달 수 없는 형식(JSON 등)이거나 위치가 없는 이슈 %d개는 건너뛰었습니다",
+	"annotate.undo":     "되돌리려면: cqc annotate %s --remove",
+
+	"badge.created":     "🏷️  배지 생성 완료: %s",
+	"badge.failed":      "배지 생성 실패: %v",
+	"badge.no-input":    "--input, --history 또는 분석할 경로를 지정하세요",
+	"badge.save-failed": "배지 저장 실패: %v",
+
+	"banned.api.message":    "금지된 API가 사용되었습니다: %s",
+	"banned.import.message": "금지된 import가 사용되었습니다: %s",
+	"banned.replacement":    "%s 사용을 권장합니다",
+	"banned.suggestion":     "팀 가이드에 따라 허용된 대체 API를 사용하세요",
+
+	"bench.baseline":             "기준값: %s (cqc %s, %s)",
+	"bench.baseline-load-failed": "경고: 기준값 로드 실패: %v",
+	"bench.baseline-save-failed": "기준값 저장 실패: %v",
+	"bench.baseline-saved":       "💾 기준값 저장: %s",
+	"bench.columns":              "단계              p50        p95",
+	"bench.columns-baseline":     "단계              p50        p95     기준 p95      변화",
+	"bench.format-mismatch":      "리포트 형식이 기준값(%s)과 달라 report/total 단계는 회귀 판정에서 제외합니다",
+	"bench.header":               "⏱️  벤치마크: 파일 %d개, %d회 실행",
+	"bench.regression":           "🐢 성능 회귀: %s p95 %s → %s (+%.1f%%, 허용 %.0f%%)",
+
+	"bundle.builtin":        "📦 기본 제공 번들",
+	"bundle.install-failed": "번들 설치 실패: %v",
+	"bundle.installed":      "✅ %s@%s 설치 완료 (규칙 %d개)",
+	"bundle.installed-list": "✅ 설치된 번들",
+	"bundle.list-failed":    "번들 목록 조회 실패: %v",
+	"bundle.recorded":       "설정 파일에 기록: %s",
+	"bundle.reinstalled":    "✅ %s@%s 재설치 완료 (규칙 %d개)",
+	"bundle.updated":        "✅ %s %s → %s 업데이트 완료 (규칙 %d개)",
+
+	"cli.analysis-failed":           "분석 실패: %v",
+	"cli.collect-failed":            "파일 수집 실패: %v",
+	"cli.config-load-failed":        "설정 파일 로드 실패: %v",
+	"cli.confluence-failed":         "경고: Confluence 게시 실패: %v",
+	"cli.count":                     "%d개",
+	"cli.error":                     "오류 발생: %v",
+	"cli.fix-failed":                "자동 수정 실패: %v",
+	"cli.fixed":                     "🔧 %d개 이슈 자동 수정 완료",
+	"cli.gate-failed":               "🚦 품질 게이트 실패: %s 이슈 %d개 (허용 %d개, %s 이상)",
+	"cli.history-query-failed":      "경고: 실행 기록 조회 실패: %v",
+	"cli.history-save-failed":       "경고: 실행 기록 저장 실패: %v",
+	"cli.history-saved":             "🗃️  실행 기록 저장 완료: %s",
+	"cli.invalid-redact":            "--redact-snippets 값이 잘못되었습니다 (mask/omit): %s",
+	"cli.json-failed":               "JSON 변환 실패: %v",
+	"cli.mailed":                    "📧 리포트 메일 발송 완료 (%d명)",
+	"cli.output-create-failed":      "출력 파일 생성 실패: %v",
+	"cli.previous-load-failed":      "경고: 이전 결과 로드 실패: %v",
+	"cli.report-failed":             "리포트 생성 실패: %v",
+	"cli.reporter-failed":           "리포터 생성 실패: %v",
+	"cli.result-load-failed":        "분석 결과 로드 실패: %v",
+	"cli.result-load-failed-path":   "분석 결과 로드 실패 (%s): %v",
+	"cli.stdin-filename-required":   "--stdin에는 언어 감지에 사용할 --stdin-filename이 필요합니다",
+	"cli.stdin-fix":                 "--fix는 --stdin과 함께 사용할 수 없습니다 (디스크의 파일이 수정됨)",
+	"cli.stdin-list-files":          "--list-files는 --stdin과 함께 사용할 수 없습니다",
+	"cli.stdin-read-failed":         "표준 입력 읽기 실패: %v",
+	"cli.stdin-with-paths":          "--stdin과 분석 경로를 함께 지정할 수 없습니다",
+	"cli.telemetry-failed":          "경고: 집계 지표 전송 실패: %v",
+	"cli.telemetry-sent":            "📈 집계 지표 전송 완료: %s",
+	"cli.unsupported-format":        "지원하지 않는 출력 형식: %s",
+	"cli.unsupported-format-choice": "지원하지 않는 출력 형식: %s (%s 중 하나)",
+	"cli.unsupported-language":      "지원하지 않는 언어: %s (%s 중 하나)",
+	"cli.upload-failed":             "경고: 리포트 업로드 실패: %v",
+	"cli.verbose.cache":             "캐시: 적중 %d개, 미스 %d개, 오류 %d개",
+	"cli.verbose.config":            "설정 파일: %s",
+	"cli.verbose.done":              "분석 완료! 총 %d개 이슈 발견",
+	"cli.verbose.index":             "심볼 색인: 갱신 %d개, 재사용 %d개, 제거 %d개",
+	"cli.verbose.memory":            "최대 메모리 사용량: %.1fMB",
+	"cli.verbose.output":            "출력 형식: %s",
+	"cli.verbose.start":             "Code Quality Checker 시작",
+	"cli.verbose.stdin-target":      "대상 경로: %s (표준 입력)",
+	"cli.verbose.target":            "대상 경로: %s",
+	"cli.warning":                   "경고: %v",
+
+	"compare-config.analysis-failed":  "분석 실패 (%s): %v",
+	"compare-config.header":           "⚖️  설정 비교: %s → %s (%s)",
+	"compare-config.load-failed":      "설정 파일 로드 실패 (%s): %v",
+	"compare-config.severity-changes": "↕️  심각도가 바뀐 이슈 (%d개):",
+	"compare-config.summary":          "전체 이슈 %d개 → %d개 (%+d), 영향받는 파일 %d개",
+
+	"css.descendant-selector.description": "태그명으로 시작하는 복잡한 셀렉터는 성능이 떨어집니다",
+	"css.descendant-selector.message":     "비효율적인 자손 셀렉터입니다",
+	"css.descendant-selector.suggestion":  "클래스나 ID로 시작하는 셀렉터를 사용하세요",
+	"css.duplicate-styles.description":    "동일한 스타일이 여러 셀렉터에 중복 정의되어 있습니다",
+	"css.duplicate-styles.message":        "중복된 CSS 스타일이 발견되었습니다",
+	"css.duplicate-styles.suggestion":     "공통 클래스를 만들어 중복을 제거하세요",
+	"css.fixed-units.description":         "고정 단위는 반응형 디자인에 제한적입니다",
+	"css.fixed-units.message":             "px 단위를 과도하게 사용하고 있습니다",
+	"css.fixed-units.suggestion":          "em, rem, %, vw, vh 등 상대 단위 사용을 고려하세요",
+	"css.media-query.description":         "반응형 디자인을 위해 미디어 쿼리가 필요합니다",
+	"css.media-query.message":             "고정 너비를 사용하지만 미디어 쿼리가 없습니다",
+	"css.media-query.suggestion":          "@media 쿼리를 추가하여 다양한 화면 크기에 대응하세요",
+	"css.modern-layout.description":       "Flexbox나 Grid를 사용하면 더 유연한 레이아웃을 만들 수 있습니다",
+	"css.modern-layout.message":           "모던 레이아웃 기법이 사용되지 않았습니다",
+	"css.modern-layout.suggestion":        "display: flex 또는 display: grid를 고려해보세요",
+	"css.multiple-ids.description":        "ID는 문서에서 고유해야 하므로 여러 ID 셀렉터는 불필요합니다",
+	"css.multiple-ids.message":            "여러 ID 셀렉터가 사용되었습니다",
+	"css.multiple-ids.suggestion":         "하나의 ID만 사용하거나 클래스 셀렉터를 사용하세요",
+	"css.nested-selector.description":     "깊은 중첩은 CSS 성능을 저하시키고 유지보수를 어렵게 합니다",
+	"css.nested-selector.message":         "과도하게 중첩된 CSS 셀렉터입니다",
+	"css.nested-selector.suggestion":      "셀렉터 중첩을 3단계 이하로 줄이세요",
+	"css.universal-selector.description":  "전체 셀렉터는 모든 요소를 검사하여 성능을 저하시킵니다",
+	"css.universal-selector.message":      "전체 셀렉터(*) 사용이 발견되었습니다",
+	"css.universal-selector.suggestion":   "더 구체적인 셀렉터를 사용하세요",
+
+	"datetime.js-date-parse.description":      "Date 문자열 파싱은 형식에 따라 UTC/로컬 시간 해석이 다르고 브라우저마다 결과가 달라질 수 있습니다",
+	"datetime.js-date-parse.message":          "문자열을 Date로 직접 파싱합니다: %s",
+	"datetime.js-date-parse.suggestion":       "date-fns의 parseISO/parse로 형식을 명시해 파싱하고, 표시는 Intl.DateTimeFormat을 사용하세요",
+	"datetime.legacy-api.description":         "java.util.Date/Calendar는 가변 객체이고 시간대 처리가 암묵적이어서 버그가 생기기 쉽습니다",
+	"datetime.legacy-api.message":             "레거시 날짜 API를 사용합니다: %s",
+	"datetime.legacy-api.suggestion":          "java.time의 LocalDate, LocalDateTime, ZonedDateTime, Instant를 사용하세요",
+	"datetime.simple-date-format.description": "서버의 기본 Locale/TimeZone에 따라 결과가 달라지고, SimpleDateFormat은 스레드에 안전하지 않습니다",
+	"datetime.simple-date-format.message":     "SimpleDateFormat에 %s이(가) 지정되지 않았습니다",
+	"datetime.simple-date-format.suggestion":  "DateTimeFormatter.ofPattern(pattern, Locale.KOREA).withZone(ZoneId.of(\"Asia/Seoul\"))를 사용하세요",
+
+	"dependency.banned.message":     "금지된 의존성이 사용되었습니다: %s",
+	"dependency.banned.suggestion":  "팀 가이드에 따라 허용된 대체 라이브러리를 사용하세요",
+	"dependency.license.message":    "금지된 라이선스(%s)의 의존성이 사용되었습니다: %s",
+	"dependency.version.message":    "금지된 버전의 의존성이 사용되었습니다: %s %s (%s)",
+	"dependency.version.suggestion": "정책에서 허용하는 버전으로 변경하세요",
+
+	"diff.by-rule":    "📋 규칙별 변화:",
+	"diff.fixed":      "✅ 해결된 이슈",
+	"diff.header":     "🔄 분석 결과 비교: %s → %s",
+	"diff.new":        "🆕 신규 이슈",
+	"diff.persisting": "⏸️  유지 이슈",
+	"diff.section":    "%s (%d개):",
+	"diff.summary":    "신규 %d개, 해결 %d개, 유지 %d개",
+
+	"doc.option.all_html":                            "true면 Thymeleaf/JSP가 아닌 정적 HTML도 검사",
+	"doc.option.allowed_numbers":                     "0, 1, 2, 10, 100, 1000 외에 허용할 숫자 또는 묶음 이름 (http-status, ports)",
+	"doc.option.allowed_types":                       "표준 응답이 아니어도 허용하는 타입",
+	"doc.option.attributes":                          "검사할 HTML 속성",
+	"doc.option.blank_line_between_groups":           "그룹 사이에 빈 라인 요구",
+	"doc.option.block_size":                          "비교할 블록 라인 수",
+	"doc.option.bounded_calls":                       "결과 수가 제한되어 허용하는 호출",
+	"doc.option.builtin_patterns":                    "사용할 기본 패턴 (생략하면 전체, none이면 사용 안 함)",
+	"doc.option.business_exceptions":                 "error 레벨로 기록하지 않을 업무 예외",
+	"doc.option.constants_class":                     "플래그 이름 상수를 모아 둔 클래스/객체",
+	"doc.option.constants_files":                     "숫자를 허용하는 상수 파일 glob",
+	"doc.option.contexts":                            "문구가 사용자에게 노출된다고 보는 호출/변수 이름",
+	"doc.option.count":                               "lines면 라인 수, statements면 빈 라인/주석/중괄호 라인 제외",
+	"doc.option.error_annotations":                   "예외 처리로 인정할 어노테이션 (예: Retryable)",
+	"doc.option.exclude_methods":                     "계산에서 제외할 메소드 종류 (accessors, object-methods, builders)",
+	"doc.option.flag_calls":                          "기능 플래그 SDK 호출 메소드",
+	"doc.option.forbidden_types":                     "표준 응답 대신 쓰면 안 되는 타입",
+	"doc.option.groups":                              "import 그룹 접두사 순서 (*는 나머지 전부)",
+	"doc.option.ignore_contexts":                     "검사하지 않을 문맥 (annotations, array-sizes, constants, tests)",
+	"doc.option.layers":                              "검사할 계층 (클래스 어노테이션)",
+	"doc.option.list_calls":                          "전체 목록을 조회하는 호출",
+	"doc.option.lock_annotations":                    "분산 락으로 인정할 어노테이션",
+	"doc.option.logger_factories":                    "승인된 로거 생성 호출",
+	"doc.option.max_external_resources":              "페이지당 외부 CSS/JS 파일 수 상한",
+	"doc.option.max_inline_script_bytes":             "인라인 <script> 블록 하나의 바이트 상한",
+	"doc.option.max_inline_style_bytes":              "인라인 <style> 블록 하나의 바이트 상한",
+	"doc.option.max_inline_total_bytes":              "페이지 전체 인라인 코드의 바이트 상한",
+	"doc.option.max_lines":                           "메소드 최대 라인 수",
+	"doc.option.min_block_lines":                     "블록 안의 실제 코드 라인 최소 수",
+	"doc.option.min_method_lines":                    "비교할 메소드 본문의 실제 코드 라인 최소 수",
+	"doc.option.min_occurrences":                     "같은 블록이 이 횟수 이상이면 중복",
+	"doc.option.min_pattern_occurrences":             "패턴이 이 횟수 이상 반복되면 중복",
+	"doc.option.min_words":                           "영어 문구로 볼 최소 단어 수",
+	"doc.option.naming_pattern":                      "%s 이름 정규식",
+	"doc.option.nonnull_annotations":                 "null이 아님을 나타내는 어노테이션",
+	"doc.option.normalize":                           "비교 전 정규화 (none, literals, identifiers)",
+	"doc.option.nullable_annotations":                "null일 수 있음을 나타내는 어노테이션",
+	"doc.option.pageable_types":                      "페이지네이션 파라미터 타입",
+	"doc.option.pattern":                             "프로젝트별 중복 패턴 정규식",
+	"doc.option.scope":                               "method면 메소드 본문끼리, window면 라인 블록끼리 비교 (비어있으면 둘 다)",
+	"doc.option.similarity":                          "메소드 본문이 이 비율(%) 이상 같으면 복제",
+	"doc.option.sort_within_group":                   "그룹 안에서 알파벳 순 정렬",
+	"doc.option.status_checks":                       "상태 코드를 검사할 HTTP 메소드",
+	"doc.option.wrapper_types":                       "표준 응답 타입",
+	"doc.rationale.css-color-contrast":               "대비가 낮은 텍스트는 저시력 사용자와 밝은 야외 환경에서 읽을 수 없습니다 (WCAG AA 기준 4.5:1).",
+	"doc.rationale.css-font-fallbacks":               "웹 폰트를 불러오지 못하면 폴백이 없는 글꼴은 브라우저 기본 글꼴로 바뀌어 화면이 크게 달라집니다.",
+	"doc.rationale.css-important-overuse":            "!important는 명시도 규칙을 무너뜨려 이후 스타일을 덮어쓰려면 또 !important가 필요해지는 악순환을 만듭니다.",
+	"doc.rationale.css-naming-convention":            "일관된 클래스 명명(kebab-case/BEM)은 스타일 충돌을 줄이고 요소 간 관계를 이름만으로 드러냅니다.",
+	"doc.rationale.css-responsive-design":            "고정 폭 레이아웃은 작은 화면에서 가로 스크롤과 잘린 내용을 만듭니다.",
+	"doc.rationale.css-selectors":                    "깊게 중첩된 셀렉터는 마크업 구조에 강하게 묶여 작은 변경에도 스타일이 깨지고, 명시도 경쟁을 부릅니다.",
+	"doc.rationale.css-unused-styles":                "사용하지 않는 CSS는 내려받고 해석하는 비용만 늘리고, 지워도 되는지 판단하기 어려워 계속 쌓입니다.",
+	"doc.rationale.css-vendor-prefixes":              "지원 대상 브라우저가 접두사가 필요한 속성을 쓰면 해당 브라우저에서 스타일이 적용되지 않습니다.",
+	"doc.rationale.html-accessibility":               "키보드로 접근할 수 없거나 역할이 드러나지 않는 요소는 보조 기술 사용자가 기능을 쓸 수 없게 만듭니다.",
+	"doc.rationale.html-deprecated-tags":             "HTML5에서 폐기된 태그는 표현과 구조를 섞고, 브라우저 지원이 언제든 사라질 수 있습니다.",
+	"doc.rationale.html-external-resource-limit":     "외부 CSS/JS 파일이 많을수록 요청 수와 연결 비용이 늘어 페이지 로딩이 느려집니다.",
+	"doc.rationale.html-form-labels":                 "label이 없는 입력 요소는 스크린 리더가 무엇을 입력해야 하는지 알려줄 수 없고, 클릭 영역도 좁아집니다.",
+	"doc.rationale.html-i18n-hardcoded-string":       "템플릿에 문구를 직접 쓰면 다국어를 지원할 때 모든 템플릿을 찾아 고쳐야 합니다.",
+	"doc.rationale.html-img-alt":                     "alt가 없으면 스크린 리더 사용자가 이미지의 의미를 알 수 없고, 이미지를 불러오지 못했을 때 대체 텍스트도 표시되지 않습니다.",
+	"doc.rationale.html-img-dimensions":              "크기를 지정하지 않은 이미지는 불러온 뒤에 공간을 차지해 레이아웃이 밀리고(CLS) 사용자가 잘못 누르게 됩니다.",
+	"doc.rationale.html-inline-code-budget":          "큰 인라인 코드는 캐시되지 않아 페이지를 열 때마다 다시 내려받고, HTML 파싱을 늦춥니다.",
+	"doc.rationale.html-inline-styles":               "인라인 스타일은 재사용과 일괄 변경이 어렵고, 우선순위가 높아 CSS 파일의 스타일을 덮어씁니다.",
+	"doc.rationale.html-render-blocking-script":      "<head>의 동기 스크립트는 내려받아 실행할 때까지 HTML 파싱을 멈춰 첫 화면 표시가 늦어집니다.",
+	"doc.rationale.html-semantic-markup":             "의미 있는 태그는 스크린 리더의 탐색, 검색 엔진의 구조 이해, 스타일 유지보수를 모두 쉽게 만듭니다.",
+	"doc.rationale.html-seo":                         "title, meta description, h1이 없으면 검색 결과에 페이지 내용이 제대로 표시되지 않습니다.",
+	"doc.rationale.html-template-unescaped-output":   "이스케이프 없이 출력한 데이터에 사용자 입력이 섞이면 스크립트가 그대로 실행되는 XSS가 됩니다.",
+	"doc.rationale.html-validation":                  "닫히지 않은 태그나 잘못된 중첩은 브라우저마다 다르게 보정되어 레이아웃과 스크립트 동작이 달라집니다.",
+	"doc.rationale.java-banned-api":                  "팀이 금지한 라이브러리와 API는 유지보수 중단, 보안 취약점, 내부 정책 위반 등의 이유가 있으므로 대체 API를 사용해야 합니다.",
+	"doc.rationale.java-coding-conventions":          "일관된 명명과 형식은 코드를 읽는 비용을 줄이고, 리뷰가 스타일이 아닌 동작에 집중하게 합니다.",
+	"doc.rationale.java-cyclomatic-complexity":       "분기가 많은 메소드는 모든 경로를 테스트하기 어렵고 결함이 생길 확률이 높아집니다.",
+	"doc.rationale.java-duplicate-code":              "복제된 코드는 버그 수정과 정책 변경을 모든 사본에 반영해야 하므로 한 곳만 고쳐 동작이 갈라지기 쉽습니다.",
+	"doc.rationale.java-exception-handling":          "예외를 삼키거나 너무 넓게 잡으면 실패가 기록되지 않아 장애 원인을 찾을 수 없고, 잘못된 상태로 처리가 계속됩니다.",
+	"doc.rationale.java-feature-flag-hygiene":        "플래그 이름을 문자열로 흩어 쓰면 오타가 조용히 false로 평가되고, 플래그를 정리할 때 사용처를 찾기 어렵습니다.",
+	"doc.rationale.java-i18n-hardcoded-string":       "화면 문구를 코드에 직접 쓰면 다국어를 지원할 때 모든 코드를 찾아 고쳐야 하고, 문구 수정에도 배포가 필요합니다.",
+	"doc.rationale.java-import-order":                "import 순서가 일정하면 diff와 병합 충돌이 줄고, 어떤 외부 의존성을 쓰는지 한눈에 보입니다 (--fix로 자동 정렬).",
+	"doc.rationale.java-input-validation":            "검증하지 않은 사용자 입력은 잘못된 데이터 저장, 예외로 인한 500 응답, 인젝션 공격의 출발점이 됩니다.",
+	"doc.rationale.java-layer-architecture":          "컨트롤러가 DAO/저장소를 직접 쓰면 트랜잭션과 업무 규칙이 서비스 계층을 우회하고, 계층 간 의존이 엉켜 변경 영향 범위가 커집니다.",
+	"doc.rationale.java-legacy-date-api":             "java.util.Date/Calendar는 변경 가능하고 스레드에 안전하지 않으며 시간대 처리가 모호합니다. java.time은 불변이고 의도가 분명합니다.",
+	"doc.rationale.java-logging-convention":          "로거 생성 방식과 레벨이 제각각이면 로그 수집과 알림 기준이 흔들리고, 문자열 연결은 로그가 꺼져 있어도 비용이 듭니다.",
+	"doc.rationale.java-magic-number":                "의미 없는 숫자는 의도를 드러내지 않고, 같은 값이 여러 곳에 흩어져 있으면 바꿀 때 일부를 놓치기 쉽습니다.",
+	"doc.rationale.java-method-length":               "긴 메소드는 여러 책임을 한꺼번에 지고 있어 이해와 테스트가 어렵고, 수정할 때 의도하지 않은 부분까지 영향을 받습니다.",
+	"doc.rationale.java-null-collection-return":      "컬렉션 대신 null을 반환하면 호출하는 모든 곳에서 null 확인이 필요하고, 하나라도 빠지면 NullPointerException이 납니다.",
+	"doc.rationale.java-nullable-inconsistency":      "null 어노테이션과 실제 동작이 다르면 호출하는 쪽이 계약을 믿고 확인을 생략해 NullPointerException이 발생합니다.",
+	"doc.rationale.java-optional-get-unchecked":      "값이 없는 Optional의 get()은 NoSuchElementException을 던지므로, Optional을 쓰는 의미가 사라집니다.",
+	"doc.rationale.java-simple-date-format":          "Locale/TimeZone을 지정하지 않으면 서버 설정에 따라 결과가 달라지고, SimpleDateFormat은 스레드에 안전하지 않아 공유하면 값이 섞입니다.",
+	"doc.rationale.java-system-out":                  "System.out은 로그 레벨, 형식, 출력 대상을 설정할 수 없고 운영 로그 수집에서 빠지며, 동기 출력이라 부하가 높을 때 성능을 떨어뜨립니다.",
+	"doc.rationale.java-taint-flow":                  "사용자 입력이 정제 없이 SQL, HTML 출력, 리다이렉트에 전달되면 SQL 인젝션, XSS, 오픈 리다이렉트 공격이 가능합니다.",
+	"doc.rationale.java-transactional-missing":       "여러 저장소 호출을 묶는 데이터 변경 메소드에 트랜잭션이 없으면 중간에 실패했을 때 일부 변경만 반영되어 데이터가 어긋납니다.",
+	"doc.rationale.js-banned-api":                    "팀이 금지한 모듈과 API는 번들 크기, 보안, 유지보수 중단 등의 이유가 있으므로 대체 API를 사용해야 합니다.",
+	"doc.rationale.js-callback-hell":                 "깊게 중첩된 콜백은 흐름과 오류 처리를 따라가기 어렵고, 오류 전파가 빠지기 쉽습니다.",
+	"doc.rationale.js-console-log":                   "운영 코드의 console.log는 브라우저 콘솔에 내부 데이터를 노출하고, 필요한 로그를 찾기 어렵게 만듭니다.",
+	"doc.rationale.js-date-parsing":                  "new Date(문자열)은 형식에 따라 UTC 또는 로컬 시간으로 해석되고 브라우저마다 결과가 달라 날짜가 하루씩 어긋날 수 있습니다.",
+	"doc.rationale.js-equality-operators":            "==는 타입을 암묵적으로 변환해 0 == \"\"처럼 예상하지 못한 결과를 내므로 ===로 의도를 분명히 해야 합니다.",
+	"doc.rationale.js-feature-flag-hygiene":          "플래그 이름을 문자열로 흩어 쓰면 오타가 조용히 false로 평가되고, 플래그를 정리할 때 사용처를 찾기 어렵습니다.",
+	"doc.rationale.js-function-length":               "긴 함수는 여러 책임을 한꺼번에 지고 있어 이해와 테스트가 어렵습니다.",
+	"doc.rationale.js-global-variables":              "전역 변수는 다른 스크립트와 이름이 충돌하고, 어디서든 값이 바뀔 수 있어 추적하기 어렵습니다.",
+	"doc.rationale.js-i18n-hardcoded-string":         "화면 문구를 코드에 직접 쓰면 다국어를 지원할 때 모든 코드를 찾아 고쳐야 합니다.",
+	"doc.rationale.js-import-order":                  "import 순서가 일정하면 diff와 병합 충돌이 줄고, 외부 모듈과 내부 모듈을 쉽게 구분할 수 있습니다 (--fix로 자동 정렬).",
+	"doc.rationale.js-innerHTML-xss":                 "innerHTML에 넣은 문자열은 HTML로 해석되므로, 사용자 입력이 섞이면 스크립트가 실행되는 XSS가 됩니다.",
+	"doc.rationale.js-memory-leak":                   "해제하지 않은 이벤트 리스너와 타이머는 화면을 떠난 뒤에도 객체를 붙잡아 메모리가 계속 늘고, 콜백이 중복 실행됩니다.",
+	"doc.rationale.js-naming-convention":             "일관된 명명은 코드를 읽는 비용을 줄이고, 생성자와 일반 함수를 이름만으로 구분할 수 있게 합니다.",
+	"doc.rationale.js-strict-mode":                   "strict mode가 아니면 선언하지 않은 변수 대입이 전역 변수를 만들고, 조용히 무시되던 오류가 드러나지 않습니다.",
+	"doc.rationale.js-taint-flow":                    "URL이나 요청 파라미터가 정제 없이 innerHTML, 리다이렉트, SQL에 전달되면 XSS, 오픈 리다이렉트, 인젝션 공격이 가능합니다.",
+	"doc.rationale.js-unused-variables":              "사용하지 않는 변수는 읽는 사람에게 의도를 오해하게 하고, 불필요한 계산이나 리팩터링 후 남은 버그의 흔적인 경우가 많습니다.",
+	"doc.rationale.js-var-usage":                     "var는 함수 스코프와 호이스팅 때문에 블록 밖에서 값이 새거나 반복문 클로저가 같은 값을 공유하는 버그를 만듭니다.",
+	"doc.rationale.manifest-dependency-policy":       "알려진 취약점이 있는 버전이나 허용되지 않은 라이선스의 의존성은 보안 사고와 법적 문제로 이어집니다.",
+	"doc.rationale.spring-api-response-convention":   "응답 형식이 API마다 다르면 클라이언트가 오류와 데이터를 일관되게 처리할 수 없고, Map 응답은 API 문서와 타입 검사에서 빠집니다.",
+	"doc.rationale.spring-batch-fault-tolerance":     "내결함성 설정이 없는 chunk Step은 잘못된 레코드 하나에 Step 전체가 실패하고, 일시적인 오류도 재시도하지 않습니다.",
+	"doc.rationale.spring-controller-advice-missing": "전역 예외 처리기가 없으면 컨트롤러마다 예외 처리가 흩어지고, 처리하지 못한 예외가 스택 트레이스와 함께 그대로 응답될 수 있습니다.",
+	"doc.rationale.spring-field-injection":           "필드 주입은 의존성을 숨기고 final로 만들 수 없으며, 스프링 없이 단위 테스트할 때 목 객체를 넣기 어렵습니다.",
+	"doc.rationale.spring-pagination-required":       "전체 목록을 한 번에 조회하면 데이터가 늘어날수록 응답 시간과 메모리 사용량이 함께 늘어 결국 OOM으로 이어집니다.",
+	"doc.rationale.spring-scheduled-error-handling":  "@Scheduled 메소드에서 던진 예외는 호출자가 없어 기본 설정에서는 조용히 사라지므로, 배치 실패를 아무도 알아채지 못합니다.",
+	"doc.rationale.spring-scheduled-lock-missing":    "여러 인스턴스로 배포하면 @Scheduled 작업이 인스턴스마다 실행되어 메일 중복 발송, 데이터 중복 처리가 생깁니다.",
+	"doc.rationale.spring-secured-deprecated":        "@Secured는 역할 이름만 비교할 수 있고, @PreAuthorize는 SpEL로 소유자 확인 등 세밀한 조건을 표현할 수 있습니다.",
+	"doc.rationale.spring-security-missing":          "관리/삭제 등 민감한 엔드포인트에 권한 검사가 없으면 인증된 누구나 호출할 수 있습니다.",
+	"doc.rationale.spring-transactional-private":     "Spring 트랜잭션은 프록시로 동작하므로 private 메소드의 @Transactional은 무시되어 트랜잭션 없이 실행됩니다.",
+	"doc.rationale.spring-transactional-rollback":    "@Transactional은 기본적으로 RuntimeException에서만 롤백하므로 체크드 예외가 발생하면 일부 변경이 커밋됩니다.",
+	"doc.rationale.spring-validation-missing":        "@Valid가 없으면 DTO에 선언한 제약 조건(@NotNull, @Size 등)이 실행되지 않아 검증되지 않은 요청이 서비스까지 들어옵니다.",
+
+	"endpoints.failed": "엔드포인트 목록 출력 실패: %v",
+
+	"explain.bad":           "%s - 위반 코드",
+	"explain.example":       "예시",
+	"explain.example-n":     "예시 %d",
+	"explain.good":          "%s - 수정 코드",
+	"explain.links":         "참고 문서",
+	"explain.meta":          "언어: %s, 심각도: %s, 카테고리: %s",
+	"explain.not-found":     "규칙을 찾을 수 없습니다: %s (cqc rules list로 규칙 ID 확인)",
+	"explain.rationale":     "왜 중요한가",
+	"explain.unimplemented": "(이 규칙은 아직 검사 구현이 없어 이슈를 보고하지 않습니다)",
+
+	"feature-flag.literal.description": "플래그 이름이 코드 곳곳에 흩어지면 사용처를 찾기 어려워 종료된 플래그를 정리하지 못합니다",
+	"feature-flag.literal.message":     "기능 플래그 '%s'를 문자열로 직접 사용합니다",
+	"feature-flag.literal.suggestion":  "%s 클래스에 상수로 선언하고 상수를 사용하세요",
+
+	"html.button-text.description":          "스크린 리더 사용자가 버튼의 목적을 알 수 없습니다",
+	"html.button-text.message":              "button 요소에 접근 가능한 텍스트가 없습니다",
+	"html.button-text.suggestion":           "aria-label 속성이나 버튼 텍스트를 추가하세요",
+	"html.div-onclick.description":          "키보드 접근성이 떨어지며 스크린 리더에서 인식하기 어렵습니다",
+	"html.div-onclick.message":              "div 요소에 onclick이 사용되었습니다",
+	"html.div-onclick.suggestion":           "button 요소를 사용하거나 적절한 ARIA 속성을 추가하세요",
+	"html.img-alt.description":              "시각 장애인을 위한 대체 텍스트가 필요합니다",
+	"html.img-alt.message":                  "img 태그에 alt 속성이 누락되었거나 비어있습니다",
+	"html.img-alt.suggestion":               "img 태그에 의미있는 alt 속성을 추가하세요",
+	"html.input-label.description":          "사용자가 입력 필드의 목적을 알기 어렵습니다",
+	"html.input-label.message":              "input 요소에 레이블이 연결되지 않았습니다",
+	"html.input-label.suggestion":           "label 요소를 사용하거나 aria-label 속성을 추가하세요",
+	"html.seo-h1-missing.description":       "페이지의 주요 제목이 필요합니다",
+	"html.seo-h1-missing.message":           "h1 태그가 없습니다",
+	"html.seo-h1-missing.suggestion":        "페이지의 주요 제목에 h1 태그를 사용하세요",
+	"html.seo-h1-multiple.description":      "페이지당 하나의 h1 태그만 사용하는 것이 좋습니다",
+	"html.seo-h1-multiple.message":          "h1 태그가 여러 개 사용되었습니다",
+	"html.seo-h1-multiple.suggestion":       "추가 제목에는 h2, h3 등을 사용하세요",
+	"html.seo-meta-description.description": "검색 결과에 표시될 페이지 설명이 필요합니다",
+	"html.seo-meta-description.message":     "meta description이 없습니다",
+	"html.seo-meta-description.suggestion":  "<meta name=\"description\" content=\"페이지 설명\"> 태그를 추가하세요",
+	"html.seo-title.description":            "페이지 제목은 SEO에 매우 중요합니다",
+	"html.seo-title.message":                "title 태그가 없습니다",
+	"html.seo-title.suggestion":             "<title> 태그를 head 영역에 추가하세요",
+
+	"html-report.chart-category":        "카테고리별 이슈",
+	"html-report.chart-files":           "이슈가 많은 파일 (상위 10개)",
+	"html-report.chart-severity":        "심각도 분포",
+	"html-report.clear-selection":       "선택 해제",
+	"html-report.code-link":             "코드 보기 ↗",
+	"html-report.column-category":       "카테고리",
+	"html-report.column-file":           "파일",
+	"html-report.column-fixed":          "해결",
+	"html-report.column-flag":           "플래그",
+	"html-report.column-hotspot":        "핫스팟 파일",
+	"html-report.column-issues":         "이슈",
+	"html-report.column-language":       "언어",
+	"html-report.column-literal-usages": "문자열 사용",
+	"html-report.column-location":       "위치",
+	"html-report.column-message":        "메시지",
+	"html-report.column-new":            "신규",
+	"html-report.column-reason":         "원인",
+	"html-report.column-rule":           "규칙",
+	"html-report.column-score":          "점수",
+	"html-report.column-suppression":    "억제",
+	"html-report.column-unchanged":      "유지",
+	"html-report.column-usages":         "사용",
+	"html-report.column-worst-files":    "이슈가 많은 파일",
+	"html-report.commit":                "커밋 %s",
+	"html-report.degraded":              "🧩 텍스트 분석으로 대체된 파일",
+	"html-report.degraded-note":         "구조 파싱에 실패하거나 제한 시간을 넘어 AST가 필요한 규칙은 검사하지 않았습니다.",
+	"html-report.delta":                 "🔄 지난 실행 대비 변화",
+	"html-report.delta-fixed":           "해결된 이슈",
+	"html-report.delta-new":             "신규 이슈",
+	"html-report.delta-unchanged":       "유지된 이슈",
+	"html-report.example-bad":           "❌ 위반 코드",
+	"html-report.example-good":          "✅ 수정 코드",
+	"html-report.examples":              "📝 수정 예시 보기",
+	"html-report.export-columns":        "심각도 | 규칙 | 위치 | 메시지 | 권장사항",
+	"html-report.files":                 "📁 파일별 분석",
+	"html-report.files-analyzed":        "검사된 파일",
+	"html-report.finished":              "분석 완료 시간: %s",
+	"html-report.flags-note":            "코드에서 사용 중인 기능 플래그입니다. 이미 전체 적용된 플래그는 코드와 함께 정리하세요.",
+	"html-report.issue-count":           "%d개 이슈",
+	"html-report.issues-found":          "발견된 이슈",
+	"html-report.label-category":        "카테고리:",
+	"html-report.label-classification":  "보안 분류:",
+	"html-report.label-description":     "설명:",
+	"html-report.label-escalation":      "⬆️ 심각도 상향:",
+	"html-report.label-fingerprint":     "식별자:",
+	"html-report.label-priority":        "🎯 우선순위:",
+	"html-report.label-rule":            "규칙:",
+	"html-report.label-suggestion":      "💡 권장사항:",
+	"html-report.label-triage":          "🏷️ 트리아지:",
+	"html-report.no-issues":             "✅ 발견된 이슈가 없습니다!",
+	"html-report.owasp":                 "🛡️ OWASP Top 10 요약",
+	"html-report.priority":              "%d (예상 수정 시간 %d분)",
+	"html-report.ranking":               "🏆 이슈가 많은 규칙과 파일",
+	"html-report.rule-nav":              "규칙 선택 (섹션 이동)",
+	"html-report.rules":                 "📋 규칙별 분석",
+	"html-report.ruleset":               "규칙 세트 %s",
+	"html-report.ruleset-hash":          "규칙 세트 해시: %s",
+	"html-report.sampling":              "🎲 표본 분석",
+	"html-report.sampling-detail":       "전체 %d개 중 %d개, 시드 %d",
+	"html-report.sampling-estimate":     "전체 추정 이슈",
+	"html-report.sampling-ratio":        "표본 비율",
+	"html-report.select":                "선택",
+	"html-report.selected":              "선택한 이슈",
+	"html-report.selected-unit":         "개",
+	"html-report.severities":            "⚠️ 심각도별 분석",
+	"html-report.signed":                "서명됨",
+	"html-report.source":                "🗂️ 소스 보기",
+	"html-report.source-missing":        "파일을 읽을 수 없습니다 (분석한 위치와 다른 곳에서 리포트를 만든 경우)",
+	"html-report.source-note":           "이슈가 있는 라인이 강조됩니다. 라인 번호에 마우스를 올리면 이슈 내용이 표시됩니다.",
+	"html-report.source-redacted":       "스니펫 가리기가 적용된 이슈가 있어 원문을 싣지 않았습니다",
+	"html-report.source-too-large":      "파일이 커서 원문을 싣지 않았습니다",
+	"html-report.source-unreadable":     "파일을 읽을 수 없습니다",
+	"html-report.suppressed":            "🔕 억제된 이슈",
+	"html-report.tab-files":             "파일별",
+	"html-report.tab-overview":          "전체 요약",
+	"html-report.tab-rules":             "규칙별",
+	"html-report.tab-severity":          "심각도별",
+	"html-report.tab-source":            "소스",
+	"html-report.theme-toggle":          "🌓 테마 전환",
+
+	"i18n.hardcoded.description": "하드코딩된 문구는 언어별로 번역할 수 없어 다국어 지원 시 코드를 직접 고쳐야 합니다",
+	"i18n.hardcoded.message":     "화면에 노출되는 문구가 하드코딩되어 있습니다: \"%s\"",
+	"i18n.java.suggestion":       "messageSource.getMessage(\"키\", args, locale)로 메시지 번들(messages.properties)에서 읽으세요",
+	"i18n.js.suggestion":         "i18n 라이브러리의 t('키')처럼 메시지 키로 바꾸고 문구는 언어별 리소스 파일로 옮기세요",
+	"i18n.jsp.suggestion":        "<spring:message code=\"키\"/> 또는 <fmt:message key=\"키\"/>로 메시지 번들의 문구를 사용하세요",
+	"i18n.thymeleaf.suggestion":  "th:text=\"#{키}\"로 메시지 번들(messages.properties)의 문구를 사용하세요",
+
+	"import-order.description":       "import 그룹 순서: %s",
+	"import-order.groups.message":    "import 그룹은 빈 줄 하나로 구분해야 합니다",
+	"import-order.groups.suggestion": "--fix 옵션으로 import 그룹을 자동 정리할 수 있습니다",
+	"import-order.order.message":     "import 순서가 규칙과 다릅니다: '%s'가 '%s'보다 먼저 와야 합니다",
+	"import-order.order.suggestion":  "--fix 옵션으로 import 순서를 자동 정리할 수 있습니다",
+
+	"index.files":   "파일 %d개 (갱신 %d개, 재사용 %d개, 제거 %d개)",
+	"index.header":  "🗂️  심볼 색인: %s",
+	"index.symbols": "심볼 %d개",
+
+	"init.create-failed":    "설정 파일 생성 실패: %v",
+	"init.created":          "✅ 시작 설정 파일 생성: %s (%s, 규칙 %d개)",
+	"init.defaults-failed":  "기본 설정 읽기 실패: %v",
+	"init.detect-failed":    "언어 감지 실패: %v",
+	"init.exists":           "%s 파일이 이미 있습니다 (--force로 덮어쓰기)",
+	"init.header":           "cqc init으로 생성한 설정 파일 (기본 제공 규칙과 기본값, 필요 없는 규칙은 항목을 지우고 심각도와 옵션은 팀 기준에 맞게 조정하세요)",
+	"init.header-languages": "감지된 언어: %s (다른 언어 규칙이 필요하면 cqc init --all-languages --force)",
+	"init.invalid-defaults": "기본 설정 형식이 올바르지 않습니다",
+	"init.no-sources":       "지원하는 소스 파일을 찾지 못해 모든 언어의 규칙을 넣었습니다",
+	"init.save-failed":      "설정 파일 저장 실패: %v",
+
+	"java.annotation-mix.description":                     "일관되지 않은 어노테이션 사용은 코드 품질을 저하시킵니다",
+	"java.annotation-mix.info-description":                "의존성 주입 어노테이션을 통일하는 것이 좋습니다",
+	"java.annotation-mix.info-message":                    "동일 클래스에서 @Resource와 @Autowired가 혼용되고 있습니다",
+	"java.annotation-mix.info-suggestion":                 "프로젝트 전체에서 @Autowired로 통일하세요",
+	"java.annotation-mix.message":                         "@Resource와 @Autowired가 혼용되고 있습니다",
+	"java.annotation-mix.suggestion":                      "@Autowired로 통일하여 사용하세요 (Spring 권장사항)",
+	"java.bean-validation.description":                    "표준 검증 미적용 시 SQL인젝션, XSS 등 보안 취약점 위험이 증가합니다",
+	"java.bean-validation.message":                        "커스텀 검증 로직 대신 Bean Validation 표준을 사용하세요",
+	"java.bean-validation.suggestion":                     "@Valid, @NotNull, @Size 등 Bean Validation 어노테이션을 사용하세요",
+	"java.complexity.description":                         "높은 순환 복잡도는 코드 이해도와 테스트 어려움을 증가시킵니다",
+	"java.complexity.message":                             "메소드 '%s'의 순환 복잡도가 너무 높습니다 (복잡도: %d)",
+	"java.complexity.suggestion":                          "메소드를 더 작은 단위로 분할하여 복잡도를 낮추세요",
+	"java.controller-advice.description":                  "일관된 예외 처리를 위해 전역 예외 처리기가 필요합니다",
+	"java.controller-advice.message":                      "전역 예외 처리기(@ControllerAdvice)가 없습니다",
+	"java.controller-advice.suggestion":                   "@ControllerAdvice 클래스를 생성하여 전역 예외 처리를 구현하세요",
+	"java.controller-dao.description":                     "레이어 아키텍처 위반으로 유지보수성이 저하됩니다",
+	"java.controller-dao.message":                         "Controller에서 DAO를 직접 의존하고 있습니다",
+	"java.controller-dao.suggestion":                      "Service 레이어를 통해 데이터에 접근하세요",
+	"java.duplicate-block.description":                    "동일한 코드 블록이 여러 곳에서 반복되고 있습니다",
+	"java.duplicate-block.message":                        "중복된 코드 블록이 발견되었습니다 (%d개 위치에서 반복)",
+	"java.duplicate-block.suggestion":                     "공통 메소드로 추출하여 중복을 제거하세요",
+	"java.duplicate-method.description":                   "거의 같은 본문을 가진 메소드가 반복되고 있습니다",
+	"java.duplicate-method.message":                       "메소드 '%s'가 '%s'(라인 %d)와 %d%% 유사합니다",
+	"java.duplicate-method.suggestion":                    "공통 메소드로 추출하거나 차이점을 매개변수로 분리하세요",
+	"java.duplicate-pattern.code-list.description":        "코드 목록 조회가 반복되고 있습니다",
+	"java.duplicate-pattern.code-list.suggestion":         "캐싱을 적용하거나 공통 메소드로 추출하세요",
+	"java.duplicate-pattern.custom.description":           "반복되는 코드 패턴입니다",
+	"java.duplicate-pattern.custom.suggestion":            "공통 메소드로 추출하여 중복을 제거하세요",
+	"java.duplicate-pattern.log-return.description":       "로깅 후 return 패턴이 반복됩니다",
+	"java.duplicate-pattern.log-return.suggestion":        "공통 로깅 유틸리티를 만들어 사용하세요",
+	"java.duplicate-pattern.message":                      "중복 코드 패턴이 발견되었습니다 (%d회 반복)",
+	"java.duplicate-pattern.null-check-throw.description": "null 체크 후 예외 발생 패턴이 중복됩니다",
+	"java.duplicate-pattern.null-check-throw.suggestion":  "공통 검증 메소드를 만들어 사용하세요",
+	"java.duplicate-pattern.response-put.description":     "API 응답 생성 패턴이 중복되고 있습니다",
+	"java.duplicate-pattern.response-put.suggestion":      "공통 응답 클래스(ApiResponse)를 만들어 사용하세요",
+	"java.final-newline.description":                      ".editorconfig의 insert_final_newline 설정과 다릅니다",
+	"java.final-newline.extra-message":                    "파일 끝에 개행 문자가 있습니다",
+	"java.final-newline.extra-suggestion":                 "파일 끝의 개행 문자를 제거하세요",
+	"java.final-newline.missing-message":                  "파일이 개행 문자로 끝나지 않습니다",
+	"java.final-newline.missing-suggestion":               "파일 끝에 개행 문자를 추가하세요",
+	"java.generic-exception.description":                  "구체적인 예외 타입을 사용하는 것이 좋습니다",
+	"java.generic-exception.message":                      "일반적인 Exception 타입을 사용하고 있습니다",
+	"java.generic-exception.suggestion":                   "구체적인 예외 클래스(BusinessException 등)를 정의하여 사용하세요",
+	"java.indent.description":                             ".editorconfig의 들여쓰기 설정(indent_style: %s)과 다릅니다",
+	"java.indent.size-message":                            "들여쓰기가 %d칸 단위가 아닙니다 (%d칸)",
+	"java.indent.size-suggestion":                         "들여쓰기를 %d칸 단위로 맞추세요",
+	"java.indent.space-message":                           "탭 대신 스페이스로 들여쓰기 되어 있습니다",
+	"java.indent.space-suggestion":                        "스페이스를 탭으로 변경하세요",
+	"java.indent.tab-message":                             "스페이스 대신 탭으로 들여쓰기 되어 있습니다",
+	"java.indent.tab-suggestion":                          "탭을 스페이스로 변경하세요",
+	"java.line-length.description":                        "긴 라인은 가독성을 저하시킵니다",
+	"java.line-length.message":                            "라인이 너무 깁니다 (%d자)",
+	"java.line-length.suggestion":                         "라인을 %s자 이하로 분할하세요",
+	"java.long-method.description":                        "긴 메소드는 가독성과 유지보수성을 저하시킵니다",
+	"java.long-method.message":                            "메소드가 너무 깁니다 (%s: %d 라인, 임계값: %d)",
+	"java.long-method.suggestion":                         "메소드를 더 작은 단위로 분할하세요",
+	"java.magic-number.description":                       "하드코딩된 숫자는 코드 가독성을 저하시킵니다",
+	"java.magic-number.message":                           "매직 넘버가 발견되었습니다: %s",
+	"java.magic-number.suggestion":                        "의미있는 상수로 정의하세요",
+	"java.mixed-indent.description":                       "일관된 들여쓰기를 사용해야 코드 가독성이 향상됩니다",
+	"java.mixed-indent.message":                           "탭과 스페이스가 혼용되고 있습니다",
+	"java.mixed-indent.suggestion":                        "탭 또는 스페이스 중 하나로 통일하세요",
+	"java.print-stack-trace.description":                  "예외 스택트레이스가 콘솔에 노출되어 보안 위험이 있습니다",
+	"java.print-stack-trace.message":                      "printStackTrace() 사용이 발견되었습니다",
+	"java.print-stack-trace.suggestion":                   "Logger를 사용하여 적절한 로깅을 하세요",
+	"java.request-body-valid.description":                 "입력 검증이 누락되어 잘못된 데이터가 처리될 수 있습니다",
+	"java.request-body-valid.message":                     "@RequestBody 파라미터에 @Valid 어노테이션이 누락되었습니다",
+	"java.request-body-valid.suggestion":                  "@RequestBody @Valid 를 사용하여 자동 검증을 적용하세요",
+	"java.system-out.description":                         "프로덕션 환경에서 불필요한 정보 노출 위험이 있습니다",
+	"java.system-out.message":                             "System.out.println 사용이 발견되었습니다",
+	"java.system-out.suggestion":                          "Logger를 사용하여 로깅하세요",
+	"java.transactional.description":                      "복잡한 데이터 변경 작업에는 트랜잭션이 필요합니다",
+	"java.transactional.message":                          "메소드 '%s'에 @Transactional이 필요합니다: %s",
+	"java.transactional.reason-conditional":               "조건부 데이터 변경 로직",
+	"java.transactional.reason-external":                  "외부 시스템 연동과 DB 작업",
+	"java.transactional.reason-mixed":                     "복합 데이터 작업(생성/수정/삭제)",
+	"java.transactional.reason-repositories":              "여러 테이블 작업(%d개 Repository 호출)",
+	"java.transactional.suggestion":                       "@Transactional 어노테이션을 메소드에 추가하세요",
+
+	"js.console-log.description":         "프로덕션 환경에서 console 출력은 성능에 영향을 줄 수 있습니다",
+	"js.console-log.message":             "console.log 사용이 발견되었습니다",
+	"js.console-log.suggestion":          "적절한 로깅 라이브러리를 사용하거나 프로덕션에서 제거하세요",
+	"js.event-listener-leak.description": "addEventListener 후 removeEventListener가 호출되지 않습니다",
+	"js.event-listener-leak.message":     "이벤트 리스너가 제거되지 않아 메모리 누수 위험이 있습니다",
+	"js.event-listener-leak.suggestion":  "컴포넌트 해제 시 removeEventListener를 호출하세요",
+	"js.function-length.description":     "긴 함수는 가독성과 유지보수성을 저하시킵니다",
+	"js.function-length.message":         "함수가 너무 깁니다 (%s: %d 라인)",
+	"js.function-length.suggestion":      "함수를 더 작은 단위로 분할하세요",
+	"js.innerhtml.description":           "사용자 입력을 innerHTML에 직접 할당하면 XSS 공격에 취약합니다",
+	"js.innerhtml.message":               "innerHTML 사용으로 인한 XSS 취약점 위험",
+	"js.innerhtml.suggestion":            "textContent를 사용하거나 입력값을 이스케이프 처리하세요",
+	"js.timer-leak.description":          "setInterval/setTimeout 후 clear 함수가 호출되지 않습니다",
+	"js.timer-leak.message":              "타이머가 정리되지 않아 메모리 누수 위험이 있습니다",
+	"js.timer-leak.suggestion":           "컴포넌트 해제 시 clearInterval/clearTimeout을 호출하세요",
+	"js.var-usage.description":           "var는 호이스팅과 스코프 문제를 일으킬 수 있습니다",
+	"js.var-usage.message":               "var 키워드 사용이 발견되었습니다",
+	"js.var-usage.suggestion":            "let 또는 const를 사용하세요",
+
+	"limit.file.message":    "이슈가 너무 많아 %d개가 생략되었습니다",
+	"limit.file.suggestion": "analysis.max_issues_per_file 설정으로 상한을 조정할 수 있습니다",
+	"limit.rule.message":    "%s 규칙의 이슈 %d개가 더 있어 생략되었습니다",
+	"limit.rule.suggestion": "반복되는 패턴을 한 번에 수정하거나 생성된 파일이라면 검사 대상에서 제외하세요",
+
+	"list-files.large":    "(대용량 %.1fMB: 라인 단위 규칙만 검사)",
+	"list-files.sampling": "표본 분석: 전체 %d개 중 %d개 선택 (시드 %d)",
+	"list-files.total":    "분석 대상 파일: %d개",
+
+	"logging.business-error.description": "사용자 입력 오류 같은 예상된 실패를 error로 남기면 장애 알림이 울리고 실제 오류가 묻힙니다",
+	"logging.business-error.message":     "업무 검증 실패를 error 레벨로 기록합니다",
+	"logging.business-error.suggestion":  "업무 검증 실패는 warn 이하 레벨로 기록하세요",
+	"logging.concat.description":         "로그 레벨이 꺼져 있어도 문자열 연결 비용이 들고, 메시지 형식이 일정하지 않습니다",
+	"logging.concat.message":             "로그 메시지를 문자열 연결로 만듭니다",
+	"logging.concat.suggestion":          "log.%s(\"... {}\", value)처럼 placeholder를 사용하세요",
+	"logging.factory.description":        "로깅 구현체에 직접 의존하면 로그 설정과 MDC 전파가 팀 표준과 달라집니다",
+	"logging.factory.message":            "로거 '%s'를 승인되지 않은 방식으로 생성합니다: %s",
+	"logging.factory.separator":          " 또는 ",
+	"logging.factory.suggestion":         "%s(...)로 로거를 생성하세요",
+	"logging.modifiers.description":      "로거는 클래스마다 하나만 두고 외부에서 바꿀 수 없어야 합니다",
+	"logging.modifiers.message":          "로거 필드 '%s'에 %s 제한자가 없습니다",
+	"logging.modifiers.suggestion":       "private static final Logger %s = ...로 선언하세요",
+
+	"markdown.by-file":          "📁 파일별 이슈",
+	"markdown.by-severity":      "📊 심각도별 이슈",
+	"markdown.category-columns": "카테고리 | 이슈 수",
+	"markdown.commit":           "커밋",
+	"markdown.duration":         "분석 시간",
+	"markdown.failed-gates":     "🚦 실패한 품질 게이트",
+	"markdown.gate":             "%d개 (허용 %d개, %s 이상)",
+	"markdown.issue-columns":    "심각도 | 우선순위 | 규칙 | 위치 | 메시지",
+	"markdown.item-columns":     "항목 | 값",
+	"markdown.line":             "%d행",
+	"markdown.ruleset-hash":     "규칙 세트 해시",
+	"markdown.seconds":          "%.2f초",
+	"markdown.severity-columns": "심각도 | 이슈 수",
+	"markdown.suggestion-label": "**권장:** %s",
+	"markdown.suppressed":       "억제된 이슈",
+	"markdown.title":            "🔍 코드 품질 리포트",
+	"markdown.tool-version":     "도구 버전",
+	"markdown.top-issues":       "🔥 주요 이슈 (상위 %d개)",
+	"markdown.total-files":      "검사 파일 수",
+	"markdown.total-issues":     "발견된 이슈",
+
+	"naming.description":     "팀 명명 규칙(%s_pattern: %s)을 따라야 합니다",
+	"naming.label.class":     "클래스명",
+	"naming.label.constant":  "상수명",
+	"naming.label.css-class": "CSS 클래스명",
+	"naming.label.field":     "필드명",
+	"naming.label.function":  "함수명",
+	"naming.label.method":    "메소드명",
+	"naming.label.package":   "패키지명",
+	"naming.message":         "%s이 명명 규칙을 따르지 않습니다: %s",
+	"naming.suggestion":      "%s을 %s 패턴에 맞게 변경하세요",
+
+	"null-safety.collection-null.description":      "호출하는 쪽은 컬렉션이 null이 아니라고 가정하고 바로 순회하므로 NPE가 발생하기 쉽습니다",
+	"null-safety.collection-null.empty-suggestion": "null 대신 Collections.empty%[1]s() 또는 %[1]s.of()를 반환하세요",
+	"null-safety.collection-null.message":          "%s을(를) 반환하는 메소드 '%s'가 null을 반환합니다",
+	"null-safety.collection-null.suggestion":       "null 대신 빈 컬렉션을 반환하세요",
+	"null-safety.nonnull-return.description":       "어노테이션을 믿고 null 확인을 생략한 호출부에서 NPE가 발생합니다",
+	"null-safety.nonnull-return.message":           "null을 반환하지 않도록 선언된 메소드 '%s'가 null을 반환합니다",
+	"null-safety.nonnull-return.suggestion":        "null 대신 빈 값이나 예외를 반환하거나, null을 반환해야 한다면 @Nullable로 선언하세요",
+	"null-safety.nullable-param.description":       "null이 들어올 수 있다고 선언한 파라미터를 바로 역참조하면 NPE가 발생합니다",
+	"null-safety.nullable-param.message":           "@Nullable 파라미터 '%s'를 null 확인 없이 사용합니다",
+	"null-safety.nullable-param.suggestion":        "사용 전에 null을 확인하거나, null을 받지 않는다면 @Nullable을 제거하세요",
+	"null-safety.nullable-result.description":      "null을 반환할 수 있다고 선언한 메소드의 결과를 바로 역참조하면 NPE가 발생합니다",
+	"null-safety.nullable-result.message":          "@Nullable 메소드 '%s'의 결과를 null 확인 없이 사용합니다",
+	"null-safety.nullable-result.suggestion":       "결과를 변수에 담아 null을 확인하거나 Optional을 반환하도록 바꾸세요",
+	"null-safety.optional-chain-get.description":   "조회 결과가 없으면 NoSuchElementException이 발생합니다",
+	"null-safety.optional-chain-get.message":       "Optional 결과에 확인 없이 get()을 호출합니다",
+	"null-safety.optional-chain-get.suggestion":    "orElseThrow(() -> new NotFoundException(...))처럼 값이 없는 경우의 예외를 명시하세요",
+	"null-safety.optional-get.description":         "값이 없으면 NoSuchElementException이 발생해 Optional을 쓰는 의미가 없어집니다",
+	"null-safety.optional-get.message":             "isPresent() 확인 없이 Optional '%s'의 get()을 호출합니다",
+	"null-safety.optional-get.suggestion":          "orElseThrow(), orElse(), ifPresent() 등으로 값이 없는 경우를 명시적으로 처리하세요",
+
+	"outputs.duplicate-target": "같은 출력 위치에 리포트를 여러 개 쓸 수 없습니다: %s",
+	"outputs.invalid-report":   "--report 값은 형식:경로 형태여야 합니다: %s",
+	"outputs.need-file":        "여러 형식을 출력하려면 --output-file로 파일 이름(확장자 제외)을 지정하세요",
+
+	"publish.confluence":     "📄 Confluence 페이지 게시 완료: %s",
+	"publish.marshal-failed": "JSON 마샬링 실패",
+	"publish.uploaded":       "☁️  리포트 업로드 완료 (%s://%s)",
+	"publish.uploading":      "업로드: %s/%s",
+
+	"report.by-category":           "📂 카테고리별 통계",
+	"report.by-language":           "💻 언어별 파일 수",
+	"report.by-severity":           "⚠️  심각도별 통계",
+	"report.code-label":            "코드: %s",
+	"report.confidence":            "(신뢰도: %s)",
+	"report.count":                 "%d개",
+	"report.delta":                 "🔄 지난 실행 대비 변화 (%s)",
+	"report.delta-counts":          "신규 %d개 / 해결 %d개 / 유지 %d개",
+	"report.duration":              "분석 시간: %.2f초",
+	"report.escalation":            "%s → %s (%d회 연속 발견)",
+	"report.escalation-since":      "%s → %s (%d회 연속 발견, 최초 %s)",
+	"report.flag-literals":         "(문자열 사용 %d곳)",
+	"report.flag-usages":           "%d곳, 파일 %d개",
+	"report.flags":                 "🚩 기능 플래그 (%d개)",
+	"report.group-issues":          "%s (%d개)",
+	"report.hotspot-score":         "%d점, 이슈 %d개",
+	"report.hotspots":              "🔥 핫스팟 파일 (상위 %d개, 심각도 가중 점수 순)",
+	"report.issues":                "🐛 발견된 이슈 목록",
+	"report.maturity-experimental": "(실험 규칙, 종료 코드 미반영)",
+	"report.maturity-deprecated":   "(폐기 예정 규칙)",
+	"report.more":                  "... 및 %d개 추가",
+	"report.more-issues":           "... 및 %d개 추가 이슈",
+	"report.no-issues":             "✅ 이슈가 발견되지 않았습니다!",
+	"report.owasp":                 "🛡️  OWASP Top 10 요약",
+	"report.peak-memory":           "최대 메모리: %.1fMB",
+	"report.preview":               "👀 미리보기 (규칙별 대표 이슈 최대 %d개)",
+	"report.preview-rule":          "전체 %d개, 파일 %d개, 표시 %d개",
+	"report.priority":              "우선순위 %d (예상 수정 시간 %d분)",
+	"report.recommend-critical":    "🚨 Critical 이슈는 즉시 수정이 필요합니다!",
+	"report.recommend-high":        "⚠️  High 이슈는 릴리즈 전에 수정하세요.",
+	"report.recommend-medium":      "📝 Medium 이슈는 점진적으로 개선하세요.",
+	"report.recommendations":       "💡 권장사항",
+	"report.sampling":              "🎲 표본 분석 (전체 %d개 중 %d개, %.1f%%, 시드 %d)",
+	"report.sampling-count":        "약 %d개",
+	"report.sampling-estimate":     "전체 추정 이슈: 약 %d개",
+	"report.severity-issues":       "%s 이슈 (%d개)",
+	"report.suggestion-label":      "권장: %s",
+	"report.summary":               "📊 분석 요약",
+	"report.suppressed":            "🔕 억제된 이슈 (%d개, 만료 %d개)",
+	"report.suppression-baseline":  "베이스라인",
+	"report.suppression-expired":   "%s 만료되어 다시 보고됨",
+	"report.suppression-inline":    "인라인 주석 (라인 %d)",
+	"report.suppression-no-expiry": "만료일 없음",
+	"report.suppression-triage":    "트리아지: %s",
+	"report.suppression-until":     "%s까지",
+	"report.tap-no-issues":         "이슈 없음 (검사 파일 %d개)",
+	"report.throughput":            "처리 속도: %.1f파일/초 (%.1fKB)",
+	"report.title":                 "🔍 Code Quality Checker 분석 결과",
+	"report.top-rules":             "🏆 이슈가 많은 규칙 (상위 %d개)",
+	"report.total-files":           "검사 파일 수: %d개",
+	"report.total-issues":          "발견된 이슈: %d개",
+	"report.triage-accepted":       "위험 수용",
+	"report.triage-false-positive": "오탐",
+	"report.triage-open":           "확인 중",
+	"report.triage-owner":          "(담당: %s)",
+	"report.triage-wont-fix":       "수정 안 함",
+	"report.warnings":              "⚠️  분석 경고",
+
+	"rules.count":                "규칙 %d개",
+	"rules.describe.bad-example": "위반 예시:",
+	"rules.describe.banned":      "금지 항목: %d개",
+	"rules.describe.category":    "  카테고리: %s",
+	"rules.describe.confidence":  "  신뢰도:   %s",
+	"rules.describe.exclude":     "제외 경로: %s",
+	"rules.describe.explain":     "수정 예시와 참고 문서: cqc explain %s",
+	"rules.describe.language":    "  언어:     %s",
+	"rules.describe.maturity":    "  성숙도:   %s",
+	"rules.describe.name":        "  이름:     %s",
+	"rules.describe.no-examples": "예시: 없음 (설정 파일의 examples로 추가)",
+	"rules.describe.pack":        "  규칙 팩:  %s",
+	"rules.describe.severity":    "  심각도:   %s",
+	"rules.none":                 "조건에 맞는 규칙이 없습니다",
+	"rules.option.default":       "%q (기본값)",
+	"rules.option.unset":         "(설정 안 함)",
+	"rules.options":              "옵션 (custom):",
+	"rules.sync.done":            "%d개 규칙 팩 동기화 완료: %s",
+	"rules.sync.failed":          "규칙 팩 동기화 실패: %v",
+	"rules.sync.no-packs":        "동기화할 규칙 팩이 없습니다 (registry.packs 설정 필요)",
+	"rules.sync.no-registry":     "레지스트리 URL이 지정되지 않았습니다 (--registry 또는 registry.url)",
+
+	"spring.batch-fault-tolerance.description":    "레코드 하나의 오류나 일시적인 장애로 Step 전체가 실패하고, 재시작 전까지 나머지 데이터가 처리되지 않습니다",
+	"spring.batch-fault-tolerance.message":        "chunk Step에 faultTolerant() 설정이 없습니다",
+	"spring.batch-fault-tolerance.policy-message": "chunk Step에 skip/retry 정책이 없습니다",
+	"spring.batch-fault-tolerance.suggestion":     ".faultTolerant().skip(...).skipLimit(n) 또는 .retry(...).retryLimit(n)으로 실패 정책을 정하세요",
+	"spring.controller-advice.description":        "일관된 예외 처리를 위해 전역 예외 처리기를 구현하세요",
+	"spring.controller-advice.message":            "전역 예외 처리기(@ControllerAdvice)가 없습니다",
+	"spring.controller-advice.suggestion":         "@ControllerAdvice를 사용한 전역 예외 처리 클래스를 생성하세요",
+	"spring.field-injection.description":          "생성자 주입은 불변성을 보장하고 테스트하기 더 쉽습니다",
+	"spring.field-injection.message":              "필드 주입 대신 생성자 주입을 사용하세요: %s",
+	"spring.field-injection.suggestion":           "final 필드와 생성자를 사용하거나 @RequiredArgsConstructor를 활용하세요",
+	"spring.pagination.description":               "데이터가 늘어나면 전체 조회 결과를 한 번에 메모리에 올려 OutOfMemoryError가 발생할 수 있습니다",
+	"spring.pagination.message":                   "메소드 '%s'가 페이지네이션 없이 %s() 결과를 목록으로 반환합니다",
+	"spring.pagination.suggestion":                "Pageable 파라미터를 받아 Page/Slice로 조회하거나 조회 건수를 제한하세요",
+	"spring.response-created.description":         "리소스를 생성하는 POST가 200을 반환하면 클라이언트가 생성 여부와 위치를 알 수 없습니다",
+	"spring.response-created.message":             "POST 핸들러 '%s'가 201 Created 상태를 반환하지 않습니다",
+	"spring.response-created.suggestion":          "ResponseEntity.created(location) 또는 ResponseEntity.status(HttpStatus.CREATED)를 사용하세요",
+	"spring.response-forbidden.description":       "Map 등으로 응답을 직접 조립하면 API마다 응답 형식이 달라지고 같은 코드가 반복됩니다",
+	"spring.response-forbidden.message":           "핸들러 메소드 '%s'가 표준 응답 타입 대신 %s을(를) 반환합니다",
+	"spring.response-forbidden.suggestion":        "공통 응답 타입(%s)으로 감싸 반환하세요",
+	"spring.response-no-content.description":      "본문 없는 성공 응답은 204 No Content가 HTTP 규약에 맞습니다",
+	"spring.response-no-content.message":          "DELETE 핸들러 '%s'가 본문 없는 200 응답을 반환합니다",
+	"spring.response-no-content.suggestion":       "ResponseEntity.noContent().build()를 사용하세요",
+	"spring.response-raw.description":             "응답 본문 타입이 드러나지 않아 표준 응답 형식을 따르는지 알 수 없습니다",
+	"spring.response-raw.message":                 "핸들러 메소드 '%s'가 타입 인자 없는 ResponseEntity를 반환합니다",
+	"spring.response-raw.suggestion":              "ResponseEntity<%s<T>> 형태로 본문 타입을 명시하세요",
+	"spring.response-type.description":            "API마다 응답 형식이 다르면 클라이언트가 성공/실패를 일관되게 처리할 수 없습니다",
+	"spring.response-type.message":                "핸들러 메소드 '%s'의 응답 타입 %s이(가) 표준 응답 타입(%s)이 아닙니다",
+	"spring.response-type.suggestion":             "%[1]s을(를) %[2]s<%[1]s>로 감싸 반환하세요",
+	"spring.response-void.description":            "void 응답은 상태 코드와 본문이 암묵적으로 정해져 클라이언트가 결과를 일관되게 처리하기 어렵습니다",
+	"spring.response-void.message":                "핸들러 메소드 '%s'가 void를 반환합니다",
+	"spring.response-void.suggestion":             "%s을(를) 반환하거나 @ResponseStatus로 상태 코드를 명시하세요",
+	"spring.scheduled-error.description":          "스케줄러 스레드에서 발생한 예외는 로그 한 줄만 남기고 사라져 작업 실패를 알아차리기 어렵습니다",
+	"spring.scheduled-error.message":              "@Scheduled 메소드 '%s'에 예외 처리가 없습니다",
+	"spring.scheduled-error.suggestion":           "본문을 try/catch로 감싸 실패를 기록하고 알림을 보내세요",
+	"spring.scheduled-lock.description":           "여러 인스턴스로 배포하면 같은 작업이 인스턴스마다 동시에 실행됩니다",
+	"spring.scheduled-lock.message":               "@Scheduled 메소드 '%s'에 분산 락이 없습니다 (@%s)",
+	"spring.scheduled-lock.suggestion":            "@%s 같은 분산 락으로 한 인스턴스에서만 실행되도록 하세요",
+	"spring.secured.description":                  "@PreAuthorize는 SpEL을 지원하여 더 유연한 보안 설정이 가능합니다",
+	"spring.secured.message":                      "@Secured 대신 @PreAuthorize 사용을 권장합니다",
+	"spring.secured.suggestion":                   "@PreAuthorize(\"hasRole('ROLE_NAME')\")로 변경하세요",
+	"spring.security-annotation.description":      "삭제, 수정, 관리자 기능에는 적절한 권한 검사가 필요합니다",
+	"spring.security-annotation.message":          "민감한 메소드에 보안 어노테이션이 누락되었습니다: %s",
+	"spring.security-annotation.suggestion":       "@PreAuthorize(\"hasRole('ADMIN')\") 등의 보안 어노테이션을 추가하세요",
+	"spring.transactional-private.description":    "private 메소드는 프록시가 작동하지 않아 트랜잭션이 적용되지 않습니다",
+	"spring.transactional-private.message":        "private 메소드에 @Transactional 어노테이션이 사용되었습니다",
+	"spring.transactional-private.suggestion":     "메소드를 public으로 변경하거나 클래스 레벨에서 @Transactional을 사용하세요",
+	"spring.transactional-rollback.description":   "체크드 예외 발생 시 롤백되지 않을 수 있습니다",
+	"spring.transactional-rollback.message":       "@Transactional에 rollbackFor 설정이 누락되었습니다",
+	"spring.transactional-rollback.suggestion":    "@Transactional(rollbackFor = Exception.class)를 사용하세요",
+	"spring.validation.description":               "입력값 검증이 없으면 보안 취약점이 발생할 수 있습니다",
+	"spring.validation.message":                   "@RequestBody 매개변수에 @Valid 어노테이션이 누락되었습니다",
+	"spring.validation.suggestion":                "@Valid 어노테이션을 추가하여 입력값을 검증하세요",
+
+	"sql.failed":        "SQL 목록 출력 실패: %v",
+	"sql.mapper-failed": "MyBatis 매퍼 분석 실패: %v",
+
+	"taint.other.message":       "사용자 입력(%s)이 정제 없이 %s로 전달됩니다",
+	"taint.other.suggestion":    "입력값을 검증하거나 정제 함수를 거친 뒤 전달하세요",
+	"taint.redirect.message":    "사용자 입력(%s)이 검증 없이 리다이렉트(%s) 대상으로 사용됩니다",
+	"taint.redirect.suggestion": "허용된 경로/도메인 목록으로 검증한 뒤 리다이렉트하세요",
+	"taint.sql.message":         "사용자 입력(%s)이 SQL 실행(%s)에 직접 전달됩니다",
+	"taint.sql.suggestion":      "PreparedStatement나 바인드 변수(?, :name)를 사용하세요",
+	"taint.xss.message":         "사용자 입력(%s)이 이스케이프 없이 HTML 출력(%s)에 사용됩니다",
+	"taint.xss.suggestion":      "출력 전에 HTML 이스케이프하거나 textContent 등 안전한 API를 사용하세요",
+
+	"template.c-out-unescaped.message":    "<c:out escapeXml=\"false\">는 HTML 이스케이프 없이 출력합니다",
+	"template.c-out-unescaped.suggestion": "escapeXml=\"false\"를 제거하세요 (기본값 true)",
+	"template.jsp-expression.message":     "<%= %> 표현식은 HTML 이스케이프 없이 출력합니다",
+	"template.jsp-expression.suggestion":  "<c:out value=\"${...}\"/> 또는 ${fn:escapeXml(...)}을 사용하세요",
+	"template.th-inline-raw.message":      "[(...)] 인라인 표현식은 HTML 이스케이프 없이 출력합니다",
+	"template.th-inline-raw.suggestion":   "[[...]] 인라인 표현식을 사용하세요",
+	"template.th-utext.message":           "th:utext로 데이터를 HTML 이스케이프 없이 출력합니다",
+	"template.th-utext.suggestion":        "th:text를 사용하거나 서버에서 허용 태그만 남기도록 정제(sanitize)한 값만 th:utext로 출력하세요",
+
+	"trends.created":      "📈 추이 리포트 생성 완료: %s",
+	"trends.failed":       "추이 출력 실패: %v",
+	"trends.open-failed":  "실행 기록 파일을 열 수 없습니다: %v",
+	"trends.query-failed": "실행 기록 조회 실패: %v",
+
+	"verify.commit":       "커밋:          %s",
+	"verify.no-key":       "❌ 서명 키 환경 변수 %s가 비어있습니다",
+	"verify.no-metadata":  "❌ 메타데이터가 없는 결과입니다",
+	"verify.ok":           "✅ 서명 확인 완료",
+	"verify.ruleset-hash": "규칙 세트 해시: %s",
+	"verify.tool-version": "도구 버전:     %s",
+
+	"web-performance.blocking-script.description": "동기 스크립트는 내려받아 실행할 때까지 HTML 파싱과 첫 화면 렌더링을 막습니다",
+	"web-performance.blocking-script.message":     "<head>에서 defer/async 없이 스크립트를 불러옵니다",
+	"web-performance.blocking-script.suggestion":  "defer(실행 순서 유지) 또는 async 속성을 추가하거나 스크립트를 </body> 직전으로 옮기세요",
+	"web-performance.img-size.description":        "이미지 크기를 모르면 이미지를 불러온 뒤 레이아웃이 밀려 CLS(Cumulative Layout Shift)가 나빠집니다",
+	"web-performance.img-size.message":            "img 태그에 %s 속성이 없습니다",
+	"web-performance.img-size.suggestion":         "이미지의 원본 크기로 width와 height 속성을 지정하고, 반응형 크기는 CSS(height: auto)로 조정하세요",
+	"web-performance.inline-block.description":    "인라인 코드는 브라우저에 캐시되지 않아 페이지를 열 때마다 다시 내려받고 HTML 응답이 커집니다",
+	"web-performance.inline-block.message":        "인라인 <%s> 블록이 %s로 예산(%s)을 넘습니다",
+	"web-performance.inline-block.suggestion":     "외부 .%s 파일로 분리해 캐시되도록 하세요",
+	"web-performance.inline-total.description":    "서버 렌더링 페이지의 HTML 응답이 커져 첫 바이트 이후 화면 표시가 늦어집니다",
+	"web-performance.inline-total.message":        "페이지의 인라인 CSS/JS가 모두 %s로 예산(%s)을 넘습니다 (블록 %d개)",
+	"web-performance.inline-total.suggestion":     "공통 스타일과 스크립트를 외부 파일로 분리하고, 첫 화면에 꼭 필요한 CSS만 인라인으로 남기세요",
+	"web-performance.resource-count.description":  "파일마다 요청이 추가되어 첫 화면 표시가 늦어지고, CSS는 모두 내려받을 때까지 렌더링을 막습니다",
+	"web-performance.resource-count.message":      "페이지에서 외부 CSS/JS 파일을 %d개 불러옵니다 (CSS %d개, JS %d개, 최대 %d개)",
+	"web-performance.resource-count.suggestion":   "빌드 단계에서 파일을 번들로 합치고, 첫 화면에 필요 없는 리소스는 지연 로딩하세요",
+
+	"xss-correlation.description": "서버 데이터가 출력되는 템플릿 요소를 스크립트가 innerHTML로 다시 쓰면 저장형/DOM XSS로 이어질 수 있습니다",
+	"xss-correlation.message":     "템플릿에서 사용자 데이터로 렌더링되는 요소 #%s에 innerHTML로 쓰고 있습니다 (%s:%d)",
+	"xss-correlation.suggestion":  "textContent를 사용하거나 템플릿과 스크립트 양쪽에서 출력값을 이스케이프하세요",
+}
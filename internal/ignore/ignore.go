@@ -0,0 +1,101 @@
+package ignore
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/editorconfig"
+)
+
+// FileName 분석 경로(와 하위 디렉터리)에 두는 제외 패턴 파일
+const FileName = ".cqcignore"
+
+// pattern 기준 디렉터리가 있는 제외 패턴 하나
+type pattern struct {
+	base    string
+	regex   *regexp.Regexp
+	negate  bool // !로 시작하면 앞에서 제외한 경로를 다시 포함
+	dirOnly bool // /로 끝나면 디렉터리에만 적용
+}
+
+// List gitignore 문법의 제외 패턴 목록 (나중에 추가한 패턴이 우선)
+// /가 없는 패턴은 모든 하위 디렉터리에서, /가 있는 패턴은 기준 디렉터리에서 시작하는 경로와 비교합니다
+type List struct {
+	patterns []pattern
+}
+
+// Add base 디렉터리 기준 패턴 한 줄 추가 (빈 줄과 # 주석은 무시)
+func (l *List) Add(base, line string) error {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	p := pattern{base: base}
+	if strings.HasPrefix(line, "!") {
+		p.negate = true
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return nil
+	}
+
+	regex, err := editorconfig.CompileGlob(line)
+	if err != nil {
+		return fmt.Errorf("잘못된 제외 패턴 %s: %w", line, err)
+	}
+	p.regex = regex
+	l.patterns = append(l.patterns, p)
+	return nil
+}
+
+// AddFile 제외 패턴 파일을 그 파일이 있는 디렉터리 기준으로 추가 (파일이 없으면 무시)
+func (l *List) AddFile(path string) error {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	base := filepath.Dir(path)
+	scanner := bufio.NewScanner(file)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		if err := l.Add(base, scanner.Text()); err != nil {
+			return fmt.Errorf("%s:%d: %w", path, lineNum, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// Ignored path가 제외 대상인지 (마지막으로 일치한 패턴이 결정, isDir면 디렉터리 전용 패턴도 적용)
+func (l *List) Ignored(path string, isDir bool) bool {
+	if l == nil {
+		return false
+	}
+
+	ignored := false
+	for _, p := range l.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		rel, err := filepath.Rel(p.base, path)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if p.regex.MatchString(filepath.ToSlash(rel)) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
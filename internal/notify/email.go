@@ -0,0 +1,166 @@
+package notify
+
+import (
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/smtp"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/reporter"
+	"code-quality-checker/internal/types"
+)
+
+// defaultSMTPPort SMTP 기본 포트 (STARTTLS 제출 포트)
+const defaultSMTPPort = 587
+
+// SendEmail 분석 결과 요약을 메일로 발송 (only_on_regression이면 회귀가 없을 때 발송하지 않음)
+// 발송 여부를 함께 반환합니다
+func SendEmail(cfg config.EmailConfig, result *types.AnalysisResult) (bool, error) {
+	if cfg.Host == "" || len(cfg.To) == 0 {
+		return false, fmt.Errorf("메일 설정에 host와 to가 필요합니다")
+	}
+
+	if cfg.OnlyOnRegression {
+		regressed, err := isRegression(cfg.Baseline, result)
+		if err != nil {
+			return false, err
+		}
+		if !regressed {
+			return false, nil
+		}
+	}
+
+	message, err := buildMessage(cfg, result)
+	if err != nil {
+		return false, err
+	}
+
+	port := cfg.Port
+	if port == 0 {
+		port = defaultSMTPPort
+	}
+	addr := fmt.Sprintf("%s:%d", cfg.Host, port)
+
+	var auth smtp.Auth
+	if cfg.Username != "" {
+		auth = smtp.PlainAuth("", cfg.Username, os.Getenv(cfg.PasswordEnv), cfg.Host)
+	}
+
+	if err := smtp.SendMail(addr, auth, cfg.From, cfg.To, message); err != nil {
+		return false, fmt.Errorf("메일 발송 실패: %w", err)
+	}
+	return true, nil
+}
+
+// isRegression 기준 결과보다 전체 이슈나 Critical/High 이슈가 늘었는지 확인 (기준 결과가 없으면 회귀로 간주)
+func isRegression(baselinePath string, result *types.AnalysisResult) (bool, error) {
+	if baselinePath == "" {
+		return true, nil
+	}
+
+	baseline, err := types.LoadResult(baselinePath)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("기준 결과 로드 실패: %w", err)
+	}
+
+	if result.Summary.TotalIssues > baseline.Summary.TotalIssues {
+		return true, nil
+	}
+	for _, severity := range []config.Severity{config.SeverityCritical, config.SeverityHigh} {
+		if result.Summary.SeverityCount[severity] > baseline.Summary.SeverityCount[severity] {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// buildMessage 메일 메시지 (헤더 + 본문) 생성
+func buildMessage(cfg config.EmailConfig, result *types.AnalysisResult) ([]byte, error) {
+	var body, contentType string
+	switch cfg.Format {
+	case "", "html":
+		html, err := reporter.RenderHTML(result)
+		if err != nil {
+			return nil, err
+		}
+		body, contentType = html, "text/html"
+	case "markdown":
+		body, contentType = markdownSummary(result), "text/markdown"
+	default:
+		return nil, fmt.Errorf("지원하지 않는 메일 형식: %s (html/markdown)", cfg.Format)
+	}
+
+	subject := cfg.Subject
+	if subject == "" {
+		subject = fmt.Sprintf("[CQC] 코드 품질 리포트 - 이슈 %d개", result.Summary.TotalIssues)
+	}
+
+	var msg strings.Builder
+	msg.WriteString("From: " + cfg.From + "\r\n")
+	msg.WriteString("To: " + strings.Join(cfg.To, ", ") + "\r\n")
+	msg.WriteString("Subject: " + mime.BEncoding.Encode("UTF-8", subject) + "\r\n")
+	msg.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: " + contentType + "; charset=UTF-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	msg.WriteString("\r\n")
+
+	// HTML 리포트는 SMTP 라인 길이 제한(998자)을 넘는 줄이 있으므로 quoted-printable로 인코딩
+	encoder := quotedprintable.NewWriter(&msg)
+	if _, err := encoder.Write([]byte(strings.ReplaceAll(body, "\n", "\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+
+	return []byte(msg.String()), nil
+}
+
+// markdownSummary 메일용 Markdown 요약
+func markdownSummary(result *types.AnalysisResult) string {
+	var md strings.Builder
+
+	md.WriteString("# 🔍 Code Quality Report\n\n")
+	md.WriteString(fmt.Sprintf("- 검사 파일 수: %d개\n", result.Summary.TotalFiles))
+	md.WriteString(fmt.Sprintf("- 발견된 이슈: %d개\n", result.Summary.TotalIssues))
+	md.WriteString(fmt.Sprintf("- 분석 시간: %.2f초\n\n", result.Duration.Seconds()))
+
+	md.WriteString("| 심각도 | 이슈 수 |\n|---|---|\n")
+	for _, severity := range []config.Severity{config.SeverityCritical, config.SeverityHigh, config.SeverityMedium, config.SeverityLow} {
+		md.WriteString(fmt.Sprintf("| %s | %d |\n", severity.String(), result.Summary.SeverityCount[severity]))
+	}
+	md.WriteString("\n")
+
+	if len(result.Summary.CategoryCount) > 0 {
+		categories := make([]string, 0, len(result.Summary.CategoryCount))
+		for category := range result.Summary.CategoryCount {
+			categories = append(categories, category)
+		}
+		sort.Strings(categories)
+
+		md.WriteString("| 카테고리 | 이슈 수 |\n|---|---|\n")
+		for _, category := range categories {
+			md.WriteString(fmt.Sprintf("| %s | %d |\n", category, result.Summary.CategoryCount[category]))
+		}
+		md.WriteString("\n")
+	}
+
+	if failed := result.FailedGates(); len(failed) > 0 {
+		md.WriteString("## 🚦 실패한 품질 게이트\n\n")
+		for _, gate := range failed {
+			md.WriteString(fmt.Sprintf("- %s: %d개 (허용 %d개, %s 이상)\n", gate.Category, gate.Count, gate.Max, gate.MinSeverity))
+		}
+		md.WriteString("\n")
+	}
+
+	return md.String()
+}
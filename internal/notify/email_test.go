@@ -0,0 +1,105 @@
+package notify
+
+import (
+	"io"
+	"mime/quotedprintable"
+	"strings"
+	"testing"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/reporter"
+	"code-quality-checker/internal/types"
+)
+
+// qpMaxLineLength quoted-printable 인코딩된 줄의 최대 길이 (RFC 2045, CRLF 제외)
+const qpMaxLineLength = 76
+
+func TestBuildMessageQuotedPrintable(t *testing.T) {
+	longCategory := strings.Repeat("maintainability-", 80)
+	korean := strings.Repeat("한글 메시지가 길게 이어지는 이슈입니다 ", 60)
+
+	tests := []struct {
+		name   string
+		format string
+		result *types.AnalysisResult
+	}{
+		{
+			name:   "짧은 Markdown",
+			format: "markdown",
+			result: &types.AnalysisResult{Summary: types.Summary{TotalFiles: 3, TotalIssues: 1}},
+		},
+		{
+			name:   "998자를 넘는 Markdown 줄",
+			format: "markdown",
+			result: &types.AnalysisResult{Summary: types.Summary{
+				TotalIssues:   1,
+				CategoryCount: map[string]int{longCategory: 1},
+			}},
+		},
+		{
+			name:   "멀티바이트 문자가 많은 HTML",
+			format: "html",
+			result: &types.AnalysisResult{
+				Summary: types.Summary{
+					TotalFiles:    1,
+					TotalIssues:   1,
+					SeverityCount: map[config.Severity]int{config.SeverityHigh: 1},
+					CategoryCount: map[string]int{"security": 1},
+				},
+				Issues: []types.Issue{{
+					RuleID:   "java-taint-flow",
+					File:     "src/main/java/" + strings.Repeat("nested/", 40) + "Service.java",
+					Line:     10,
+					Severity: config.SeverityHigh,
+					Category: "security",
+					Message:  korean,
+				}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.EmailConfig{From: "cqc@example.com", To: []string{"dev@example.com"}, Format: tt.format}
+			message, err := buildMessage(cfg, tt.result)
+			if err != nil {
+				t.Fatalf("buildMessage 오류: %v", err)
+			}
+
+			header, body, ok := strings.Cut(string(message), "\r\n\r\n")
+			if !ok {
+				t.Fatal("헤더와 본문 사이에 빈 줄이 없습니다")
+			}
+			if !strings.Contains(header+"\r\n", "Content-Transfer-Encoding: quoted-printable\r\n") {
+				t.Errorf("Content-Transfer-Encoding 헤더 없음:\n%s", header)
+			}
+
+			// 모든 줄이 CRLF로 끝나고 76자를 넘지 않아야 함
+			if strings.Contains(strings.ReplaceAll(body, "\r\n", ""), "\n") {
+				t.Error("본문에 CR 없는 LF가 있습니다")
+			}
+			for i, line := range strings.Split(body, "\r\n") {
+				if len(line) > qpMaxLineLength {
+					t.Errorf("%d번째 줄 길이 %d > %d: %q", i+1, len(line), qpMaxLineLength, line)
+				}
+			}
+
+			// 디코딩하면 원래 본문 (줄바꿈은 CRLF)
+			decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+			if err != nil {
+				t.Fatalf("quoted-printable 디코딩 오류: %v", err)
+			}
+			var want string
+			if tt.format == "html" {
+				if want, err = reporter.RenderHTML(tt.result); err != nil {
+					t.Fatal(err)
+				}
+			} else {
+				want = markdownSummary(tt.result)
+			}
+			if want = strings.ReplaceAll(want, "\n", "\r\n"); string(decoded) != want {
+				t.Errorf("디코딩한 본문이 원래 본문과 다릅니다 (길이 %d, want %d)", len(decoded), len(want))
+			}
+		})
+	}
+}
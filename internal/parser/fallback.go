@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrParseTimeout 구조 파싱이 제한 시간을 넘음
+var ErrParseTimeout = errors.New("파싱 제한 시간 초과")
+
+// ParseFileTimeout 제한 시간 안에 ParseFile 실행 (파서 패닉은 오류로 변환)
+// 정규식 파싱은 중간에 멈출 수 없으므로 시간이 초과되면 파싱 고루틴을 기다리지 않고 ErrParseTimeout을 반환합니다
+func ParseFileTimeout(filePath, language string, timeout time.Duration) (*ParsedFile, error) {
+	type outcome struct {
+		parsed *ParsedFile
+		err    error
+	}
+	done := make(chan outcome, 1)
+
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- outcome{err: fmt.Errorf("파서 오류: %v", r)}
+			}
+		}()
+		parsed, err := ParseFile(filePath, language)
+		done <- outcome{parsed, err}
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case result := <-done:
+		return result.parsed, result.err
+	case <-timer.C:
+		return nil, fmt.Errorf("%w (%s)", ErrParseTimeout, timeout)
+	}
+}
+
+// ParseText 구조 파싱 없이 라인과 텍스트 토큰만 생성 (구조 파서가 실패했을 때 정규식 기반 규칙용, AST는 nil)
+func ParseText(filePath, language string) (*ParsedFile, error) {
+	content, err := readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ParsedFile{
+		Path:        filePath,
+		Language:    language,
+		Content:     content,
+		Lines:       strings.Split(content, "\n"),
+		LineOffsets: computeLineOffsets(content),
+		Tokens:      tokenizeText(content),
+	}, nil
+}
@@ -0,0 +1,175 @@
+package parser
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// 매니페스트 종류
+const (
+	ManifestMaven  = "maven"
+	ManifestGradle = "gradle"
+	ManifestNPM    = "npm"
+)
+
+var (
+	pomDependencyRegex = regexp.MustCompile(`(?s)<dependency>(.*?)</dependency>`)
+	pomPropertiesRegex = regexp.MustCompile(`(?s)<properties>(.*?)</properties>`)
+	pomPropertyRegex   = regexp.MustCompile(`<([\w.-]+)>\s*([^<]*?)\s*</([\w.-]+)>`)
+	pomPlaceholder     = regexp.MustCompile(`\$\{([\w.-]+)\}`)
+	gradleDependency   = regexp.MustCompile(`^\s*(?:implementation|api|compile|compileOnly|runtimeOnly|testImplementation|testCompileOnly|testRuntimeOnly|annotationProcessor)\s*\(?\s*['"]([^:'"\s]+):([^:'"\s]+)(?::([^'"@\s]+))?[^'"]*['"]`)
+)
+
+// Manifest 의존성 매니페스트 (pom.xml, build.gradle, package.json)
+type Manifest struct {
+	Kind         string
+	Dependencies []Dependency
+}
+
+// Dependency 매니페스트에 선언된 의존성 (maven/gradle은 groupId:artifactId)
+type Dependency struct {
+	Name    string
+	Version string // 선언된 그대로 (npm 범위 기호 포함, maven 속성은 치환)
+	Scope   string // maven scope, gradle 설정, npm 섹션
+	Line    int
+}
+
+// IsManifest 의존성 매니페스트 파일인지 확인
+func IsManifest(path string) bool {
+	return manifestKind(path) != ""
+}
+
+func manifestKind(path string) string {
+	switch strings.ToLower(filepath.Base(path)) {
+	case "pom.xml":
+		return ManifestMaven
+	case "build.gradle", "build.gradle.kts":
+		return ManifestGradle
+	case "package.json":
+		return ManifestNPM
+	default:
+		return ""
+	}
+}
+
+// parseManifest 매니페스트 종류별 의존성 추출
+func parseManifest(path, content string, lines []string, offsets []int) (*Manifest, error) {
+	manifest := &Manifest{Kind: manifestKind(path)}
+
+	switch manifest.Kind {
+	case ManifestMaven:
+		manifest.Dependencies = parsePOM(content, offsets)
+	case ManifestGradle:
+		for i, line := range lines {
+			if match := gradleDependency.FindStringSubmatch(line); match != nil {
+				manifest.Dependencies = append(manifest.Dependencies, Dependency{
+					Name:    match[1] + ":" + match[2],
+					Version: match[3],
+					Scope:   strings.Fields(strings.TrimSpace(line))[0],
+					Line:    i + 1,
+				})
+			}
+		}
+	case ManifestNPM:
+		deps, err := parsePackageJSON(content, lines)
+		if err != nil {
+			return nil, err
+		}
+		manifest.Dependencies = deps
+	}
+	return manifest, nil
+}
+
+// parsePOM pom.xml의 dependency 요소 추출 (${속성} 버전은 properties 값으로 치환)
+func parsePOM(content string, offsets []int) []Dependency {
+	properties := make(map[string]string)
+	if match := pomPropertiesRegex.FindStringSubmatch(content); match != nil {
+		for _, property := range pomPropertyRegex.FindAllStringSubmatch(match[1], -1) {
+			if property[1] == property[3] {
+				properties[property[1]] = property[2]
+			}
+		}
+	}
+
+	var dependencies []Dependency
+	for _, match := range pomDependencyRegex.FindAllStringSubmatchIndex(content, -1) {
+		block := content[match[2]:match[3]]
+		dependency := Dependency{
+			Name:    xmlElement(block, "groupId") + ":" + xmlElement(block, "artifactId"),
+			Version: xmlElement(block, "version"),
+			Scope:   xmlElement(block, "scope"),
+			Line:    lineAt(offsets, match[0]),
+		}
+		dependency.Version = pomPlaceholder.ReplaceAllStringFunc(dependency.Version, func(placeholder string) string {
+			if value, ok := properties[placeholder[2:len(placeholder)-1]]; ok {
+				return value
+			}
+			return placeholder
+		})
+		dependencies = append(dependencies, dependency)
+	}
+	return dependencies
+}
+
+// xmlElement 블록에서 첫 번째 <name> 요소의 텍스트
+func xmlElement(block, name string) string {
+	start := strings.Index(block, "<"+name+">")
+	if start < 0 {
+		return ""
+	}
+	start += len(name) + 2
+	end := strings.Index(block[start:], "</"+name+">")
+	if end < 0 {
+		return ""
+	}
+	return strings.TrimSpace(block[start : start+end])
+}
+
+// parsePackageJSON package.json의 dependencies/devDependencies 등 추출 (이름순)
+func parsePackageJSON(content string, lines []string) ([]Dependency, error) {
+	var pkg map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &pkg); err != nil {
+		return nil, err
+	}
+
+	var dependencies []Dependency
+	for _, section := range []string{"dependencies", "devDependencies", "peerDependencies", "optionalDependencies"} {
+		raw, ok := pkg[section]
+		if !ok {
+			continue
+		}
+		var versions map[string]string
+		if err := json.Unmarshal(raw, &versions); err != nil {
+			continue
+		}
+
+		names := make([]string, 0, len(versions))
+		for name := range versions {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			dependencies = append(dependencies, Dependency{
+				Name:    name,
+				Version: versions[name],
+				Scope:   section,
+				Line:    findKeyLine(lines, name),
+			})
+		}
+	}
+	return dependencies, nil
+}
+
+// findKeyLine JSON 키가 처음 나오는 라인 (없으면 1)
+func findKeyLine(lines []string, key string) int {
+	quoted := `"` + key + `"`
+	for i, line := range lines {
+		if strings.Contains(line, quoted) {
+			return i + 1
+		}
+	}
+	return 1
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// overlays 디스크 대신 사용할 파일 내용 (키는 절대 경로)
+// 에디터의 저장하지 않은 버퍼를 표준 입력으로 받아 원래 경로의 파일처럼 분석할 때 사용합니다
+var overlays = struct {
+	sync.RWMutex
+	files map[string]string
+}{files: make(map[string]string)}
+
+func overlayKey(filePath string) string {
+	if abs, err := filepath.Abs(filePath); err == nil {
+		return abs
+	}
+	return filepath.Clean(filePath)
+}
+
+// SetOverlay filePath를 읽을 때 디스크 대신 content 사용 (파일이 디스크에 없어도 됨)
+func SetOverlay(filePath, content string) {
+	overlays.Lock()
+	defer overlays.Unlock()
+	overlays.files[overlayKey(filePath)] = content
+}
+
+// ClearOverlay SetOverlay로 지정한 내용 제거
+func ClearOverlay(filePath string) {
+	overlays.Lock()
+	defer overlays.Unlock()
+	delete(overlays.files, overlayKey(filePath))
+}
+
+// Overlay filePath에 지정된 내용 (없으면 false)
+func Overlay(filePath string) (string, bool) {
+	overlays.RLock()
+	defer overlays.RUnlock()
+	content, ok := overlays.files[overlayKey(filePath)]
+	return content, ok
+}
+
+// Open 파일 열기 (overlay가 있으면 그 내용을 읽음)
+func Open(filePath string) (io.ReadCloser, error) {
+	if content, ok := Overlay(filePath); ok {
+		return io.NopCloser(strings.NewReader(content)), nil
+	}
+	return os.Open(filePath)
+}
+
+// Stat 파일 정보 (overlay가 있으면 그 내용의 크기)
+func Stat(filePath string) (os.FileInfo, error) {
+	if content, ok := Overlay(filePath); ok {
+		return overlayInfo{name: filepath.Base(filePath), size: int64(len(content))}, nil
+	}
+	return os.Stat(filePath)
+}
+
+// overlayInfo overlay 파일의 os.FileInfo
+type overlayInfo struct {
+	name string
+	size int64
+}
+
+func (i overlayInfo) Name() string       { return i.name }
+func (i overlayInfo) Size() int64        { return i.size }
+func (i overlayInfo) Mode() fs.FileMode  { return 0644 }
+func (i overlayInfo) ModTime() time.Time { return time.Time{} }
+func (i overlayInfo) IsDir() bool        { return false }
+func (i overlayInfo) Sys() interface{}   { return nil }
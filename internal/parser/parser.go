@@ -3,19 +3,53 @@ package parser
 import (
 	"bufio"
 	"fmt"
-	"os"
+	"io"
 	"regexp"
 	"strings"
+	"unicode"
+)
+
+// 파싱에 사용하는 정규식 (파일마다 다시 컴파일하지 않도록 미리 컴파일)
+var (
+	javaPackageRegex = regexp.MustCompile(`package\s+([a-zA-Z0-9_.]+);`)
+	javaImportRegex  = regexp.MustCompile(`import\s+([a-zA-Z0-9_.*]+);`)
+	javaClassRegex   = regexp.MustCompile(`(?:public\s+)?class\s+(\w+)`)
+	// 메소드 패턴: (접근제한자)? (기타제한자)* 리턴타입 메소드명(파라미터) {
+	// 리턴타입은 ResponseEntity<ApiResponse<T>>처럼 한 단계 중첩된 제네릭까지 인식
+	javaMethodRegex = regexp.MustCompile(`(?m)^\s*(?:(public|private|protected)\s+)?((?:(?:static|final|abstract|synchronized)\s+)*)(\w+(?:<(?:[^<>]|<[^<>]*>)+>)?)\s+(\w+)\s*\(([^)]*)\)\s*(?:throws\s+[^{]+)?\s*\{`)
+	// 필드 패턴: (접근제한자)? (기타제한자)* 타입 필드명;
+	javaFieldRegex = regexp.MustCompile(`(?m)^\s*(?:(public|private|protected)\s+)?((?:(?:static|final)\s+)*)(\w+(?:<[^>]+>)?)\s+(\w+)\s*(?:=\s*[^;]+)?;`)
+
+	// JavaScript 함수 패턴들
+	jsFunctionPatterns = []struct {
+		regex   *regexp.Regexp
+		isArrow bool
+	}{
+		{regexp.MustCompile(`function\s+(\w+)\s*\(([^)]*)\)\s*\{`), false},      // function name() {}
+		{regexp.MustCompile(`(\w+)\s*:\s*function\s*\(([^)]*)\)\s*\{`), false},  // name: function() {}
+		{regexp.MustCompile(`(\w+)\s*=\s*function\s*\(([^)]*)\)\s*\{`), false},  // name = function() {}
+		{regexp.MustCompile(`(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`), true},         // name = () => {}
+		{regexp.MustCompile(`const\s+(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`), true}, // const name = () => {}
+		{regexp.MustCompile(`let\s+(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`), true},   // let name = () => {}
+	}
+
+	htmlImgRegex     = regexp.MustCompile(`<img[^>]*>`)
+	htmlSrcRegex     = regexp.MustCompile(`src\s*=\s*["']([^"']*)["']`)
+	htmlAltRegex     = regexp.MustCompile(`alt\s*=\s*["']([^"']*)["']`)
+	htmlFormRegex    = regexp.MustCompile(`<form[^>]*>`)
+	htmlScriptRegex  = regexp.MustCompile(`<script[^>]*>[\s\S]*?</script>`)
+	cssSelectorRegex = regexp.MustCompile(`([^{}]+)\s*\{`)
 )
 
 // ParsedFile 파싱된 파일 정보
 type ParsedFile struct {
-	Path     string
-	Language string
-	Content  string
-	Lines    []string
-	Tokens   []Token
-	AST      interface{} // 언어별로 다른 AST 구조
+	Path        string
+	Language    string
+	Content     string
+	Lines       []string
+	LineOffsets []int // 각 라인의 시작 위치 (바이트 오프셋)
+	Tokens      []Token
+	AST         interface{} // 언어별로 다른 AST 구조
 }
 
 // Token 토큰 정보
@@ -82,24 +116,28 @@ func ParseFile(filePath, language string) (*ParsedFile, error) {
 	}
 
 	lines := strings.Split(content, "\n")
+	offsets := computeLineOffsets(content)
 
 	parsed := &ParsedFile{
 		Path:     filePath,
 		Language: language,
 		Content:  content,
 		Lines:    lines,
+		LineOffsets: offsets,
 	}
 
 	// 언어별 파싱
 	switch language {
 	case "java":
-		parsed.AST, err = parseJava(content, lines)
+		parsed.AST, err = parseJava(content, lines, offsets)
 	case "javascript", "typescript":
-		parsed.AST, err = parseJavaScript(content, lines)
+		parsed.AST, err = parseJavaScript(content, lines, offsets)
 	case "html":
-		parsed.AST, err = parseHTML(content, lines)
+		parsed.AST, err = parseHTML(filePath, content, lines)
 	case "css":
 		parsed.AST, err = parseCSS(content, lines)
+	case "manifest":
+		parsed.AST, err = parseManifest(filePath, content, lines, offsets)
 	default:
 		// 기본적으로 텍스트 파싱
 		parsed.Tokens = tokenizeText(content)
@@ -114,7 +152,7 @@ func ParseFile(filePath, language string) (*ParsedFile, error) {
 
 // readFile 파일 읽기
 func readFile(filePath string) (string, error) {
-	file, err := os.Open(filePath)
+	file, err := Open(filePath)
 	if err != nil {
 		return "", err
 	}
@@ -130,52 +168,67 @@ func readFile(filePath string) (string, error) {
 	return content.String(), scanner.Err()
 }
 
+// ScanLines 파일 전체를 메모리에 올리지 않고 한 줄씩 읽어 처리 (대용량 파일용)
+func ScanLines(filePath string, fn func(lineNum int, line string)) error {
+	file, err := Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReaderSize(file, 64*1024)
+	for lineNum := 1; ; lineNum++ {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			fn(lineNum, strings.TrimRight(line, "\r\n"))
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // parseJava Java 파일 파싱
-func parseJava(content string, lines []string) (*JavaClass, error) {
+func parseJava(content string, lines []string, offsets []int) (*JavaClass, error) {
 	class := &JavaClass{}
 
 	// 패키지 추출
-	packageRegex := regexp.MustCompile(`package\s+([a-zA-Z0-9_.]+);`)
-	if match := packageRegex.FindStringSubmatch(content); len(match) > 1 {
+	if match := javaPackageRegex.FindStringSubmatch(content); len(match) > 1 {
 		class.Package = match[1]
 	}
 
 	// import 추출
-	importRegex := regexp.MustCompile(`import\s+([a-zA-Z0-9_.*]+);`)
-	imports := importRegex.FindAllStringSubmatch(content, -1)
+	imports := javaImportRegex.FindAllStringSubmatch(content, -1)
 	for _, imp := range imports {
 		if len(imp) > 1 {
 			class.Imports = append(class.Imports, imp[1])
 		}
 	}
 
-	// 클래스명 추출
-	classRegex := regexp.MustCompile(`(?:public\s+)?class\s+(\w+)`)
-	if match := classRegex.FindStringSubmatch(content); len(match) > 1 {
-		class.Name = match[1]
+	// 클래스명과 클래스 선언 앞의 어노테이션 추출
+	if match := javaClassRegex.FindStringSubmatchIndex(content); match != nil {
+		class.Name = content[match[2]:match[3]]
+		class.Annotations = extractAnnotations(content, lines, offsets, match[0])
 	}
 
-	// 클래스 어노테이션 추출
-	class.Annotations = extractAnnotations(content, 0)
-
 	// 메소드 추출
-	class.Methods = extractJavaMethods(content, lines)
+	class.Methods = extractJavaMethods(content, lines, offsets)
 
 	// 필드 추출
-	class.Fields = extractJavaFields(content, lines)
+	class.Fields = extractJavaFields(content, lines, offsets)
 
 	return class, nil
 }
 
 // extractJavaMethods Java 메소드 추출
-func extractJavaMethods(content string, lines []string) []JavaMethod {
+func extractJavaMethods(content string, lines []string, offsets []int) []JavaMethod {
 	var methods []JavaMethod
 
-	// 메소드 패턴: (접근제한자)? (기타제한자)* 리턴타입 메소드명(파라미터) {
-	methodRegex := regexp.MustCompile(`(?m)^\s*(?:(public|private|protected)\s+)?(?:(static|final|abstract|synchronized)\s+)*(\w+(?:<[^>]+>)?)\s+(\w+)\s*\(([^)]*)\)\s*(?:throws\s+[^{]+)?\s*\{`)
-
-	matches := methodRegex.FindAllStringSubmatch(content, -1)
-	indices := methodRegex.FindAllStringIndex(content, -1)
+	matches := javaMethodRegex.FindAllStringSubmatch(content, -1)
+	indices := javaMethodRegex.FindAllStringIndex(content, -1)
 
 	for i, match := range matches {
 		if len(match) >= 5 {
@@ -208,11 +261,16 @@ func extractJavaMethods(content string, lines []string) []JavaMethod {
 
 			// 라인 번호 계산
 			if i < len(indices) {
-				lineNum := getLineNumber(content, indices[i][0])
+				// 패턴 앞의 \s*가 빈 라인까지 삼키므로 선언이 시작되는 위치로 이동
+				start := indices[i][0]
+				for start < len(content) && unicode.IsSpace(rune(content[start])) {
+					start++
+				}
+				lineNum := lineAt(offsets, start)
 				method.Line = lineNum
 				
 				// 메소드 이전 어노테이션 추출
-				method.Annotations = extractAnnotations(content, indices[i][0])
+				method.Annotations = extractAnnotations(content, lines, offsets, start)
 			}
 
 			methods = append(methods, method)
@@ -223,14 +281,11 @@ func extractJavaMethods(content string, lines []string) []JavaMethod {
 }
 
 // extractJavaFields Java 필드 추출
-func extractJavaFields(content string, lines []string) []JavaField {
+func extractJavaFields(content string, lines []string, offsets []int) []JavaField {
 	var fields []JavaField
 
-	// 필드 패턴: (접근제한자)? (기타제한자)* 타입 필드명;
-	fieldRegex := regexp.MustCompile(`(?m)^\s*(?:(public|private|protected)\s+)?(?:(static|final)\s+)*(\w+(?:<[^>]+>)?)\s+(\w+)\s*(?:=\s*[^;]+)?;`)
-
-	matches := fieldRegex.FindAllStringSubmatch(content, -1)
-	indices := fieldRegex.FindAllStringIndex(content, -1)
+	matches := javaFieldRegex.FindAllStringSubmatch(content, -1)
+	indices := javaFieldRegex.FindAllStringIndex(content, -1)
 
 	for i, match := range matches {
 		if len(match) >= 5 {
@@ -249,8 +304,8 @@ func extractJavaFields(content string, lines []string) []JavaField {
 
 			// 라인 번호 계산
 			if i < len(indices) {
-				field.Line = getLineNumber(content, indices[i][0])
-				field.Annotations = extractAnnotations(content, indices[i][0])
+				field.Line = lineAt(offsets, indices[i][0])
+				field.Annotations = extractAnnotations(content, lines, offsets, indices[i][0])
 			}
 
 			fields = append(fields, field)
@@ -261,16 +316,19 @@ func extractJavaFields(content string, lines []string) []JavaField {
 }
 
 // extractAnnotations 어노테이션 추출
-func extractAnnotations(content string, beforePos int) []string {
+func extractAnnotations(content string, lines []string, offsets []int, beforePos int) []string {
 	var annotations []string
 
-	// beforePos 이전의 내용에서 어노테이션 찾기
-	beforeContent := content[:beforePos]
-	lines := strings.Split(beforeContent, "\n")
+	// beforePos 이전의 내용에서 어노테이션 찾기 (beforePos가 속한 라인은 앞부분만 사용)
+	lineIdx := lineAt(offsets, beforePos) - 1
 
 	// 뒤에서부터 어노테이션 찾기
-	for i := len(lines) - 1; i >= 0; i-- {
-		line := strings.TrimSpace(lines[i])
+	for i := lineIdx; i >= 0; i-- {
+		line := lines[i]
+		if i == lineIdx {
+			line = content[offsets[i]:beforePos]
+		}
+		line = strings.TrimSpace(line)
 		if strings.HasPrefix(line, "@") {
 			annotations = append([]string{line}, annotations...) // 앞에 추가
 		} else if line != "" && !strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "*") {
@@ -283,23 +341,12 @@ func extractAnnotations(content string, beforePos int) []string {
 }
 
 // parseJavaScript JavaScript 파일 파싱  
-func parseJavaScript(content string, lines []string) ([]JSFunction, error) {
+func parseJavaScript(content string, lines []string, offsets []int) ([]JSFunction, error) {
 	var functions []JSFunction
 
-	// 함수 패턴들
-	patterns := []string{
-		`function\s+(\w+)\s*\(([^)]*)\)\s*\{`,           // function name() {}
-		`(\w+)\s*:\s*function\s*\(([^)]*)\)\s*\{`,      // name: function() {}
-		`(\w+)\s*=\s*function\s*\(([^)]*)\)\s*\{`,      // name = function() {}
-		`(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`,            // name = () => {}
-		`const\s+(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`,    // const name = () => {}
-		`let\s+(\w+)\s*=\s*\(([^)]*)\)\s*=>\s*\{`,      // let name = () => {}
-	}
-
-	for _, pattern := range patterns {
-		regex := regexp.MustCompile(pattern)
-		matches := regex.FindAllStringSubmatch(content, -1)
-		indices := regex.FindAllStringIndex(content, -1)
+	for _, pattern := range jsFunctionPatterns {
+		matches := pattern.regex.FindAllStringSubmatch(content, -1)
+		indices := pattern.regex.FindAllStringIndex(content, -1)
 
 		for i, match := range matches {
 			if len(match) >= 3 {
@@ -316,13 +363,11 @@ func parseJavaScript(content string, lines []string) ([]JSFunction, error) {
 				}
 
 				// 화살표 함수 여부
-				if strings.Contains(pattern, "=>") {
-					function.IsArrow = true
-				}
+				function.IsArrow = pattern.isArrow
 
 				// 라인 번호 계산
 				if i < len(indices) {
-					function.Line = getLineNumber(content, indices[i][0])
+					function.Line = lineAt(offsets, indices[i][0])
 				}
 
 				functions = append(functions, function)
@@ -333,14 +378,15 @@ func parseJavaScript(content string, lines []string) ([]JSFunction, error) {
 	return functions, nil
 }
 
-// parseHTML HTML 파일 파싱
-func parseHTML(content string, lines []string) (map[string]interface{}, error) {
+// parseHTML HTML 파일 파싱 (Thymeleaf/JSP 템플릿이면 template에 엔진 정보 기록)
+func parseHTML(filePath, content string, lines []string) (map[string]interface{}, error) {
 	result := make(map[string]interface{})
 	
 	// 기본적인 HTML 요소 추출
 	result["images"] = extractHTMLImages(content)
 	result["forms"] = extractHTMLForms(content)
 	result["scripts"] = extractHTMLScripts(content)
+	result["template"] = DetectTemplate(filePath, content)
 	
 	return result, nil
 }
@@ -358,23 +404,22 @@ func parseCSS(content string, lines []string) (map[string]interface{}, error) {
 // 헬퍼 함수들
 func extractHTMLImages(content string) []map[string]string {
 	var images []map[string]string
-	imgRegex := regexp.MustCompile(`<img[^>]*>`)
-	matches := imgRegex.FindAllString(content, -1)
+	matches := htmlImgRegex.FindAllString(content, -1)
 	
 	for _, match := range matches {
 		img := make(map[string]string)
 		img["tag"] = match
 		
 		// src 속성 추출
-		srcRegex := regexp.MustCompile(`src\s*=\s*["']([^"']*)["']`)
-		if srcMatch := srcRegex.FindStringSubmatch(match); len(srcMatch) > 1 {
+		if srcMatch := htmlSrcRegex.FindStringSubmatch(match); len(srcMatch) > 1 {
 			img["src"] = srcMatch[1]
 		}
 		
-		// alt 속성 추출
-		altRegex := regexp.MustCompile(`alt\s*=\s*["']([^"']*)["']`)
-		if altMatch := altRegex.FindStringSubmatch(match); len(altMatch) > 1 {
+		// alt 속성 추출 (th:attr="alt=#{...}"로 지정한 값은 표현식을 그대로 기록)
+		if altMatch := htmlAltRegex.FindStringSubmatch(match); len(altMatch) > 1 {
 			img["alt"] = altMatch[1]
+		} else if attrMatch := thymeleafAltAttrRegex.FindStringSubmatch(match); len(attrMatch) > 1 {
+			img["alt"] = attrMatch[1]
 		}
 		
 		images = append(images, img)
@@ -384,18 +429,15 @@ func extractHTMLImages(content string) []map[string]string {
 }
 
 func extractHTMLForms(content string) []string {
-	formRegex := regexp.MustCompile(`<form[^>]*>`)
-	return formRegex.FindAllString(content, -1)
+	return htmlFormRegex.FindAllString(content, -1)
 }
 
 func extractHTMLScripts(content string) []string {
-	scriptRegex := regexp.MustCompile(`<script[^>]*>[\s\S]*?</script>`)
-	return scriptRegex.FindAllString(content, -1)
+	return htmlScriptRegex.FindAllString(content, -1)
 }
 
 func extractCSSSelectors(content string) []string {
-	selectorRegex := regexp.MustCompile(`([^{}]+)\s*\{`)
-	matches := selectorRegex.FindAllStringSubmatch(content, -1)
+	matches := cssSelectorRegex.FindAllStringSubmatch(content, -1)
 	
 	var selectors []string
 	for _, match := range matches {
@@ -428,9 +470,4 @@ func tokenizeText(content string) []Token {
 	}
 	
 	return tokens
-}
-
-// getLineNumber 위치에서 라인 번호 계산
-func getLineNumber(content string, pos int) int {
-	return strings.Count(content[:pos], "\n") + 1
 }
\ No newline at end of file
@@ -0,0 +1,50 @@
+package parser
+
+import (
+	"sort"
+	"strings"
+)
+
+// computeLineOffsets 각 라인의 시작 위치(바이트 오프셋) 테이블 생성
+func computeLineOffsets(content string) []int {
+	offsets := make([]int, 1, strings.Count(content, "\n")+1)
+	for i := 0; i < len(content); i++ {
+		if content[i] == '\n' {
+			offsets = append(offsets, i+1)
+		}
+	}
+	return offsets
+}
+
+// lineAt 오프셋 테이블에서 위치가 속한 라인 번호(1부터 시작) 검색
+func lineAt(offsets []int, pos int) int {
+	return sort.Search(len(offsets), func(i int) bool { return offsets[i] > pos })
+}
+
+// lineOffsets 라인 오프셋 테이블 반환 (없으면 생성)
+func (f *ParsedFile) lineOffsets() []int {
+	if f.LineOffsets == nil {
+		f.LineOffsets = computeLineOffsets(f.Content)
+	}
+	return f.LineOffsets
+}
+
+// LineAt 위치(바이트 오프셋)의 라인 번호 반환 (1부터 시작)
+func (f *ParsedFile) LineAt(pos int) int {
+	return lineAt(f.lineOffsets(), pos)
+}
+
+// ColumnAt 위치(바이트 오프셋)의 컬럼 번호 반환 (1부터 시작)
+func (f *ParsedFile) ColumnAt(pos int) int {
+	offsets := f.lineOffsets()
+	return pos - offsets[lineAt(offsets, pos)-1] + 1
+}
+
+// LineStart 라인의 시작 위치(바이트 오프셋) 반환, 범위를 벗어나면 -1
+func (f *ParsedFile) LineStart(line int) int {
+	offsets := f.lineOffsets()
+	if line <= 0 || line > len(offsets) {
+		return -1
+	}
+	return offsets[line-1]
+}
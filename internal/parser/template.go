@@ -0,0 +1,54 @@
+package parser
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// 서버 사이드 템플릿 엔진
+const (
+	TemplateThymeleaf = "thymeleaf"
+	TemplateJSP       = "jsp"
+)
+
+// 템플릿 인식에 사용하는 정규식
+var (
+	thymeleafAttrRegex = regexp.MustCompile(`\s(?:th|data-th)[:-][\w-]+\s*=|xmlns:th\s*=`)
+	jspDirectiveRegex  = regexp.MustCompile(`<%[@=!]?|<(?:c|fmt|fn|spring|form|tiles|jsp):\w+`)
+	htmlRootRegex      = regexp.MustCompile(`(?i)<html\b`)
+	// th:attr="alt=#{...}"로 지정한 img 대체 텍스트
+	thymeleafAltAttrRegex = regexp.MustCompile(`th:attr\s*=\s*["'][^"']*\balt\s*=\s*([^,"']+)`)
+	// 다른 페이지에 포함되는 조각 (Thymeleaf 프래그먼트)
+	thymeleafFragmentRegex = regexp.MustCompile(`\s(?:th:fragment|data-th-fragment)\s*=`)
+	// 공통 레이아웃이나 다른 파일에서 head/본문 골격을 가져오는 페이지
+	layoutRegex = regexp.MustCompile(`(?i)layout:decorat(?:e|or)\s*=|data-layout-decorate\s*=|<head\b[^>]*\s(?:th|data-th)[:-](?:replace|insert|include)\s*=|<%@\s*include\b|<jsp:include\b|<c:import\b|<tiles:insert\w*`)
+)
+
+// HTMLTemplate 서버 사이드 템플릿 정보 (일반 HTML이면 Engine이 비어있음)
+type HTMLTemplate struct {
+	Engine    string // thymeleaf, jsp
+	Fragment  bool   // 다른 페이지에 포함되는 조각 (title/h1 등 페이지 단위 요소가 없는 것이 정상)
+	Decorated bool   // 공통 레이아웃이나 include로 head/골격을 가져오는 페이지
+}
+
+// DetectTemplate 확장자와 내용으로 Thymeleaf/JSP 템플릿 여부 판단
+func DetectTemplate(filePath, content string) HTMLTemplate {
+	var template HTMLTemplate
+
+	ext := strings.ToLower(filepath.Ext(filePath))
+	switch {
+	case ext == ".jsp" || ext == ".jspf" || jspDirectiveRegex.MatchString(content):
+		template.Engine = TemplateJSP
+		// .jspf와 <html> 없는 JSP는 다른 페이지에 include되는 조각
+		template.Fragment = ext == ".jspf" || !htmlRootRegex.MatchString(content)
+	case thymeleafAttrRegex.MatchString(content):
+		template.Engine = TemplateThymeleaf
+		template.Fragment = thymeleafFragmentRegex.MatchString(content) && !strings.Contains(content, "<title")
+	default:
+		return template
+	}
+
+	template.Decorated = layoutRegex.MatchString(content)
+	return template
+}
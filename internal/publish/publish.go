@@ -0,0 +1,268 @@
+package publish
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/config"
+)
+
+// 기본 자격 증명 환경 변수
+const (
+	defaultAccessKeyEnv = "AWS_ACCESS_KEY_ID"
+	defaultSecretKeyEnv = "AWS_SECRET_ACCESS_KEY"
+	defaultSessionEnv   = "AWS_SESSION_TOKEN"
+)
+
+// LatestPrefix 가장 최근 리포트를 가리키는 별칭 경로
+const LatestPrefix = "latest"
+
+// Artifact 업로드할 리포트
+type Artifact struct {
+	Name        string // 버킷에 저장될 파일명
+	ContentType string
+	Data        []byte
+}
+
+// Publisher S3/GCS 버킷 업로더 (GCS는 HMAC 키로 S3 호환 XML API 사용)
+type Publisher struct {
+	config    config.PublishConfig
+	accessKey string
+	secretKey string
+	session   string // 임시 자격 증명의 세션 토큰 (없으면 빈 문자열)
+	client    *http.Client
+	now       func() time.Time
+}
+
+// New 설정으로 업로더 생성 (자격 증명은 환경 변수에서 읽음)
+func New(cfg config.PublishConfig) (*Publisher, error) {
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("publish.bucket이 지정되지 않았습니다")
+	}
+	if cfg.Provider != "s3" && cfg.Provider != "gcs" {
+		return nil, fmt.Errorf("지원하지 않는 저장소: %s (s3/gcs)", cfg.Provider)
+	}
+
+	accessKeyEnv := cfg.AccessKeyEnv
+	if accessKeyEnv == "" {
+		accessKeyEnv = defaultAccessKeyEnv
+	}
+	secretKeyEnv := cfg.SecretKeyEnv
+	if secretKeyEnv == "" {
+		secretKeyEnv = defaultSecretKeyEnv
+	}
+	sessionEnv := cfg.SessionTokenEnv
+	if sessionEnv == "" {
+		sessionEnv = defaultSessionEnv
+	}
+
+	p := &Publisher{
+		config:    cfg,
+		accessKey: os.Getenv(accessKeyEnv),
+		secretKey: os.Getenv(secretKeyEnv),
+		session:   os.Getenv(sessionEnv),
+		client:    &http.Client{Timeout: 60 * time.Second},
+		now:       time.Now,
+	}
+	if p.accessKey == "" || p.secretKey == "" {
+		return nil, fmt.Errorf("자격 증명 환경 변수(%s, %s)가 설정되지 않았습니다", accessKeyEnv, secretKeyEnv)
+	}
+	return p, nil
+}
+
+// Publish 리포트를 <prefix>/<타임스탬프>/ 아래에 올리고 <prefix>/latest/에도 복사, 업로드한 키 목록 반환
+func (p *Publisher) Publish(artifacts []Artifact) ([]string, error) {
+	stamp := p.now().UTC().Format("20060102T150405Z")
+
+	var keys []string
+	for _, dir := range []string{stamp, LatestPrefix} {
+		for _, artifact := range artifacts {
+			key := path.Join(p.config.Prefix, dir, artifact.Name)
+			if err := p.put(key, artifact); err != nil {
+				return keys, fmt.Errorf("%s 업로드 실패: %w", key, err)
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// put 객체 업로드 (AWS Signature V4 서명)
+func (p *Publisher) put(key string, artifact Artifact) error {
+	objectURL := p.objectURL(key)
+	req, err := http.NewRequest(http.MethodPut, objectURL.String(), bytes.NewReader(artifact.Data))
+	if err != nil {
+		return err
+	}
+
+	payloadHash := sha256Hex(artifact.Data)
+	req.Header.Set("Content-Type", artifact.ContentType)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("X-Amz-Date", p.now().UTC().Format("20060102T150405Z"))
+	if p.session != "" {
+		// 서명 전에 설정해야 서명 대상 헤더에 포함됨
+		req.Header.Set("X-Amz-Security-Token", p.session)
+	}
+	req.Header.Set("Authorization", signV4(req, payloadHash, p.region(), p.accessKey, p.secretKey))
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// region 서명에 사용할 리전 (GCS는 "auto")
+func (p *Publisher) region() string {
+	if p.config.Region != "" {
+		return p.config.Region
+	}
+	if p.config.Provider == "gcs" {
+		return "auto"
+	}
+	return "us-east-1"
+}
+
+// objectURL 객체 URL (S3는 가상 호스트 방식, GCS와 사용자 지정 엔드포인트는 경로 방식)
+func (p *Publisher) objectURL(key string) *url.URL {
+	u := &url.URL{Scheme: "https"}
+
+	switch {
+	case p.config.Endpoint != "":
+		if parsed, err := url.Parse(p.config.Endpoint); err == nil && parsed.Host != "" {
+			u.Scheme, u.Host = parsed.Scheme, parsed.Host
+		} else {
+			u.Host = p.config.Endpoint
+		}
+		u.Path = "/" + p.config.Bucket + "/" + key
+	case p.config.Provider == "gcs":
+		u.Host = "storage.googleapis.com"
+		u.Path = "/" + p.config.Bucket + "/" + key
+	default:
+		u.Host = fmt.Sprintf("%s.s3.%s.amazonaws.com", p.config.Bucket, p.region())
+		u.Path = "/" + key
+	}
+
+	u.RawPath = encodePath(u.Path)
+	return u
+}
+
+// signV4 AWS Signature Version 4 Authorization 헤더 값 계산 (S3 서비스)
+func signV4(req *http.Request, payloadHash, region, accessKey, secretKey string) string {
+	amzDate := req.Header.Get("X-Amz-Date")
+	date := amzDate[:8]
+
+	// 서명할 헤더 (host + 요청 헤더, 소문자 정렬)
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		encodePath(req.URL.Path),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+secretKey), date)
+	key = hmacSHA256(key, region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	return fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature)
+}
+
+// canonicalQuery 정렬된 쿼리 문자열
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var parts []string
+	for _, key := range keys {
+		values := query[key]
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// encodePath 경로의 각 구간을 RFC 3986 방식으로 인코딩 ('/'는 유지)
+func encodePath(p string) string {
+	segments := strings.Split(p, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// uriEncode RFC 3986 비예약 문자를 제외하고 퍼센트 인코딩
+func uriEncode(s string) string {
+	var encoded strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'A' && c <= 'Z') || (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			encoded.WriteByte(c)
+		} else {
+			fmt.Fprintf(&encoded, "%%%02X", c)
+		}
+	}
+	return encoded.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,306 @@
+package queries
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"code-quality-checker/internal/parser"
+)
+
+// 쿼리 출처
+const (
+	SourceJava    = "java"
+	SourceMyBatis = "mybatis"
+)
+
+var (
+	// 문자열 리터럴 (+로 이어진 리터럴은 하나로 합침)
+	javaStringRegex    = regexp.MustCompile(`"(?:[^"\\\n]|\\.)*"(?:\s*\+\s*"(?:[^"\\\n]|\\.)*")*`)
+	javaTextBlockRegex = regexp.MustCompile(`(?s)"""(.*?)"""`)
+	literalPartRegex   = regexp.MustCompile(`"((?:[^"\\\n]|\\.)*)"`)
+
+	sqlStartRegex  = regexp.MustCompile(`(?i)^\s*(SELECT|INSERT|UPDATE|DELETE|MERGE|WITH)\b`)
+	sqlClauseRegex = regexp.MustCompile(`(?i)\b(FROM|INTO|SET|VALUES|WHERE)\b`)
+
+	mapperNamespaceRegex = regexp.MustCompile(`<mapper\s+[^>]*namespace\s*=\s*"([^"]+)"`)
+	mapperStatementRegex = regexp.MustCompile(`(?is)<(select|insert|update|delete)\b([^>]*)>(.*?)</(?:select|insert|update|delete)>`)
+	mapperIDRegex        = regexp.MustCompile(`\bid\s*=\s*"([^"]+)"`)
+	xmlTagRegex          = regexp.MustCompile(`(?s)<!\[CDATA\[|\]\]>|<[^>]+>`)
+	whitespaceRegex      = regexp.MustCompile(`\s+`)
+
+	tableRegex     = regexp.MustCompile(`(?i)\b(?:JOIN|INTO|UPDATE)\s+([A-Za-z_][\w.]*)`)
+	fromRegex      = regexp.MustCompile(`(?i)\bFROM\s+(.+?)(?:\bWHERE\b|\bGROUP\b|\bORDER\b|\bHAVING\b|\bLIMIT\b|\b(?:INNER|LEFT|RIGHT|FULL|CROSS|OUTER)\b|\bJOIN\b|\bUNION\b|\)|;|$)`)
+	joinRegex      = regexp.MustCompile(`(?i)\bJOIN\b`)
+	subqueryRegex  = regexp.MustCompile(`(?i)\(\s*SELECT\b`)
+	unionRegex     = regexp.MustCompile(`(?i)\bUNION\b`)
+	groupingRegex  = regexp.MustCompile(`(?i)\bGROUP\s+BY\b|\bHAVING\b`)
+	conditionRegex = regexp.MustCompile(`(?i)\b(?:AND|OR)\b`)
+)
+
+// Statement 코드에서 찾은 SQL 문
+type Statement struct {
+	Source     string   `json:"source"` // java 또는 mybatis
+	File       string   `json:"file"`
+	Line       int      `json:"line"`
+	Name       string   `json:"name,omitempty"` // MyBatis 매퍼 namespace.id
+	Type       string   `json:"type"`           // SELECT, INSERT, UPDATE, DELETE, ...
+	Tables     []string `json:"tables"`
+	Joins      int      `json:"joins"` // 명시적 JOIN과 FROM 절의 쉼표 조인
+	Subqueries int      `json:"subqueries"`
+	Complexity int      `json:"complexity"`
+	SQL        string   `json:"sql"` // 공백을 정리한 SQL
+}
+
+// TableUsage 테이블별 사용 횟수
+type TableUsage struct {
+	Table      string `json:"table"`
+	Statements int    `json:"statements"`
+}
+
+// Inventory SQL 사용 목록 (복잡도 높은 순)
+type Inventory struct {
+	Statements []Statement  `json:"statements"`
+	Tables     []TableUsage `json:"tables"`
+}
+
+// Collector 분석 중 파싱된 Java 파일에서 SQL 수집 (analyzer.Hook의 OnFileParsed로 사용)
+type Collector struct {
+	mu         sync.Mutex
+	statements []Statement
+}
+
+// Add 파싱된 Java 파일의 문자열 리터럴에서 SQL 추가
+func (c *Collector) Add(file *parser.ParsedFile) {
+	if file.Language != "java" {
+		return
+	}
+	found := ExtractJava(file)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.statements = append(c.statements, found...)
+}
+
+// AddMappers 경로 아래의 MyBatis 매퍼 XML에서 SQL 추가
+func (c *Collector) AddMappers(root string) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "node_modules" || name == "target" || name == "build") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.EqualFold(filepath.Ext(path), ".xml") {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		found := ExtractMapper(path, string(data))
+		c.mu.Lock()
+		c.statements = append(c.statements, found...)
+		c.mu.Unlock()
+		return nil
+	})
+}
+
+// Inventory 수집한 SQL을 복잡도 높은 순으로 정렬하고 테이블별 사용 횟수 집계
+func (c *Collector) Inventory() *Inventory {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	inventory := &Inventory{Statements: append([]Statement{}, c.statements...)}
+	sort.SliceStable(inventory.Statements, func(i, j int) bool {
+		return inventory.Statements[i].Complexity > inventory.Statements[j].Complexity
+	})
+
+	counts := make(map[string]int)
+	for _, statement := range inventory.Statements {
+		for _, table := range statement.Tables {
+			counts[table]++
+		}
+	}
+	for table, count := range counts {
+		inventory.Tables = append(inventory.Tables, TableUsage{Table: table, Statements: count})
+	}
+	sort.Slice(inventory.Tables, func(i, j int) bool {
+		a, b := inventory.Tables[i], inventory.Tables[j]
+		if a.Statements != b.Statements {
+			return a.Statements > b.Statements
+		}
+		return a.Table < b.Table
+	})
+	return inventory
+}
+
+// ExtractJava Java 문자열 리터럴(+ 연결, 텍스트 블록 포함)에서 SQL 추출
+func ExtractJava(file *parser.ParsedFile) []Statement {
+	var statements []Statement
+
+	for _, match := range javaStringRegex.FindAllStringIndex(file.Content, -1) {
+		var text strings.Builder
+		for _, part := range literalPartRegex.FindAllStringSubmatch(file.Content[match[0]:match[1]], -1) {
+			text.WriteString(part[1])
+		}
+		if statement, ok := analyze(text.String()); ok {
+			statement.Source = SourceJava
+			statement.File = file.Path
+			statement.Line = file.LineAt(match[0])
+			statements = append(statements, statement)
+		}
+	}
+
+	for _, match := range javaTextBlockRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		if statement, ok := analyze(file.Content[match[2]:match[3]]); ok {
+			statement.Source = SourceJava
+			statement.File = file.Path
+			statement.Line = file.LineAt(match[0])
+			statements = append(statements, statement)
+		}
+	}
+	return statements
+}
+
+// ExtractMapper MyBatis 매퍼 XML의 select/insert/update/delete 문 추출 (동적 SQL 태그는 내용만 남김)
+func ExtractMapper(path, content string) []Statement {
+	namespace := mapperNamespaceRegex.FindStringSubmatch(content)
+	if namespace == nil {
+		return nil
+	}
+
+	var statements []Statement
+	for _, match := range mapperStatementRegex.FindAllStringSubmatchIndex(content, -1) {
+		body := xmlTagRegex.ReplaceAllString(content[match[6]:match[7]], " ")
+		statement, ok := analyze(body)
+		if !ok {
+			continue
+		}
+
+		statement.Source = SourceMyBatis
+		statement.File = path
+		statement.Line = strings.Count(content[:match[0]], "\n") + 1
+		statement.Name = namespace[1]
+		if id := mapperIDRegex.FindStringSubmatch(content[match[4]:match[5]]); id != nil {
+			statement.Name += "." + id[1]
+		}
+		statements = append(statements, statement)
+	}
+	return statements
+}
+
+// analyze SQL 문이면 종류, 테이블, 조인 수, 복잡도 계산
+// 복잡도 = 테이블 수 + 조인×2 + 서브쿼리×3 + UNION×2 + GROUP BY/HAVING + AND/OR 조건÷2
+func analyze(text string) (Statement, bool) {
+	sql := strings.TrimSpace(whitespaceRegex.ReplaceAllString(strings.NewReplacer(`\n`, " ", `\t`, " ").Replace(text), " "))
+	start := sqlStartRegex.FindStringSubmatch(sql)
+	if start == nil || !sqlClauseRegex.MatchString(sql) {
+		return Statement{}, false
+	}
+
+	tables := make(map[string]bool)
+	var tableList []string
+	addTable := func(name string) {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name != "" && !tables[name] {
+			tables[name] = true
+			tableList = append(tableList, name)
+		}
+	}
+
+	commaJoins := 0
+	for _, from := range fromRegex.FindAllStringSubmatch(sql, -1) {
+		items := strings.Split(from[1], ",")
+		commaJoins += len(items) - 1
+		for _, item := range items {
+			if fields := strings.Fields(item); len(fields) > 0 && !strings.HasPrefix(fields[0], "(") {
+				addTable(fields[0])
+			}
+		}
+	}
+	for _, match := range tableRegex.FindAllStringSubmatch(sql, -1) {
+		addTable(match[1])
+	}
+	sort.Strings(tableList)
+
+	statement := Statement{
+		Type:       strings.ToUpper(start[1]),
+		Tables:     tableList,
+		Joins:      len(joinRegex.FindAllString(sql, -1)) + commaJoins,
+		Subqueries: len(subqueryRegex.FindAllString(sql, -1)),
+		SQL:        sql,
+	}
+	statement.Complexity = len(tableList) + statement.Joins*2 + statement.Subqueries*3 +
+		len(unionRegex.FindAllString(sql, -1))*2 + len(groupingRegex.FindAllString(sql, -1)) +
+		len(conditionRegex.FindAllString(sql, -1))/2
+	return statement, true
+}
+
+// WriteJSON SQL 목록을 JSON으로 출력
+func WriteJSON(w io.Writer, inventory *Inventory) error {
+	data, err := json.MarshalIndent(inventory, "", "  ")
+	if err != nil {
+		return fmt.Errorf("JSON 마샬링 실패: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// WriteConsole 복잡도 상위 top개 쿼리와 테이블별 사용 횟수 출력
+func WriteConsole(w io.Writer, inventory *Inventory, top int) error {
+	var output strings.Builder
+
+	output.WriteString("🗄️  SQL 사용 목록\n")
+	output.WriteString(strings.Repeat("=", 50) + "\n\n")
+	output.WriteString(fmt.Sprintf("SQL 문: %d개, 테이블: %d개\n\n", len(inventory.Statements), len(inventory.Tables)))
+
+	if len(inventory.Statements) > 0 {
+		output.WriteString(fmt.Sprintf("🔝 복잡도 상위 쿼리 (최대 %d개)\n", top))
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for i, statement := range inventory.Statements {
+			if i >= top {
+				break
+			}
+			location := fmt.Sprintf("%s:%d", statement.File, statement.Line)
+			if statement.Name != "" {
+				location += " (" + statement.Name + ")"
+			}
+			output.WriteString(fmt.Sprintf("  [%d] %s %s\n", statement.Complexity, statement.Type, location))
+			output.WriteString(fmt.Sprintf("     테이블 %d개 (%s), 조인 %d, 서브쿼리 %d\n",
+				len(statement.Tables), strings.Join(statement.Tables, ", "), statement.Joins, statement.Subqueries))
+			output.WriteString(fmt.Sprintf("     📋 %s\n", truncate(statement.SQL, 120)))
+		}
+		output.WriteString("\n")
+	}
+
+	if len(inventory.Tables) > 0 {
+		output.WriteString("📂 테이블별 사용 횟수\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for _, usage := range inventory.Tables {
+			output.WriteString(fmt.Sprintf("  %s: %d개 쿼리\n", usage.Table, usage.Statements))
+		}
+	}
+
+	_, err := io.WriteString(w, output.String())
+	return err
+}
+
+// truncate 긴 SQL을 max자(룬 기준)로 자르기
+func truncate(text string, max int) string {
+	runes := []rune(text)
+	if len(runes) <= max {
+		return text
+	}
+	return string(runes[:max]) + "..."
+}
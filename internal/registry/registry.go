@@ -0,0 +1,263 @@
+package registry
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/config"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Index 레지스트리 팩 목록 (index.yaml)
+type Index struct {
+	Packs []IndexPack `yaml:"packs"`
+}
+
+// IndexPack 레지스트리에 등록된 팩
+type IndexPack struct {
+	Name        string         `yaml:"name"`
+	Description string         `yaml:"description,omitempty"`
+	Versions    []IndexVersion `yaml:"versions"`
+}
+
+// IndexVersion 팩의 배포 버전
+type IndexVersion struct {
+	Version string `yaml:"version"`
+	URL     string `yaml:"url"` // 절대 URL 또는 레지스트리 기준 상대 경로
+	SHA256  string `yaml:"sha256,omitempty"`
+}
+
+// Client 규칙 레지스트리 클라이언트
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient 새로운 레지스트리 클라이언트 생성
+func NewClient(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// FetchIndex 레지스트리 팩 목록 조회
+func (c *Client) FetchIndex() (*Index, error) {
+	data, err := c.get(c.BaseURL + "/index.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("레지스트리 목록 조회 실패: %w", err)
+	}
+
+	var index Index
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("레지스트리 목록 파싱 실패: %w", err)
+	}
+	return &index, nil
+}
+
+// Sync 고정된 팩을 다운로드하여 dir에 저장하고 잠금 파일 갱신
+func (c *Client) Sync(pins []config.PackPin, dir string) (*config.LockFile, error) {
+	index, err := c.FetchIndex()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, "plugins"), 0755); err != nil {
+		return nil, fmt.Errorf("팩 디렉토리 생성 실패: %w", err)
+	}
+
+	lock := &config.LockFile{
+		Registry: c.BaseURL,
+		SyncedAt: time.Now(),
+	}
+
+	for _, pin := range pins {
+		entry, err := c.syncPack(index, pin, dir)
+		if err != nil {
+			return nil, err
+		}
+		lock.Packs = append(lock.Packs, *entry)
+	}
+
+	data, err := yaml.Marshal(lock)
+	if err != nil {
+		return nil, fmt.Errorf("잠금 파일 생성 실패: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, config.LockFileName), data, 0644); err != nil {
+		return nil, fmt.Errorf("잠금 파일 저장 실패: %w", err)
+	}
+
+	return lock, nil
+}
+
+// syncPack 개별 팩 다운로드 및 검증
+func (c *Client) syncPack(index *Index, pin config.PackPin, dir string) (*config.LockEntry, error) {
+	if err := validatePackName(pin.Name); err != nil {
+		return nil, fmt.Errorf("규칙 팩 이름 오류: %w", err)
+	}
+	version, err := resolveVersion(index, pin)
+	if err != nil {
+		return nil, err
+	}
+	// 승인된 팩만 받도록 레지스트리 목록에 체크섬이 없으면 거부
+	if version.SHA256 == "" {
+		return nil, fmt.Errorf("규칙 팩 '%s@%s'의 sha256이 레지스트리 목록에 없습니다", pin.Name, version.Version)
+	}
+
+	packURL := c.resolveURL(version.URL)
+	data, err := c.get(packURL)
+	if err != nil {
+		return nil, fmt.Errorf("규칙 팩 '%s' 다운로드 실패: %w", pin.Name, err)
+	}
+
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(version.SHA256, checksum) {
+		return nil, fmt.Errorf("규칙 팩 '%s' 체크섬 불일치 (기대: %s, 실제: %s)", pin.Name, version.SHA256, checksum)
+	}
+
+	var pack config.RulePack
+	if err := yaml.Unmarshal(data, &pack); err != nil {
+		return nil, fmt.Errorf("규칙 팩 '%s' 파싱 실패: %w", pin.Name, err)
+	}
+	if pack.Name != pin.Name || pack.Version != version.Version {
+		return nil, fmt.Errorf("규칙 팩 메타데이터 불일치: %s@%s (기대: %s@%s)", pack.Name, pack.Version, pin.Name, version.Version)
+	}
+	if err := ValidatePack(&pack); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, pin.Name+".yaml"), data, 0644); err != nil {
+		return nil, fmt.Errorf("규칙 팩 저장 실패: %w", err)
+	}
+
+	entry := &config.LockEntry{
+		Name:    pack.Name,
+		Version: pack.Version,
+		SHA256:  checksum,
+		URL:     packURL,
+	}
+
+	// 플러그인 매니페스트 저장
+	for _, plugin := range pack.Plugins {
+		if err := validatePackName(plugin.Name); err != nil {
+			return nil, fmt.Errorf("규칙 팩 '%s'의 플러그인 이름 오류: %w", pin.Name, err)
+		}
+		manifest, err := yaml.Marshal(plugin)
+		if err != nil {
+			return nil, fmt.Errorf("플러그인 매니페스트 생성 실패: %w", err)
+		}
+		path := filepath.Join(dir, "plugins", plugin.Name+".yaml")
+		if err := os.WriteFile(path, manifest, 0644); err != nil {
+			return nil, fmt.Errorf("플러그인 매니페스트 저장 실패: %w", err)
+		}
+		entry.Plugins = append(entry.Plugins, plugin.Name+"@"+plugin.Version)
+	}
+
+	return entry, nil
+}
+
+// validatePackName 파일명으로 쓰는 팩/플러그인 이름이 저장 디렉토리를 벗어나지 않는지 확인
+func validatePackName(name string) error {
+	if name == "" || name == "." || strings.Contains(name, "..") || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("'%s'는 사용할 수 없는 이름입니다 (경로 구분자나 '..' 불가)", name)
+	}
+	return nil
+}
+
+// ValidatePack 팩 규칙의 정규식 패턴 검증
+func ValidatePack(pack *config.RulePack) error {
+	for _, langRules := range pack.Languages {
+		for _, rule := range langRules.Rules {
+			if rule.Pattern.Type != "regex" {
+				continue
+			}
+			if _, err := regexp.Compile(rule.Pattern.Regex); err != nil {
+				return fmt.Errorf("규칙 팩 '%s'의 규칙 '%s' 정규식 오류: %w", pack.Name, rule.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// resolveVersion 고정 버전 또는 최신 버전 선택
+func resolveVersion(index *Index, pin config.PackPin) (*IndexVersion, error) {
+	for _, pack := range index.Packs {
+		if pack.Name != pin.Name {
+			continue
+		}
+		if len(pack.Versions) == 0 {
+			return nil, fmt.Errorf("규칙 팩 '%s'에 배포된 버전이 없습니다", pin.Name)
+		}
+
+		if pin.Version == "" || pin.Version == "latest" {
+			latest := &pack.Versions[0]
+			for i := range pack.Versions {
+				if compareVersions(pack.Versions[i].Version, latest.Version) > 0 {
+					latest = &pack.Versions[i]
+				}
+			}
+			return latest, nil
+		}
+
+		for i := range pack.Versions {
+			if pack.Versions[i].Version == pin.Version {
+				return &pack.Versions[i], nil
+			}
+		}
+		return nil, fmt.Errorf("규칙 팩 '%s'의 버전 %s를 찾을 수 없습니다", pin.Name, pin.Version)
+	}
+	return nil, fmt.Errorf("레지스트리에 규칙 팩 '%s'가 없습니다", pin.Name)
+}
+
+// compareVersions 점으로 구분된 버전 비교 (a > b 이면 양수)
+func compareVersions(a, b string) int {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum != bNum {
+			return aNum - bNum
+		}
+	}
+	return 0
+}
+
+// resolveURL 상대 경로를 레지스트리 기준 URL로 변환
+func (c *Client) resolveURL(ref string) string {
+	if u, err := url.Parse(ref); err == nil && u.IsAbs() {
+		return ref
+	}
+	return c.BaseURL + "/" + strings.TrimLeft(ref, "/")
+}
+
+func (c *Client) get(target string) ([]byte, error) {
+	resp, err := c.HTTPClient.Get(target)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: HTTP %d", target, resp.StatusCode)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,52 @@
+package reporter
+
+import (
+	"sort"
+
+	"code-quality-checker/internal/types"
+)
+
+// chartItem 차트 항목 (HTML 리포트 스크립트에 JSON으로 삽입)
+type chartItem struct {
+	Label string `json:"label"`
+	Value int    `json:"value"`
+}
+
+// chartData HTML 리포트 차트 데이터 (브라우저에서 SVG로 그림)
+type chartData struct {
+	Severity []chartItem `json:"severity"` // 심각도 높은 순 (도넛 차트)
+	Category []chartItem `json:"category"` // 이슈가 많은 순 (막대 차트)
+	Files    []chartItem `json:"files"`    // 이슈가 많은 파일 상위 10개 (막대 차트)
+}
+
+// newChartData 분석 결과로 차트 데이터 구성 (이슈가 없으면 nil)
+func newChartData(result *types.AnalysisResult) *chartData {
+	if len(result.Issues) == 0 {
+		return nil
+	}
+
+	data := &chartData{}
+	for _, severity := range severityOrder {
+		if count := result.Summary.SeverityCount[severity]; count > 0 {
+			data.Severity = append(data.Severity, chartItem{Label: severity.String(), Value: count})
+		}
+	}
+
+	for category, count := range result.Summary.CategoryCount {
+		if count > 0 {
+			data.Category = append(data.Category, chartItem{Label: category, Value: count})
+		}
+	}
+	sort.Slice(data.Category, func(i, j int) bool {
+		if data.Category[i].Value != data.Category[j].Value {
+			return data.Category[i].Value > data.Category[j].Value
+		}
+		return data.Category[i].Label < data.Category[j].Label
+	})
+
+	for _, file := range topFiles(result.Issues) {
+		data.Files = append(data.Files, chartItem{Label: file.Key, Value: file.Count})
+	}
+
+	return data
+}
@@ -0,0 +1,161 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// Code Climate 엔진 명세의 이슈 (엔진은 이슈마다 JSON 문서 하나를 NUL 문자로 끝내 출력)
+type codeClimateIssue struct {
+	Type              string              `json:"type"`
+	CheckName         string              `json:"check_name"`
+	Description       string              `json:"description"`
+	Content           *codeClimateContent `json:"content,omitempty"`
+	Categories        []string            `json:"categories"`
+	Location          codeClimateLocation `json:"location"`
+	RemediationPoints int                 `json:"remediation_points"`
+	Severity          string              `json:"severity"`
+	Fingerprint       string              `json:"fingerprint"`
+}
+
+type codeClimateContent struct {
+	Body string `json:"body"`
+}
+
+type codeClimateLocation struct {
+	Path  string           `json:"path"`
+	Lines codeClimateLines `json:"lines"`
+}
+
+type codeClimateLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end"`
+}
+
+// 카테고리별 Code Climate 분류 (없으면 Bug Risk)
+var codeClimateCategories = map[string]string{
+	"security":        "Security",
+	"performance":     "Performance",
+	"web-performance": "Performance",
+	"compatibility":   "Compatibility",
+	"modernization":   "Compatibility",
+	"responsive":      "Compatibility",
+	"style":           "Style",
+	"standards":       "Style",
+	"cleanup":         "Style",
+	"logging":         "Style",
+	"maintainability": "Clarity",
+	"architecture":    "Clarity",
+	"best-practices":  "Clarity",
+	"accessibility":   "Clarity",
+	"seo":             "Clarity",
+	"i18n":            "Clarity",
+}
+
+// 규칙 ID로 판단하는 분류 (카테고리보다 우선)
+var codeClimateRuleCategories = []struct {
+	contains string
+	category string
+}{
+	{"duplicate", "Duplication"},
+	{"complexity", "Complexity"},
+	{"length", "Complexity"},
+	{"callback-hell", "Complexity"},
+}
+
+// 심각도별 수정 비용 (Code Climate 기준 50,000점이 약 5분)
+var codeClimateRemediation = map[config.Severity]int{
+	config.SeverityCritical: 1000000,
+	config.SeverityHigh:     400000,
+	config.SeverityMedium:   150000,
+	config.SeverityLow:      50000,
+}
+
+// CodeClimateReporter Code Climate 엔진 JSON 출력 리포터 (Code Climate, Qlty 사용자 정의 엔진용)
+type CodeClimateReporter struct{}
+
+func (r *CodeClimateReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	var output strings.Builder
+	for _, issue := range result.Issues {
+		jsonData, err := json.Marshal(newCodeClimateIssue(issue))
+		if err != nil {
+			return fmt.Errorf("Code Climate 이슈 마샬링 실패: %w", err)
+		}
+		output.Write(jsonData)
+		output.WriteByte(0)
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(output.String()), 0644)
+	}
+	fmt.Print(output.String())
+	return nil
+}
+
+// newCodeClimateIssue 이슈를 Code Climate 이슈로 변환
+func newCodeClimateIssue(issue types.Issue) codeClimateIssue {
+	line := issue.Line
+	if line < 1 {
+		line = 1
+	}
+	entry := codeClimateIssue{
+		Type:              "issue",
+		CheckName:         issue.RuleID,
+		Description:       issue.Message,
+		Categories:        []string{codeClimateCategory(issue)},
+		RemediationPoints: codeClimateRemediation[issue.Severity],
+		Severity:          codeClimateSeverity(issue.Severity),
+		Fingerprint:       issueFingerprint(issue),
+		Location: codeClimateLocation{
+			Path:  filepath.ToSlash(workdirRelative(issue.File)),
+			Lines: codeClimateLines{Begin: line, End: line},
+		},
+	}
+
+	var body []string
+	if issue.Description != "" {
+		body = append(body, issue.Description)
+	}
+	if issue.Suggestion != "" {
+		body = append(body, i18n.T("markdown.suggestion-label", issue.Suggestion))
+	}
+	if len(body) > 0 {
+		entry.Content = &codeClimateContent{Body: strings.Join(body, "\n\n")}
+	}
+
+	return entry
+}
+
+// codeClimateCategory 이슈의 Code Climate 분류
+func codeClimateCategory(issue types.Issue) string {
+	for _, rule := range codeClimateRuleCategories {
+		if strings.Contains(issue.RuleID, rule.contains) {
+			return rule.category
+		}
+	}
+	if category, ok := codeClimateCategories[issue.Category]; ok {
+		return category
+	}
+	return "Bug Risk"
+}
+
+// codeClimateSeverity 심각도를 Code Climate 심각도로 변환 (GitLab Code Quality도 같은 값 사용)
+func codeClimateSeverity(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical:
+		return "critical"
+	case config.SeverityHigh:
+		return "major"
+	case config.SeverityMedium:
+		return "minor"
+	default:
+		return "info"
+	}
+}
@@ -0,0 +1,53 @@
+package reporter
+
+import (
+	"os"
+
+	"code-quality-checker/internal/config"
+)
+
+// ANSI 색상 코드 (콘솔 리포트)
+const (
+	colorReset    = "\033[0m"
+	colorCritical = "\033[1;31m" // 굵은 빨강
+	colorHigh     = "\033[31m"   // 빨강
+	colorMedium   = "\033[33m"   // 노랑
+	colorLow      = "\033[36m"   // 청록
+	colorPath     = "\033[34m"   // 파랑
+	colorRule     = "\033[35m"   // 자주
+)
+
+// ColorSupported 파일(보통 os.Stdout)에 ANSI 색상을 출력해도 되는지
+// NO_COLOR 환경 변수가 있거나(https://no-color.org), TERM=dumb이거나, 터미널이 아니면(파이프, 파일 리디렉션) false입니다
+func ColorSupported(file *os.File) bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	info, err := file.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// paint Color가 켜져 있으면 텍스트를 색상 코드로 감쌈
+func (r *ConsoleReporter) paint(color, text string) string {
+	if !r.Color || color == "" || text == "" {
+		return text
+	}
+	return color + text + colorReset
+}
+
+// severityColor 심각도별 색상
+func severityColor(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical:
+		return colorCritical
+	case config.SeverityHigh:
+		return colorHigh
+	case config.SeverityMedium:
+		return colorMedium
+	default:
+		return colorLow
+	}
+}
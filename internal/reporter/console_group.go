@@ -0,0 +1,126 @@
+package reporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// 콘솔 리포트 이슈 목록 그룹 기준
+const (
+	GroupBySeverity = "severity"
+	GroupByFile     = "file"
+	GroupByRule     = "rule"
+	GroupByCategory = "category"
+)
+
+// 콘솔 리포트 그룹 안 이슈 정렬 기준
+const (
+	SortByLine     = "line"     // 파일, 라인, 컬럼 순
+	SortBySeverity = "severity" // 심각도 높은 순 (같으면 파일, 라인 순)
+	SortByRule     = "rule"     // 규칙 ID 순 (같으면 파일, 라인 순)
+	SortByPriority = "priority" // 우선순위 점수 높은 순 (같으면 파일, 라인 순)
+)
+
+// DefaultGroupLimit 콘솔 리포트 그룹별 최대 표시 이슈 수 기본값
+const DefaultGroupLimit = 10
+
+// Validate 그룹/정렬 기준 확인 (분석 전에 잘못된 옵션을 알리기 위함)
+func (r *ConsoleReporter) Validate() error {
+	switch r.GroupBy {
+	case "", GroupBySeverity, GroupByFile, GroupByRule, GroupByCategory:
+	default:
+		return fmt.Errorf("지원하지 않는 그룹 기준: %s (severity/file/rule/category)", r.GroupBy)
+	}
+	switch r.SortBy {
+	case "", SortByLine, SortBySeverity, SortByRule, SortByPriority:
+	default:
+		return fmt.Errorf("지원하지 않는 정렬 기준: %s (line/severity/rule/priority)", r.SortBy)
+	}
+	if r.GroupLimit < 0 {
+		return fmt.Errorf("그룹별 최대 표시 수는 0 이상이어야 합니다: %d", r.GroupLimit)
+	}
+	return nil
+}
+
+// groupIssues 그룹 기준으로 이슈 분류 (Key는 그룹 제목)
+// 심각도는 높은 순, 파일은 경로 순, 규칙과 카테고리는 이슈가 많은 순으로 그룹을 나열합니다
+func (r *ConsoleReporter) groupIssues(issues []types.Issue) []issueGroup {
+	if r.GroupBy == "" || r.GroupBy == GroupBySeverity {
+		var groups []issueGroup
+		for _, severity := range severityOrder {
+			var matched []types.Issue
+			for _, issue := range issues {
+				if issue.Severity == severity {
+					matched = append(matched, issue)
+				}
+			}
+			if len(matched) > 0 {
+				title := fmt.Sprintf("%s %s", r.getSeverityEmoji(severity), r.paint(severityColor(severity), i18n.T("report.severity-issues", strings.ToUpper(severity.String()), len(matched))))
+				groups = append(groups, issueGroup{Key: title, Issues: matched})
+			}
+		}
+		return groups
+	}
+
+	key, emoji, color := func(issue types.Issue) string { return issue.File }, "📁", colorPath
+	switch r.GroupBy {
+	case GroupByRule:
+		key, emoji, color = func(issue types.Issue) string { return issue.RuleID }, "📏", colorRule
+	case GroupByCategory:
+		key, emoji, color = func(issue types.Issue) string { return issue.Category }, "📂", ""
+	}
+
+	var keys []string
+	grouped := make(map[string][]types.Issue)
+	for _, issue := range issues {
+		k := key(issue)
+		if _, ok := grouped[k]; !ok {
+			keys = append(keys, k)
+		}
+		grouped[k] = append(grouped[k], issue)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if r.GroupBy != GroupByFile && len(grouped[keys[i]]) != len(grouped[keys[j]]) {
+			return len(grouped[keys[i]]) > len(grouped[keys[j]])
+		}
+		return keys[i] < keys[j]
+	})
+
+	groups := make([]issueGroup, 0, len(keys))
+	for _, k := range keys {
+		title := fmt.Sprintf("%s %s", emoji, i18n.T("report.group-issues", r.paint(color, k), len(grouped[k])))
+		groups = append(groups, issueGroup{Key: title, Issues: grouped[k]})
+	}
+	return groups
+}
+
+// sortIssues 정렬 기준으로 그룹 안 이슈 정렬 (기준이 없으면 분석 순서 유지)
+func (r *ConsoleReporter) sortIssues(issues []types.Issue) []types.Issue {
+	if r.SortBy == "" {
+		return issues
+	}
+
+	sorted := append([]types.Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		switch {
+		case r.SortBy == SortBySeverity && a.Severity != b.Severity:
+			return a.Severity > b.Severity
+		case r.SortBy == SortByRule && a.RuleID != b.RuleID:
+			return a.RuleID < b.RuleID
+		case r.SortBy == SortByPriority && a.Priority != b.Priority:
+			return a.Priority > b.Priority
+		case a.File != b.File:
+			return a.File < b.File
+		case a.Line != b.Line:
+			return a.Line < b.Line
+		default:
+			return a.Column < b.Column
+		}
+	})
+	return sorted
+}
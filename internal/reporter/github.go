@@ -0,0 +1,75 @@
+package reporter
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// GitHubReporter GitHub Actions 워크플로 명령(::error 등) 출력 리포터
+// Actions 단계의 표준 출력으로 내보내면 이슈가 PR의 변경 파일에 인라인 어노테이션으로 표시됩니다
+type GitHubReporter struct{}
+
+func (r *GitHubReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	content := RenderGitHub(result)
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(content), 0644)
+	}
+	fmt.Print(content)
+	return nil
+}
+
+// RenderGitHub 이슈마다 워크플로 명령 한 줄 생성
+func RenderGitHub(result *types.AnalysisResult) string {
+	var output strings.Builder
+	for _, issue := range result.Issues {
+		output.WriteString(githubAnnotation(issue) + "\n")
+	}
+	return output.String()
+}
+
+// githubAnnotation 이슈 하나의 워크플로 명령 (경로는 저장소 루트 기준이어야 하므로 작업 디렉터리 기준 상대 경로)
+func githubAnnotation(issue types.Issue) string {
+	properties := []string{"file=" + githubProperty(filepath.ToSlash(workdirRelative(issue.File)))}
+	if issue.Line > 0 {
+		properties = append(properties, fmt.Sprintf("line=%d", issue.Line))
+		if issue.Column > 0 {
+			properties = append(properties, fmt.Sprintf("col=%d", issue.Column))
+		}
+	}
+	properties = append(properties, "title="+githubProperty(fmt.Sprintf("%s (%s)", issue.RuleID, issue.Severity)))
+
+	message := issue.Message
+	if issue.Suggestion != "" {
+		message += "\n" + i18n.T("report.suggestion-label", issue.Suggestion)
+	}
+	return fmt.Sprintf("::%s %s::%s", githubLevel(issue.Severity), strings.Join(properties, ","), githubData(message))
+}
+
+// githubLevel 심각도를 어노테이션 수준으로 변환
+func githubLevel(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical, config.SeverityHigh:
+		return "error"
+	case config.SeverityMedium:
+		return "warning"
+	default:
+		return "notice"
+	}
+}
+
+// githubData 워크플로 명령 메시지 이스케이프 (줄바꿈과 %)
+func githubData(value string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(value)
+}
+
+// githubProperty 워크플로 명령 속성 값 이스케이프 (메시지 이스케이프에 더해 : 와 ,)
+func githubProperty(value string) string {
+	return strings.NewReplacer(":", "%3A", ",", "%2C").Replace(githubData(value))
+}
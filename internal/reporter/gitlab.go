@@ -0,0 +1,79 @@
+package reporter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code-quality-checker/internal/types"
+)
+
+// GitLab Code Quality 리포트 항목 (Code Climate 이슈 형식의 일부, Merge Request diff에 인라인 표시)
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"` // info, minor, major, critical, blocker
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+// GitLabReporter GitLab Code Quality JSON 출력 리포터
+type GitLabReporter struct{}
+
+func (r *GitLabReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	jsonData, err := json.MarshalIndent(newGitLabIssues(result.Issues), "", "  ")
+	if err != nil {
+		return fmt.Errorf("GitLab Code Quality 마샬링 실패: %w", err)
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, jsonData, 0644)
+	}
+	fmt.Print(string(jsonData))
+	return nil
+}
+
+// newGitLabIssues 이슈를 Code Quality 항목으로 변환
+// GitLab은 fingerprint가 같은 항목을 하나로 합치므로 같은 코드가 반복된 이슈는 순번을 섞어 구분합니다
+func newGitLabIssues(issues []types.Issue) []gitlabIssue {
+	entries := make([]gitlabIssue, 0, len(issues))
+	seen := make(map[string]int)
+	for _, issue := range issues {
+		fingerprint := issueFingerprint(issue)
+		if n := seen[fingerprint]; n > 0 {
+			sum := sha256.Sum256([]byte(fmt.Sprintf("%s:%d", fingerprint, n)))
+			seen[fingerprint]++
+			fingerprint = hex.EncodeToString(sum[:8])
+		} else {
+			seen[fingerprint] = 1
+		}
+
+		line := issue.Line
+		if line < 1 {
+			line = 1
+		}
+		entries = append(entries, gitlabIssue{
+			Description: issue.Message,
+			CheckName:   issue.RuleID,
+			Fingerprint: fingerprint,
+			Severity:    codeClimateSeverity(issue.Severity),
+			Location: gitlabLocation{
+				Path:  filepath.ToSlash(workdirRelative(issue.File)),
+				Lines: gitlabLines{Begin: line},
+			},
+		})
+	}
+	return entries
+}
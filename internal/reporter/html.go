@@ -0,0 +1,231 @@
+package reporter
+
+import (
+	"embed"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+//go:embed templates/report.html
+var templateFS embed.FS
+
+// reportTemplate HTML 리포트 템플릿 (html/template이 메시지와 코드 스니펫을 문맥에 맞게 이스케이프)
+var reportTemplate = template.Must(template.New("report.html").Funcs(template.FuncMap{
+	"upper":          strings.ToUpper,
+	"join":           strings.Join,
+	"classification": classificationText,
+	"escalation":     escalationText,
+	"suppression":    suppressionText,
+	"triage":         triageText,
+	"percent":        func(ratio float64) float64 { return ratio * 100 },
+	"inc":            func(i int) int { return i + 1 },
+	"exportKey":      exportKey,
+	"t":              i18n.T,
+	"lang":           i18n.Language,
+}).ParseFS(templateFS, "templates/report.html"))
+
+// severityOrder 리포트에 표시할 심각도 순서 (높은 순)
+var severityOrder = []config.Severity{
+	config.SeverityCritical,
+	config.SeverityHigh,
+	config.SeverityMedium,
+	config.SeverityLow,
+}
+
+// htmlReport HTML 템플릿 데이터 모델 (--html-template 사용자 템플릿도 같은 모델을 받으므로 필드를 바꾸면 README도 함께 수정)
+type htmlReport struct {
+	Result         *types.AnalysisResult
+	SeverityCounts []severityCount // 이슈가 있는 심각도 (높은 순)
+	Rules          []issueGroup    // 규칙 ID 순
+	Severities     []issueGroup    // 심각도 높은 순
+	Files          []issueGroup    // 파일 경로 순
+	OWASP          []owaspCategory
+	TopRules       []rankedCount   // 이슈가 많은 규칙 (상위 10개)
+	TopFiles       []rankedCount   // 이슈가 많은 파일 (상위 10개)
+	Hotspots       []types.Hotspot // 심각도 가중 점수 상위 파일 (상위 10개)
+	Export         []exportIssue   // 선택 내보내기용 이슈 데이터 (스크립트에 JSON으로 삽입)
+	Charts         *chartData      // 요약 차트 데이터 (이슈가 없으면 nil)
+	Sources        []sourceFile    // 소스 보기 탭 (이슈가 있는 파일의 원문)
+}
+
+// exportIssue HTML 리포트에서 선택해 CSV/JSON/Markdown으로 내보내는 이슈 항목
+type exportIssue struct {
+	Key         string `json:"key"`
+	ID          string `json:"id"`
+	RuleID      string `json:"rule_id"`
+	Severity    string `json:"severity"`
+	Category    string `json:"category"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	Message     string `json:"message"`
+	Suggestion  string `json:"suggestion"`
+	Fingerprint string `json:"fingerprint"`
+	Priority    int    `json:"priority"`
+}
+
+// exportKey 탭마다 반복 표시되는 같은 이슈를 묶는 선택 키 (ID가 없는 예전 결과는 식별자와 위치로 구분)
+func exportKey(issue types.Issue) string {
+	if issue.ID != "" {
+		return issue.ID
+	}
+	return fmt.Sprintf("%s:%d:%d", issueFingerprint(issue), issue.Line, issue.Column)
+}
+
+// issueFingerprint 이슈 식별자 (분석기를 거치지 않은 결과는 직접 계산)
+func issueFingerprint(issue types.Issue) string {
+	if issue.Fingerprint != "" {
+		return issue.Fingerprint
+	}
+	return types.Fingerprint(issue)
+}
+
+// severityCount 심각도별 이슈 수
+type severityCount struct {
+	Severity config.Severity
+	Count    int
+}
+
+// issueGroup 규칙/심각도/파일 단위로 묶인 이슈
+type issueGroup struct {
+	Key    string
+	Issues []types.Issue
+}
+
+// HTMLReporter HTML 출력 리포터
+// TemplateFile을 지정하면 기본 템플릿 대신 그 파일로 리포트를 만듭니다 (기본 템플릿의 define 블록은 그대로 사용하거나 재정의 가능)
+type HTMLReporter struct {
+	TemplateFile string
+}
+
+// RenderHTML HTML 리포트 문자열 생성 (메일 본문 등 파일 외 용도)
+func RenderHTML(result *types.AnalysisResult) (string, error) {
+	return (&HTMLReporter{}).generateHTML(result)
+}
+
+func (r *HTMLReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	html, err := r.generateHTML(result)
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		return r.writeToFile(html, outputFile)
+	} else {
+		fmt.Print(html)
+		return nil
+	}
+}
+
+func (r *HTMLReporter) generateHTML(result *types.AnalysisResult) (string, error) {
+	tmpl, err := r.template()
+	if err != nil {
+		return "", err
+	}
+
+	var html strings.Builder
+	if err := tmpl.Execute(&html, newHTMLReport(result)); err != nil {
+		return "", fmt.Errorf("HTML 리포트 생성 실패: %w", err)
+	}
+	return html.String(), nil
+}
+
+// template 리포트 템플릿 (사용자 템플릿은 기본 템플릿을 복제한 뒤 덧씌워 같은 함수와 define 블록을 쓸 수 있게 함)
+func (r *HTMLReporter) template() (*template.Template, error) {
+	if r.TemplateFile == "" {
+		return reportTemplate, nil
+	}
+
+	content, err := os.ReadFile(r.TemplateFile)
+	if err != nil {
+		return nil, fmt.Errorf("HTML 템플릿 읽기 실패: %w", err)
+	}
+	base, err := reportTemplate.Clone()
+	if err != nil {
+		return nil, err
+	}
+	custom, err := base.New(filepath.Base(r.TemplateFile)).Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("HTML 템플릿 파싱 실패: %w", err)
+	}
+	return custom, nil
+}
+
+// newHTMLReport 분석 결과로 템플릿 데이터 구성
+func newHTMLReport(result *types.AnalysisResult) *htmlReport {
+	report := &htmlReport{
+		Result:   result,
+		Rules:    groupIssues(result.Issues, func(issue types.Issue) string { return issue.RuleID }),
+		Files:    groupIssues(result.Issues, func(issue types.Issue) string { return issue.File }),
+		OWASP:    summarizeOWASP(result.Issues),
+		TopRules: topRules(result.Issues),
+		TopFiles: topFiles(result.Issues),
+		Hotspots: hotspots(result),
+		Charts:   newChartData(result),
+	}
+	report.Sources = newSourceFiles(report.Files)
+
+	for _, issue := range result.Issues {
+		report.Export = append(report.Export, exportIssue{
+			Key:         exportKey(issue),
+			ID:          issue.ID,
+			RuleID:      issue.RuleID,
+			Severity:    issue.Severity.String(),
+			Category:    issue.Category,
+			File:        issue.File,
+			Line:        issue.Line,
+			Column:      issue.Column,
+			Message:     issue.Message,
+			Suggestion:  issue.Suggestion,
+			Fingerprint: issueFingerprint(issue),
+			Priority:    issue.Priority,
+		})
+	}
+
+	bySeverity := make(map[config.Severity][]types.Issue)
+	for _, issue := range result.Issues {
+		bySeverity[issue.Severity] = append(bySeverity[issue.Severity], issue)
+	}
+	for _, severity := range severityOrder {
+		if count := result.Summary.SeverityCount[severity]; count > 0 {
+			report.SeverityCounts = append(report.SeverityCounts, severityCount{Severity: severity, Count: count})
+		}
+		if issues := bySeverity[severity]; len(issues) > 0 {
+			report.Severities = append(report.Severities, issueGroup{Key: severity.String(), Issues: issues})
+		}
+	}
+
+	return report
+}
+
+// groupIssues 키별로 이슈를 묶어 키 순으로 정렬 (묶음 안의 순서는 유지)
+func groupIssues(issues []types.Issue, key func(types.Issue) string) []issueGroup {
+	indexByKey := make(map[string]int)
+	var groups []issueGroup
+
+	for _, issue := range issues {
+		k := key(issue)
+		i, ok := indexByKey[k]
+		if !ok {
+			i = len(groups)
+			indexByKey[k] = i
+			groups = append(groups, issueGroup{Key: k})
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Key < groups[j].Key })
+	return groups
+}
+
+func (r *HTMLReporter) writeToFile(content string, filename string) error {
+	return os.WriteFile(filename, []byte(content), 0644)
+}
@@ -0,0 +1,170 @@
+package reporter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// JUnit XML (Jenkins, GitLab 테스트 리포트 탭용) 출력 형식
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Timestamp string          `xml:"timestamp,attr,omitempty"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	File      string        `xml:"file,attr,omitempty"`
+	Line      int           `xml:"line,attr,omitempty"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitSkipped `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type junitSkipped struct {
+	Message string `xml:"message,attr,omitempty"`
+}
+
+// JUnitReporter JUnit XML 출력 리포터
+// 규칙(GroupByFile이면 파일)마다 testsuite, 이슈마다 실패한 testcase를 만들고 억제된 이슈는 skipped로 기록합니다
+type JUnitReporter struct {
+	GroupByFile bool
+}
+
+func (r *JUnitReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	xmlData, err := xml.MarshalIndent(r.newTestSuites(result), "", "  ")
+	if err != nil {
+		return fmt.Errorf("JUnit XML 마샬링 실패: %w", err)
+	}
+	content := xml.Header + string(xmlData) + "\n"
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(content), 0644)
+	}
+	fmt.Print(content)
+	return nil
+}
+
+// newTestSuites 분석 결과를 testsuite 목록으로 변환 (testsuite는 이름 순, testcase는 파일/라인 순)
+func (r *JUnitReporter) newTestSuites(result *types.AnalysisResult) *junitTestSuites {
+	suites := make(map[string]*junitTestSuite)
+	suite := func(issue types.Issue) *junitTestSuite {
+		name := issue.RuleID
+		if r.GroupByFile {
+			name = issue.File
+		}
+		if _, ok := suites[name]; !ok {
+			suites[name] = &junitTestSuite{Name: name, Timestamp: result.StartTime.Format("2006-01-02T15:04:05")}
+		}
+		return suites[name]
+	}
+
+	for _, issue := range result.Issues {
+		s := suite(issue)
+		testCase := r.newTestCase(issue)
+		testCase.Failure = &junitFailure{
+			Message: issue.Message,
+			Type:    strings.ToUpper(issue.Severity.String()),
+			Text:    junitFailureText(issue),
+		}
+		s.TestCases = append(s.TestCases, testCase)
+		s.Tests++
+		s.Failures++
+	}
+	for _, suppressed := range result.Suppressed {
+		if suppressed.Expired {
+			continue // 만료된 억제는 Issues에 이미 있음
+		}
+		s := suite(suppressed.Issue)
+		testCase := r.newTestCase(suppressed.Issue)
+		testCase.Skipped = &junitSkipped{Message: suppressionText(suppressed)}
+		s.TestCases = append(s.TestCases, testCase)
+		s.Tests++
+		s.Skipped++
+	}
+
+	report := &junitTestSuites{
+		Name:   "Code Quality Checker",
+		Time:   fmt.Sprintf("%.3f", result.Duration.Seconds()),
+		Suites: []junitTestSuite{},
+	}
+	names := make([]string, 0, len(suites))
+	for name := range suites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		s := suites[name]
+		sort.SliceStable(s.TestCases, func(i, j int) bool {
+			if s.TestCases[i].File != s.TestCases[j].File {
+				return s.TestCases[i].File < s.TestCases[j].File
+			}
+			return s.TestCases[i].Line < s.TestCases[j].Line
+		})
+		report.Tests += s.Tests
+		report.Failures += s.Failures
+		report.Skipped += s.Skipped
+		report.Suites = append(report.Suites, *s)
+	}
+
+	return report
+}
+
+// newTestCase 이슈 위치로 testcase 생성 (classname은 testsuite가 아닌 쪽 기준으로 테스트 리포트 탭에서 묶임)
+func (r *JUnitReporter) newTestCase(issue types.Issue) junitTestCase {
+	testCase := junitTestCase{
+		Name:      fmt.Sprintf("%s:%d", issue.File, issue.Line),
+		ClassName: issue.File,
+		File:      issue.File,
+		Line:      issue.Line,
+	}
+	if r.GroupByFile {
+		testCase.Name = fmt.Sprintf("%s:%d", issue.RuleID, issue.Line)
+		testCase.ClassName = issue.RuleID
+	}
+	return testCase
+}
+
+// junitFailureText 실패 상세 내용 (설명, 권장 수정 방법, 코드)
+func junitFailureText(issue types.Issue) string {
+	var text strings.Builder
+	text.WriteString(fmt.Sprintf("[%s] %s:%d:%d\n", issue.RuleID, issue.File, issue.Line, issue.Column))
+	if issue.ID != "" {
+		text.WriteString("ID: " + issue.ID + "\n")
+	}
+	if issue.Description != "" {
+		text.WriteString(issue.Description + "\n")
+	}
+	if issue.Suggestion != "" {
+		text.WriteString(i18n.T("report.suggestion-label", issue.Suggestion) + "\n")
+	}
+	if issue.CodeSnippet != "" {
+		text.WriteString(i18n.T("report.code-label", issue.CodeSnippet) + "\n")
+	}
+	return text.String()
+}
@@ -0,0 +1,139 @@
+package reporter
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// markdownTopIssues 주요 이슈 표에 표시할 최대 이슈 수
+const markdownTopIssues = 20
+
+// MarkdownReporter GitHub 스타일 Markdown 출력 리포터 (PR 설명, 위키에 붙여넣기용)
+// 파일별 이슈는 <details>로 접어 두어 긴 결과도 PR 본문을 덮지 않게 합니다
+type MarkdownReporter struct{}
+
+func (r *MarkdownReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	markdown := RenderMarkdown(result)
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(markdown), 0644)
+	}
+	fmt.Print(markdown)
+	return nil
+}
+
+// RenderMarkdown Markdown 리포트 문자열 생성
+func RenderMarkdown(result *types.AnalysisResult) string {
+	var md strings.Builder
+
+	md.WriteString("## " + i18n.T("markdown.title") + "\n\n")
+	md.WriteString("| " + i18n.T("markdown.item-columns") + " |\n|---|---|\n")
+	md.WriteString(fmt.Sprintf("| %s | %s |\n", i18n.T("markdown.total-files"), i18n.T("report.count", result.Summary.TotalFiles)))
+	md.WriteString(fmt.Sprintf("| %s | %s |\n", i18n.T("markdown.total-issues"), i18n.T("report.count", result.Summary.TotalIssues)))
+	if suppressed := result.ActiveSuppressions(); suppressed > 0 {
+		md.WriteString(fmt.Sprintf("| %s | %s |\n", i18n.T("markdown.suppressed"), i18n.T("report.count", suppressed)))
+	}
+	md.WriteString(fmt.Sprintf("| %s | %s |\n", i18n.T("markdown.duration"), i18n.T("markdown.seconds", result.Duration.Seconds())))
+	if metadata := result.Metadata; metadata != nil {
+		md.WriteString(fmt.Sprintf("| %s | %s |\n| %s | `%s` |\n", i18n.T("markdown.tool-version"), metadata.ToolVersion, i18n.T("markdown.ruleset-hash"), metadata.RulesetHash))
+		if metadata.Commit != "" {
+			md.WriteString(fmt.Sprintf("| %s | `%s` |\n", i18n.T("markdown.commit"), metadata.Commit))
+		}
+	}
+	md.WriteString("\n")
+
+	if result.Summary.TotalIssues == 0 {
+		md.WriteString(i18n.T("report.no-issues") + "\n")
+		return md.String()
+	}
+
+	// 심각도별, 카테고리별 이슈 수
+	console := &ConsoleReporter{}
+	md.WriteString("### " + i18n.T("markdown.by-severity") + "\n\n| " + i18n.T("markdown.severity-columns") + " |\n|---|---|\n")
+	for _, severity := range severityOrder {
+		md.WriteString(fmt.Sprintf("| %s %s | %d |\n", console.getSeverityEmoji(severity), strings.ToUpper(severity.String()), result.Summary.SeverityCount[severity]))
+	}
+	md.WriteString("\n")
+
+	categories := make([]string, 0, len(result.Summary.CategoryCount))
+	for category := range result.Summary.CategoryCount {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	md.WriteString("| " + i18n.T("markdown.category-columns") + " |\n|---|---|\n")
+	for _, category := range categories {
+		md.WriteString(fmt.Sprintf("| %s | %d |\n", category, result.Summary.CategoryCount[category]))
+	}
+	md.WriteString("\n")
+
+	if failed := result.FailedGates(); len(failed) > 0 {
+		md.WriteString("### " + i18n.T("markdown.failed-gates") + "\n\n")
+		for _, gate := range failed {
+			md.WriteString(fmt.Sprintf("- %s: %s\n", gate.Category, i18n.T("markdown.gate", gate.Count, gate.Max, gate.MinSeverity)))
+		}
+		md.WriteString("\n")
+	}
+
+	// 우선순위(같으면 심각도) 높은 순 주요 이슈
+	top := append([]types.Issue{}, result.Issues...)
+	sort.SliceStable(top, func(i, j int) bool {
+		if top[i].Priority != top[j].Priority {
+			return top[i].Priority > top[j].Priority
+		}
+		return top[i].Severity > top[j].Severity
+	})
+	if len(top) > markdownTopIssues {
+		top = top[:markdownTopIssues]
+	}
+	md.WriteString("### " + i18n.T("markdown.top-issues", len(top)) + "\n\n")
+	md.WriteString("| " + i18n.T("markdown.issue-columns") + " |\n|---|---|---|---|---|\n")
+	for _, issue := range top {
+		md.WriteString(fmt.Sprintf("| %s | %d | `%s` | %s | %s |\n",
+			strings.ToUpper(issue.Severity.String()), issue.Priority, issue.RuleID, markdownLocation(issue, fmt.Sprintf("%s:%d", issue.File, issue.Line)), markdownCell(markdownText(issue.Message))))
+	}
+	md.WriteString("\n")
+
+	// 파일별 이슈 (접힌 섹션)
+	md.WriteString("### " + i18n.T("markdown.by-file") + "\n\n")
+	for _, group := range groupIssues(result.Issues, func(issue types.Issue) string { return issue.File }) {
+		md.WriteString(fmt.Sprintf("<details>\n<summary><code>%s</code> (%s)</summary>\n\n", markdownText(group.Key), i18n.T("report.count", len(group.Issues))))
+		for _, issue := range group.Issues {
+			md.WriteString(fmt.Sprintf("- **%s** `%s` %s: %s", strings.ToUpper(issue.Severity.String()), issue.RuleID, markdownLocation(issue, i18n.T("markdown.line", issue.Line)), markdownText(issue.Message)))
+			if issue.ID != "" {
+				md.WriteString(fmt.Sprintf(" <sub>`%s`</sub>", issue.ID))
+			}
+			if issue.Suggestion != "" {
+				md.WriteString(fmt.Sprintf("<br>💡 %s", markdownText(issue.Suggestion)))
+			}
+			md.WriteString("\n")
+		}
+		md.WriteString("\n</details>\n\n")
+	}
+
+	return md.String()
+}
+
+// markdownLocation 이슈 위치 (코드 호스팅 링크가 있으면 링크로 표시)
+func markdownLocation(issue types.Issue, label string) string {
+	if issue.URL != "" {
+		return fmt.Sprintf("[`%s`](%s)", label, issue.URL)
+	}
+	return "`" + label + "`"
+}
+
+// markdownText 메시지의 태그 이름(<head> 등)이 HTML로 해석되지 않도록 이스케이프
+func markdownText(text string) string {
+	return html.EscapeString(text)
+}
+
+// markdownCell 표 셀 안에서 표를 깨뜨리는 문자 처리
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", "\\|")
+	return strings.ReplaceAll(text, "\n", " ")
+}
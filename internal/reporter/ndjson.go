@@ -0,0 +1,125 @@
+package reporter
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/types"
+)
+
+// StreamReporter 분석 중에 이슈를 하나씩 받아 바로 출력하는 리포터 (결과 전체를 메모리에 모으지 않음)
+// Begin, 이슈마다 WriteIssue, End 순으로 호출합니다
+type StreamReporter interface {
+	Reporter
+	Begin(outputFile string) error
+	WriteIssue(issue types.Issue) error
+	End(result *types.AnalysisResult) error
+}
+
+// NewStream 스트리밍을 지원하는 형식의 리포터 생성 (지원하지 않으면 nil)
+func NewStream(format string) StreamReporter {
+	switch strings.ToLower(format) {
+	case "ndjson":
+		return &NDJSONReporter{}
+	default:
+		return nil
+	}
+}
+
+// NDJSON 레코드 종류
+const (
+	NDJSONIssue   = "issue"
+	NDJSONSummary = "summary"
+)
+
+// ndjsonIssue 이슈 한 줄 (이슈 필드에 레코드 종류를 더함)
+type ndjsonIssue struct {
+	Type string `json:"type"`
+	types.Issue
+}
+
+// ndjsonSummary 마지막 줄 (요약과 게이트, 분석이 끝나야 알 수 있는 정보)
+type ndjsonSummary struct {
+	Type       string             `json:"type"`
+	Summary    types.Summary      `json:"summary"`
+	Gates      []types.GateResult `json:"gates,omitempty"`
+	Suppressed int                `json:"suppressed,omitempty"`
+	Warnings   []string           `json:"warnings,omitempty"`
+	Duration   time.Duration      `json:"duration"`
+}
+
+// NDJSONReporter 이슈마다 JSON 한 줄, 마지막에 요약 한 줄을 출력하는 리포터 (jq, 로그 수집기용)
+// 스트리밍으로 쓰면 파일 분석이 끝날 때마다 이슈를 출력하므로 대형 저장소에서도 메모리 사용량이 이슈 수에 비례하지 않습니다
+type NDJSONReporter struct {
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func (r *NDJSONReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	if err := r.Begin(outputFile); err != nil {
+		return err
+	}
+	for _, issue := range result.Issues {
+		if err := r.WriteIssue(issue); err != nil {
+			r.close()
+			return err
+		}
+	}
+	return r.End(result)
+}
+
+// Begin 출력 열기 (outputFile이 비어있으면 stdout)
+func (r *NDJSONReporter) Begin(outputFile string) error {
+	var out io.Writer = os.Stdout
+	if outputFile != "" {
+		file, err := os.Create(outputFile)
+		if err != nil {
+			return err
+		}
+		r.file = file
+		out = file
+	}
+	r.writer = bufio.NewWriter(out)
+	r.encoder = json.NewEncoder(r.writer)
+	return nil
+}
+
+// WriteIssue 이슈 한 줄 출력 (파이프로 바로 읽을 수 있도록 줄마다 내보냄)
+func (r *NDJSONReporter) WriteIssue(issue types.Issue) error {
+	if err := r.encoder.Encode(ndjsonIssue{Type: NDJSONIssue, Issue: issue}); err != nil {
+		return err
+	}
+	return r.writer.Flush()
+}
+
+// End 요약 한 줄을 출력하고 닫기
+func (r *NDJSONReporter) End(result *types.AnalysisResult) error {
+	err := r.encoder.Encode(ndjsonSummary{
+		Type:       NDJSONSummary,
+		Summary:    result.Summary,
+		Gates:      result.Gates,
+		Suppressed: len(result.Suppressed),
+		Warnings:   result.Warnings,
+		Duration:   result.Duration,
+	})
+	if closeErr := r.close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (r *NDJSONReporter) close() error {
+	err := r.writer.Flush()
+	if r.file != nil {
+		if closeErr := r.file.Close(); err == nil {
+			err = closeErr
+		}
+		r.file = nil
+	}
+	return err
+}
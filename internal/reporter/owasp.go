@@ -0,0 +1,77 @@
+package reporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// owaspWorstFiles 카테고리별로 표시할 이슈가 많은 파일 수
+const owaspWorstFiles = 3
+
+// owaspCategory OWASP Top 10 카테고리별 보안 이슈 집계
+type owaspCategory struct {
+	Name       string
+	Count      int
+	WorstFiles []fileCount // 이슈가 많은 순
+}
+
+// fileCount 파일별 이슈 수
+type fileCount struct {
+	File  string
+	Count int
+}
+
+// summarizeOWASP 보안 분류가 있는 이슈를 OWASP 카테고리별로 집계 (카테고리 순 정렬)
+func summarizeOWASP(issues []types.Issue) []owaspCategory {
+	filesByCategory := make(map[string]map[string]int)
+	for _, issue := range issues {
+		if issue.OWASP == "" {
+			continue
+		}
+		if filesByCategory[issue.OWASP] == nil {
+			filesByCategory[issue.OWASP] = make(map[string]int)
+		}
+		filesByCategory[issue.OWASP][issue.File]++
+	}
+
+	var categories []owaspCategory
+	for name, files := range filesByCategory {
+		category := owaspCategory{Name: name}
+		for file, count := range files {
+			category.Count += count
+			category.WorstFiles = append(category.WorstFiles, fileCount{File: file, Count: count})
+		}
+		sort.Slice(category.WorstFiles, func(i, j int) bool {
+			if category.WorstFiles[i].Count != category.WorstFiles[j].Count {
+				return category.WorstFiles[i].Count > category.WorstFiles[j].Count
+			}
+			return category.WorstFiles[i].File < category.WorstFiles[j].File
+		})
+		if len(category.WorstFiles) > owaspWorstFiles {
+			category.WorstFiles = category.WorstFiles[:owaspWorstFiles]
+		}
+		categories = append(categories, category)
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name < categories[j].Name
+	})
+	return categories
+}
+
+// writeOWASPConsole 콘솔 리포트의 OWASP Top 10 요약 섹션
+func writeOWASPConsole(output *strings.Builder, categories []owaspCategory) {
+	output.WriteString(i18n.T("report.owasp") + "\n")
+	output.WriteString(strings.Repeat("-", 20) + "\n")
+	for _, category := range categories {
+		output.WriteString(fmt.Sprintf("  %s: %s\n", category.Name, i18n.T("report.count", category.Count)))
+		for _, file := range category.WorstFiles {
+			output.WriteString(fmt.Sprintf("     📁 %s (%s)\n", file.File, i18n.T("report.count", file.Count)))
+		}
+	}
+	output.WriteString("\n")
+}
@@ -0,0 +1,71 @@
+package reporter
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// QuickfixReporter 에디터 quickfix 목록용 출력 리포터 (Vim :make, Emacs compilation-mode)
+// 이슈마다 file:line:col: severity: message [rule-id] 한 줄만 출력하고 헤더, 이모지, 말줄임은 넣지 않습니다
+type QuickfixReporter struct{}
+
+func (r *QuickfixReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	content := RenderQuickfix(result)
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(content), 0644)
+	}
+	fmt.Print(content)
+	return nil
+}
+
+// RenderQuickfix 파일, 라인, 컬럼 순으로 정렬한 quickfix 문자열 생성
+func RenderQuickfix(result *types.AnalysisResult) string {
+	issues := append([]types.Issue{}, result.Issues...)
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].File != issues[j].File {
+			return issues[i].File < issues[j].File
+		}
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+		return issues[i].Column < issues[j].Column
+	})
+
+	var output strings.Builder
+	for _, issue := range issues {
+		output.WriteString(quickfixLine(issue) + "\n")
+	}
+	return output.String()
+}
+
+// quickfixLine 이슈 하나의 quickfix 줄 (파일 단위 이슈는 1:1 위치로 표시)
+func quickfixLine(issue types.Issue) string {
+	line, column := issue.Line, issue.Column
+	if line < 1 {
+		line = 1
+	}
+	if column < 1 {
+		column = 1
+	}
+	message := strings.Join(strings.Fields(issue.Message), " ") // 여러 줄 메시지도 한 줄로
+	return fmt.Sprintf("%s:%d:%d: %s: %s [%s]", workdirRelative(issue.File), line, column,
+		quickfixLevel(issue.Severity), message, issue.RuleID)
+}
+
+// quickfixLevel 심각도를 컴파일러 진단 수준으로 변환 (Emacs가 error/warning/info로 구분하는 단어)
+func quickfixLevel(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical, config.SeverityHigh:
+		return "error"
+	case config.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
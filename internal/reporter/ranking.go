@@ -0,0 +1,105 @@
+package reporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// rankingSize 순위에 표시할 규칙/파일 수
+const rankingSize = 10
+
+// rankedCount 이슈 수 순위 항목 (규칙 순위는 카테고리 포함)
+type rankedCount struct {
+	Key      string
+	Category string
+	Count    int
+}
+
+// rankIssues 키별 이슈 수 상위 항목 (이슈가 많은 순, 같으면 키 순)
+func rankIssues(issues []types.Issue, key func(types.Issue) string, withCategory bool) []rankedCount {
+	indexByKey := make(map[string]int)
+	var ranking []rankedCount
+	for _, issue := range issues {
+		k := key(issue)
+		i, ok := indexByKey[k]
+		if !ok {
+			i = len(ranking)
+			indexByKey[k] = i
+			ranking = append(ranking, rankedCount{Key: k})
+			if withCategory {
+				ranking[i].Category = issue.Category
+			}
+		}
+		ranking[i].Count++
+	}
+
+	sort.Slice(ranking, func(i, j int) bool {
+		if ranking[i].Count != ranking[j].Count {
+			return ranking[i].Count > ranking[j].Count
+		}
+		return ranking[i].Key < ranking[j].Key
+	})
+	if len(ranking) > rankingSize {
+		ranking = ranking[:rankingSize]
+	}
+	return ranking
+}
+
+// topRules 이슈가 많은 규칙 순위
+func topRules(issues []types.Issue) []rankedCount {
+	return rankIssues(issues, func(issue types.Issue) string { return issue.RuleID }, true)
+}
+
+// topFiles 이슈가 많은 파일 순위
+func topFiles(issues []types.Issue) []rankedCount {
+	return rankIssues(issues, func(issue types.Issue) string { return issue.File }, false)
+}
+
+// hotspots 요약의 핫스팟 파일 (요약에 없는 이전 버전 결과는 이슈로 다시 계산)
+func hotspots(result *types.AnalysisResult) []types.Hotspot {
+	if result.Summary.Hotspots != nil {
+		return result.Summary.Hotspots
+	}
+	return types.RankHotspots(result.Issues, types.HotspotCount)
+}
+
+// hotspotBreakdown 핫스팟 파일의 심각도별 이슈 수 ("critical 1, high 4")
+func hotspotBreakdown(hotspot types.Hotspot) string {
+	var parts []string
+	for _, count := range []struct {
+		severity string
+		count    int
+	}{
+		{"critical", hotspot.Critical},
+		{"high", hotspot.High},
+		{"medium", hotspot.Medium},
+		{"low", hotspot.Low},
+	} {
+		if count.count > 0 {
+			parts = append(parts, fmt.Sprintf("%s %d", count.severity, count.count))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// writeRankingConsole 콘솔 리포트의 이슈가 많은 규칙/핫스팟 파일 순위 섹션
+func writeRankingConsole(output *strings.Builder, result *types.AnalysisResult) {
+	output.WriteString(i18n.T("report.top-rules", rankingSize) + "\n")
+	output.WriteString(strings.Repeat("-", 20) + "\n")
+	for i, rule := range topRules(result.Issues) {
+		output.WriteString(fmt.Sprintf("  %2d. %s [%s]: %s\n", i+1, rule.Key, rule.Category, i18n.T("report.count", rule.Count)))
+	}
+	output.WriteString("\n")
+
+	output.WriteString(i18n.T("report.hotspots", types.HotspotCount) + "\n")
+	output.WriteString(strings.Repeat("-", 20) + "\n")
+	for i, hotspot := range hotspots(result) {
+		output.WriteString(fmt.Sprintf("  %2d. %s: %s (%s)\n", i+1, hotspot.File,
+			i18n.T("report.hotspot-score", hotspot.Score, hotspot.Issues), hotspotBreakdown(hotspot)))
+	}
+	output.WriteString("\n")
+}
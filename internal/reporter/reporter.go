@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
 	"code-quality-checker/internal/types"
 )
 
@@ -19,121 +20,210 @@ type Reporter interface {
 func New(format string) (Reporter, error) {
 	switch strings.ToLower(format) {
 	case "console", "text":
-		return &ConsoleReporter{}, nil
+		return &ConsoleReporter{GroupLimit: DefaultGroupLimit}, nil
 	case "json":
 		return &JSONReporter{}, nil
 	case "html":
 		return &HTMLReporter{}, nil
+	case "sarif":
+		return &SARIFReporter{}, nil
+	case "junit":
+		return &JUnitReporter{}, nil
+	case "junit-file":
+		return &JUnitReporter{GroupByFile: true}, nil
+	case "markdown", "md":
+		return &MarkdownReporter{}, nil
+	case "gitlab":
+		return &GitLabReporter{}, nil
+	case "codeclimate":
+		return &CodeClimateReporter{}, nil
+	case "sonar", "sonarqube":
+		return &SonarReporter{}, nil
+	case "tap":
+		return &TAPReporter{}, nil
+	case "github":
+		return &GitHubReporter{}, nil
+	case "quickfix":
+		return &QuickfixReporter{}, nil
+	case "ndjson":
+		return &NDJSONReporter{}, nil
 	default:
 		return nil, fmt.Errorf("지원하지 않는 출력 형식: %s", format)
 	}
 }
 
 // ConsoleReporter 콘솔 출력 리포터
-type ConsoleReporter struct{}
+type ConsoleReporter struct {
+	GroupBy    string // 이슈 목록 그룹 기준 (severity/file/rule/category, 비어있으면 severity)
+	SortBy     string // 그룹 안 이슈 정렬 기준 (line/severity/rule/priority, 비어있으면 분석 순서)
+	GroupLimit int    // 그룹별 최대 표시 이슈 수 (0이면 제한 없음)
+	Color      bool   // 심각도, 파일 경로, 규칙 ID에 ANSI 색상 적용 (터미널에 출력할 때만 켜야 함, ColorSupported 참고)
+}
 
 func (r *ConsoleReporter) Generate(result *types.AnalysisResult, outputFile string) error {
 	var output strings.Builder
 
 	// 헤더 출력
-	output.WriteString("🔍 Code Quality Checker 분석 결과\n")
+	output.WriteString(i18n.T("report.title") + "\n")
 	output.WriteString(strings.Repeat("=", 50) + "\n\n")
 
 	// 요약 정보
-	output.WriteString("📊 분석 요약\n")
+	output.WriteString(i18n.T("report.summary") + "\n")
 	output.WriteString(strings.Repeat("-", 20) + "\n")
-	output.WriteString(fmt.Sprintf("검사 파일 수: %d개\n", result.Summary.TotalFiles))
-	output.WriteString(fmt.Sprintf("발견된 이슈: %d개\n", result.Summary.TotalIssues))
-	output.WriteString(fmt.Sprintf("분석 시간: %.2f초\n\n", result.Duration.Seconds()))
+	output.WriteString(i18n.T("report.total-files", result.Summary.TotalFiles) + "\n")
+	output.WriteString(i18n.T("report.total-issues", result.Summary.TotalIssues) + "\n")
+	output.WriteString(i18n.T("report.duration", result.Duration.Seconds()) + "\n")
+	output.WriteString(i18n.T("report.throughput", result.Summary.Performance.FilesPerSecond, float64(result.Summary.Performance.TotalBytes)/1024) + "\n")
+	output.WriteString(i18n.T("report.peak-memory", float64(result.Summary.Performance.PeakMemoryBytes)/(1<<20)) + "\n\n")
+
+	// 표본 분석 추정치
+	if sampling := result.Sampling; sampling != nil {
+		output.WriteString(i18n.T("report.sampling", sampling.TotalFiles, sampling.SampledFiles, sampling.Ratio*100, sampling.Seed) + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		output.WriteString(i18n.T("report.sampling-estimate", sampling.EstimatedIssues) + "\n")
+		for _, severity := range severityOrder {
+			if count := sampling.EstimatedSeverity[severity]; count > 0 {
+				output.WriteString(fmt.Sprintf("  %s %s: %s\n", r.getSeverityEmoji(severity), severity.String(), i18n.T("report.sampling-count", count)))
+			}
+		}
+		output.WriteString("\n")
+	}
+
+	// 미리보기 (규칙별 전체 이슈 수, 아래 목록에는 대표 이슈만 표시)
+	if preview := result.Preview; preview != nil {
+		output.WriteString(i18n.T("report.preview", preview.PerRule) + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for _, rule := range preview.Rules {
+			output.WriteString(fmt.Sprintf("  %s %s [%s]: %s\n", r.getSeverityEmoji(rule.Severity), r.paint(colorRule, rule.RuleID), rule.Category, i18n.T("report.preview-rule", rule.Total, rule.Files, rule.Shown)))
+		}
+		output.WriteString("\n")
+	}
+
+	// 지난 실행 대비 변화
+	if result.Delta != nil {
+		delta := result.Delta
+		output.WriteString(i18n.T("report.delta", delta.PreviousTime.Format("2006-01-02 15:04")) + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		output.WriteString(i18n.T("report.delta-counts", delta.New, delta.Fixed, delta.Unchanged) + "\n")
+		for _, rule := range delta.Rules {
+			output.WriteString(fmt.Sprintf("  %s: +%d / -%d / =%d\n", rule.RuleID, rule.New, rule.Fixed, rule.Unchanged))
+		}
+		output.WriteString("\n")
+	}
 
 	// 심각도별 통계
 	if result.Summary.TotalIssues > 0 {
-		output.WriteString("⚠️  심각도별 통계\n")
+		output.WriteString(i18n.T("report.by-severity") + "\n")
 		output.WriteString(strings.Repeat("-", 20) + "\n")
 		for severity, count := range result.Summary.SeverityCount {
 			if count > 0 {
 				emoji := r.getSeverityEmoji(severity)
-				output.WriteString(fmt.Sprintf("%s %s: %d개\n", emoji, severity.String(), count))
+				output.WriteString(fmt.Sprintf("%s %s: %s\n", emoji, r.paint(severityColor(severity), severity.String()), i18n.T("report.count", count)))
 			}
 		}
 		output.WriteString("\n")
 
 		// 카테고리별 통계
-		output.WriteString("📂 카테고리별 통계\n")
+		output.WriteString(i18n.T("report.by-category") + "\n")
 		output.WriteString(strings.Repeat("-", 20) + "\n")
 		for category, count := range result.Summary.CategoryCount {
-			output.WriteString(fmt.Sprintf("  %s: %d개\n", category, count))
+			output.WriteString(fmt.Sprintf("  %s: %s\n", category, i18n.T("report.count", count)))
 		}
 		output.WriteString("\n")
 
-		// 이슈 상세 목록
-		output.WriteString("🐛 발견된 이슈 목록\n")
-		output.WriteString(strings.Repeat("=", 50) + "\n\n")
+		// 이슈가 많은 규칙/핫스팟 파일 순위와 OWASP Top 10 요약 (미리보기는 대표 이슈만 남아 있어 위의 미리보기 요약으로 대신함)
+		if result.Preview == nil {
+			writeRankingConsole(&output, result)
 
-		// 심각도별로 그룹화하여 출력
-		issuesBySeverity := r.groupIssuesBySeverity(result.Issues)
-		
-		severityOrder := []config.Severity{
-			config.SeverityCritical,
-			config.SeverityHigh,
-			config.SeverityMedium,
-			config.SeverityLow,
+			if categories := summarizeOWASP(result.Issues); len(categories) > 0 {
+				writeOWASPConsole(&output, categories)
+			}
 		}
 
-		for _, severity := range severityOrder {
-			issues, exists := issuesBySeverity[severity]
-			if !exists || len(issues) == 0 {
-				continue
-			}
+		// 이슈 상세 목록
+		output.WriteString(i18n.T("report.issues") + "\n")
+		output.WriteString(strings.Repeat("=", 50) + "\n\n")
 
-			emoji := r.getSeverityEmoji(severity)
-			output.WriteString(fmt.Sprintf("%s %s 이슈 (%d개)\n", emoji, strings.ToUpper(severity.String()), len(issues)))
+		// 기준(기본값 심각도)별로 그룹화하여 출력
+		for _, group := range r.groupIssues(result.Issues) {
+			output.WriteString(group.Key + "\n")
 			output.WriteString(strings.Repeat("-", 30) + "\n")
 
-			for i, issue := range issues {
-				if i >= 10 { // 각 심각도별로 최대 10개까지만 표시
-					output.WriteString(fmt.Sprintf("  ... 및 %d개 추가 이슈\n", len(issues)-i))
+			for i, issue := range r.sortIssues(group.Issues) {
+				if r.GroupLimit > 0 && i >= r.GroupLimit { // 그룹별 최대 표시 수 (기본 10개)
+					output.WriteString("  " + i18n.T("report.more-issues", len(group.Issues)-i) + "\n")
 					break
 				}
-
-				output.WriteString(fmt.Sprintf("  📁 %s:%d:%d\n", issue.File, issue.Line, issue.Column))
-				output.WriteString(fmt.Sprintf("     [%s] %s\n", issue.RuleID, issue.Message))
-				if issue.Suggestion != "" {
-					output.WriteString(fmt.Sprintf("     💡 %s\n", issue.Suggestion))
-				}
-				if issue.CodeSnippet != "" {
-					output.WriteString(fmt.Sprintf("     📋 %s\n", issue.CodeSnippet))
-				}
-				output.WriteString("\n")
+				r.writeIssue(&output, issue)
 			}
 		}
 	} else {
-		output.WriteString("✅ 이슈가 발견되지 않았습니다!\n\n")
+		output.WriteString(i18n.T("report.no-issues") + "\n\n")
 	}
 
 	// 언어별 통계
 	if len(result.Summary.LanguageCount) > 0 {
-		output.WriteString("💻 언어별 파일 수\n")
+		output.WriteString(i18n.T("report.by-language") + "\n")
 		output.WriteString(strings.Repeat("-", 20) + "\n")
 		for language, count := range result.Summary.LanguageCount {
-			output.WriteString(fmt.Sprintf("  %s: %d개\n", language, count))
+			output.WriteString(fmt.Sprintf("  %s: %s\n", language, i18n.T("report.count", count)))
+		}
+		output.WriteString("\n")
+	}
+
+	// 사용 중인 기능 플래그 (오래된 플래그 정리용)
+	if len(result.Flags) > 0 {
+		output.WriteString(i18n.T("report.flags", len(result.Flags)) + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for _, flag := range result.Flags {
+			output.WriteString(fmt.Sprintf("  %s: %s", flag.Name, i18n.T("report.flag-usages", flag.Usages, len(flag.Files))))
+			if flag.LiteralUsages > 0 {
+				output.WriteString(" " + i18n.T("report.flag-literals", flag.LiteralUsages))
+			}
+			output.WriteString("\n")
+		}
+		output.WriteString("\n")
+	}
+
+	// 분석 경고 (대용량 파일 등)
+	if len(result.Warnings) > 0 {
+		output.WriteString(i18n.T("report.warnings") + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for _, warning := range result.Warnings {
+			output.WriteString(fmt.Sprintf("  %s\n", warning))
+		}
+		output.WriteString("\n")
+	}
+
+	// 억제된 이슈 (만료되어 다시 보고된 이슈 포함)
+	if len(result.Suppressed) > 0 {
+		output.WriteString(i18n.T("report.suppressed", len(result.Suppressed), len(result.Suppressed)-result.ActiveSuppressions()) + "\n")
+		output.WriteString(strings.Repeat("-", 20) + "\n")
+		for i, suppressed := range result.Suppressed {
+			if i >= 20 {
+				output.WriteString("  " + i18n.T("report.more", len(result.Suppressed)-i) + "\n")
+				break
+			}
+			issue := suppressed.Issue
+			output.WriteString(fmt.Sprintf("  %s [%s] %s\n", r.paint(colorPath, fmt.Sprintf("%s:%d", issue.File, issue.Line)), r.paint(colorRule, issue.RuleID), issue.Message))
+			output.WriteString(fmt.Sprintf("     ↳ %s\n", suppressionText(suppressed)))
 		}
 		output.WriteString("\n")
 	}
 
 	// 권장사항
 	if result.Summary.TotalIssues > 0 {
-		output.WriteString("💡 권장사항\n")
+		output.WriteString(i18n.T("report.recommendations") + "\n")
 		output.WriteString(strings.Repeat("-", 20) + "\n")
 		
 		if result.Summary.SeverityCount[config.SeverityCritical] > 0 {
-			output.WriteString("🚨 Critical 이슈는 즉시 수정이 필요합니다!\n")
+			output.WriteString(i18n.T("report.recommend-critical") + "\n")
 		}
 		if result.Summary.SeverityCount[config.SeverityHigh] > 0 {
-			output.WriteString("⚠️  High 이슈는 릴리즈 전에 수정하세요.\n")
+			output.WriteString(i18n.T("report.recommend-high") + "\n")
 		}
 		if result.Summary.SeverityCount[config.SeverityMedium] > 0 {
-			output.WriteString("📝 Medium 이슈는 점진적으로 개선하세요.\n")
+			output.WriteString(i18n.T("report.recommend-medium") + "\n")
 		}
 	}
 
@@ -146,6 +236,98 @@ func (r *ConsoleReporter) Generate(result *types.AnalysisResult, outputFile stri
 	}
 }
 
+// writeIssue 이슈 하나의 위치, 메시지, 분류, 제안, 코드 스니펫 출력
+func (r *ConsoleReporter) writeIssue(output *strings.Builder, issue types.Issue) {
+	output.WriteString("  📁 " + r.paint(colorPath, fmt.Sprintf("%s:%d:%d", issue.File, issue.Line, issue.Column)))
+	if issue.ID != "" {
+		output.WriteString(fmt.Sprintf("  (ID: %s)", issue.ID))
+	}
+	output.WriteString("\n")
+	output.WriteString(fmt.Sprintf("     [%s] %s", r.paint(colorRule, issue.RuleID), issue.Message))
+	if issue.Confidence != 0 && issue.Confidence < config.ConfidenceHigh {
+		output.WriteString(" " + i18n.T("report.confidence", issue.Confidence))
+	}
+	if issue.Maturity != "" {
+		output.WriteString(" " + i18n.T("report.maturity-"+issue.Maturity))
+	}
+	output.WriteString("\n")
+	if issue.Priority > 0 {
+		output.WriteString(fmt.Sprintf("     🎯 %s\n", i18n.T("report.priority", issue.Priority, issue.EffortMinutes)))
+	}
+	if classification := classificationText(issue); classification != "" {
+		output.WriteString(fmt.Sprintf("     🛡️  %s\n", classification))
+	}
+	if issue.EscalatedFrom != nil {
+		output.WriteString(fmt.Sprintf("     ⬆️  %s\n", escalationText(issue)))
+	}
+	if issue.Triage != nil {
+		output.WriteString(fmt.Sprintf("     🏷️  %s\n", triageText(issue.Triage)))
+	}
+	if issue.Suggestion != "" {
+		output.WriteString(fmt.Sprintf("     💡 %s\n", issue.Suggestion))
+	}
+	if issue.CodeSnippet != "" {
+		output.WriteString(fmt.Sprintf("     📋 %s\n", issue.CodeSnippet))
+	}
+	output.WriteString("\n")
+}
+
+// classificationText 보안 이슈의 CWE/OWASP 분류 표시 문자열 (분류가 없으면 빈 문자열)
+func classificationText(issue types.Issue) string {
+	parts := append([]string{}, issue.CWE...)
+	if issue.OWASP != "" {
+		parts = append(parts, "OWASP "+issue.OWASP)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// escalationText 방치로 심각도가 상향된 이슈의 표시 문자열
+func escalationText(issue types.Issue) string {
+	if issue.FirstSeen != nil {
+		return i18n.T("report.escalation-since", issue.EscalatedFrom, issue.Severity, issue.Runs, issue.FirstSeen.Format("2006-01-02"))
+	}
+	return i18n.T("report.escalation", issue.EscalatedFrom, issue.Severity, issue.Runs)
+}
+
+// suppressionText 억제 출처와 만료 상태 표시 문자열
+func suppressionText(suppressed types.SuppressedIssue) string {
+	if suppressed.Source == types.SuppressionTriage {
+		return i18n.T("report.suppression-triage", triageText(suppressed.Issue.Triage))
+	}
+
+	text := i18n.T("report.suppression-baseline")
+	if suppressed.Source == types.SuppressionInline {
+		text = i18n.T("report.suppression-inline", suppressed.Line)
+	}
+	switch {
+	case suppressed.Expired:
+		text += ", " + i18n.T("report.suppression-expired", suppressed.Until.Format("2006-01-02"))
+	case suppressed.Until != nil:
+		text += ", " + i18n.T("report.suppression-until", suppressed.Until.Format("2006-01-02"))
+	default:
+		text += ", " + i18n.T("report.suppression-no-expiry")
+	}
+	return text
+}
+
+// triageText 트리아지 상태 설명 (상태, 담당자, 코멘트)
+func triageText(triage *types.Triage) string {
+	states := map[string]string{
+		types.TriageOpen:          i18n.T("report.triage-open"),
+		types.TriageAccepted:      i18n.T("report.triage-accepted"),
+		types.TriageFalsePositive: i18n.T("report.triage-false-positive"),
+		types.TriageWontFix:       i18n.T("report.triage-wont-fix"),
+	}
+	text := states[triage.State]
+	if triage.Owner != "" {
+		text += " " + i18n.T("report.triage-owner", triage.Owner)
+	}
+	if triage.Comment != "" {
+		text += " - " + triage.Comment
+	}
+	return text
+}
+
 func (r *ConsoleReporter) getSeverityEmoji(severity config.Severity) string {
 	switch severity {
 	case config.SeverityCritical:
@@ -161,16 +343,6 @@ func (r *ConsoleReporter) getSeverityEmoji(severity config.Severity) string {
 	}
 }
 
-func (r *ConsoleReporter) groupIssuesBySeverity(issues []types.Issue) map[config.Severity][]types.Issue {
-	grouped := make(map[config.Severity][]types.Issue)
-	
-	for _, issue := range issues {
-		grouped[issue.Severity] = append(grouped[issue.Severity], issue)
-	}
-	
-	return grouped
-}
-
 func (r *ConsoleReporter) writeToFile(content string, filename string) error {
 	file, err := os.Create(filename)
 	if err != nil {
@@ -201,362 +373,4 @@ func (r *JSONReporter) Generate(result *types.AnalysisResult, outputFile string)
 
 func (r *JSONReporter) writeToFile(data []byte, filename string) error {
 	return os.WriteFile(filename, data, 0644)
-}
-
-// HTMLReporter HTML 출력 리포터
-type HTMLReporter struct{}
-
-func (r *HTMLReporter) Generate(result *types.AnalysisResult, outputFile string) error {
-	html := r.generateHTML(result)
-
-	if outputFile != "" {
-		return r.writeToFile(html, outputFile)
-	} else {
-		fmt.Print(html)
-		return nil
-	}
-}
-
-func (r *HTMLReporter) generateHTML(result *types.AnalysisResult) string {
-	var html strings.Builder
-
-	html.WriteString(`<!DOCTYPE html>
-<html lang="ko">
-<head>
-    <meta charset="UTF-8">
-    <meta name="viewport" content="width=device-width, initial-scale=1.0">
-    <title>Code Quality Report</title>
-    <style>
-        body { font-family: Arial, sans-serif; margin: 0; background-color: #f5f5f5; }
-        .container { max-width: 1200px; margin: 0 auto; padding: 20px; }
-        .header { background: #2c3e50; color: white; padding: 20px; border-radius: 8px; margin-bottom: 20px; }
-        .tabs { background: white; border-radius: 8px; margin-bottom: 20px; box-shadow: 0 2px 4px rgba(0,0,0,0.1); }
-        .tab-buttons { display: flex; border-bottom: 1px solid #ddd; }
-        .tab-button { padding: 15px 20px; background: none; border: none; cursor: pointer; font-size: 16px; border-bottom: 3px solid transparent; transition: all 0.3s; }
-        .tab-button.active { background-color: #3498db; color: white; border-bottom-color: #2980b9; }
-        .tab-button:hover { background-color: #ecf0f1; }
-        .tab-button.active:hover { background-color: #2980b9; }
-        .tab-content { padding: 20px; min-height: 400px; }
-        .tab-pane { display: none; }
-        .tab-pane.active { display: block; }
-        .stats { display: flex; gap: 20px; flex-wrap: wrap; margin-bottom: 20px; }
-        .stat-card { background: #ecf0f1; padding: 15px; border-radius: 8px; flex: 1; min-width: 200px; text-align: center; }
-        .severity-badge { display: inline-block; padding: 4px 8px; border-radius: 4px; color: white; font-size: 12px; font-weight: bold; }
-        .critical { background-color: #e74c3c; }
-        .high { background-color: #f39c12; }
-        .medium { background-color: #3498db; }
-        .low { background-color: #27ae60; }
-        .rule-nav { background: #f8f9fa; padding: 15px; border-radius: 8px; margin-bottom: 20px; }
-        .rule-nav h3 { margin-top: 0; margin-bottom: 10px; }
-        .rule-buttons { display: flex; flex-wrap: wrap; gap: 8px; }
-        .rule-button { padding: 8px 12px; background: #3498db; color: white; border: none; border-radius: 4px; cursor: pointer; font-size: 14px; }
-        .rule-button:hover { background: #2980b9; }
-        .issue { border-left: 4px solid #e74c3c; margin-bottom: 15px; padding: 15px; background: #fafafa; border-radius: 4px; }
-        .issue.critical { border-left-color: #e74c3c; }
-        .issue.high { border-left-color: #f39c12; }
-        .issue.medium { border-left-color: #3498db; }
-        .issue.low { border-left-color: #27ae60; }
-        .code-snippet { background: #2c3e50; color: #ecf0f1; padding: 10px; border-radius: 4px; font-family: monospace; margin-top: 10px; }
-        .file-path { color: #7f8c8d; font-family: monospace; font-size: 14px; }
-        .collapsible { cursor: pointer; padding: 10px; background: #e8f4f8; border: 1px solid #d4e6ea; border-radius: 4px; margin-bottom: 5px; }
-        .collapsible:hover { background: #d4e6ea; }
-        .collapsible.active { background: #3498db; color: white; }
-        .collapsible-content { display: none; padding: 15px; border: 1px solid #ddd; border-top: none; }
-        h1, h2, h3 { margin-top: 0; }
-    </style>
-</head>
-<body>
-    <div class="container">
-        <div class="header">
-            <h1>🔍 Code Quality Report</h1>
-            <p>분석 완료 시간: ` + result.EndTime.Format("2006-01-02 15:04:05") + `</p>
-            <p>분석 시간: ` + fmt.Sprintf("%.2f초", result.Duration.Seconds()) + `</p>
-        </div>
-
-        <div class="tabs">
-            <div class="tab-buttons">
-                <button class="tab-button active" onclick="showTab('overview')">전체 요약</button>
-                <button class="tab-button" onclick="showTab('rules')">규칙별</button>
-                <button class="tab-button" onclick="showTab('severity')">심각도별</button>
-                <button class="tab-button" onclick="showTab('files')">파일별</button>
-            </div>
-            
-            <div class="tab-content">
-                ` + r.generateOverviewTab(result) + `
-                ` + r.generateRulesByTab(result) + `
-                ` + r.generateSeverityTab(result) + `
-                ` + r.generateFilesTab(result) + `
-            </div>
-        </div>
-    </div>
-
-    <script>
-        function showTab(tabName) {
-            // 모든 탭 버튼 비활성화
-            var buttons = document.querySelectorAll('.tab-button');
-            buttons.forEach(btn => btn.classList.remove('active'));
-            
-            // 모든 탭 패널 숨기기
-            var panes = document.querySelectorAll('.tab-pane');
-            panes.forEach(pane => pane.classList.remove('active'));
-            
-            // 선택된 탭 활성화
-            event.target.classList.add('active');
-            document.getElementById(tabName + '-tab').classList.add('active');
-        }
-        
-        function scrollToRule(ruleId) {
-            var element = document.getElementById('rule-' + ruleId);
-            if (element) {
-                element.scrollIntoView({ behavior: 'smooth', block: 'start' });
-                element.style.backgroundColor = '#fff3cd';
-                setTimeout(() => {
-                    element.style.backgroundColor = '';
-                }, 2000);
-            }
-        }
-        
-        function toggleCollapsible(element) {
-            element.classList.toggle('active');
-            var content = element.nextElementSibling;
-            if (content.style.display === 'block') {
-                content.style.display = 'none';
-            } else {
-                content.style.display = 'block';
-            }
-        }
-    </script>
-</body>
-</html>`)
-
-	return html.String()
-}
-
-func (r *HTMLReporter) generateOverviewTab(result *types.AnalysisResult) string {
-	var html strings.Builder
-	
-	html.WriteString(`<div id="overview-tab" class="tab-pane active">
-		<h2>📊 분석 요약</h2>
-		<div class="stats">
-			<div class="stat-card">
-				<h3>` + fmt.Sprintf("%d", result.Summary.TotalFiles) + `</h3>
-				<p>검사된 파일</p>
-			</div>
-			<div class="stat-card">
-				<h3>` + fmt.Sprintf("%d", result.Summary.TotalIssues) + `</h3>
-				<p>발견된 이슈</p>
-			</div>`)
-
-	// 심각도별 통계
-	for severity, count := range result.Summary.SeverityCount {
-		if count > 0 {
-			html.WriteString(`
-			<div class="stat-card">
-				<h3>` + fmt.Sprintf("%d", count) + `</h3>
-				<p><span class="severity-badge ` + severity.String() + `">` + strings.ToUpper(severity.String()) + `</span></p>
-			</div>`)
-		}
-	}
-
-	html.WriteString(`</div>`)
-
-	// 언어별 통계
-	if len(result.Summary.LanguageCount) > 0 {
-		html.WriteString(`<h3>💻 언어별 파일 수</h3><div class="stats">`)
-		for language, count := range result.Summary.LanguageCount {
-			html.WriteString(`
-			<div class="stat-card">
-				<h3>` + fmt.Sprintf("%d", count) + `</h3>
-				<p>` + language + `</p>
-			</div>`)
-		}
-		html.WriteString(`</div>`)
-	}
-
-	html.WriteString(`</div>`)
-	return html.String()
-}
-
-func (r *HTMLReporter) generateRulesByTab(result *types.AnalysisResult) string {
-	var html strings.Builder
-	
-	html.WriteString(`<div id="rules-tab" class="tab-pane">
-		<h2>📋 규칙별 분석</h2>`)
-
-	// 규칙별 네비게이션
-	issuesByRule := r.groupIssuesByRule(result.Issues)
-	if len(issuesByRule) > 0 {
-		html.WriteString(`<div class="rule-nav">
-			<h3>규칙 선택 (섹션 이동)</h3>
-			<div class="rule-buttons">`)
-		
-		for ruleID, issues := range issuesByRule {
-			html.WriteString(`<button class="rule-button" onclick="scrollToRule('` + ruleID + `')">` + ruleID + ` (` + fmt.Sprintf("%d", len(issues)) + `)</button>`)
-		}
-		
-		html.WriteString(`</div></div>`)
-
-		// 규칙별 이슈 표시
-		for ruleID, issues := range issuesByRule {
-			html.WriteString(`<div id="rule-` + ruleID + `" class="collapsible" onclick="toggleCollapsible(this)">
-				<h3>` + ruleID + ` (` + fmt.Sprintf("%d", len(issues)) + `개 이슈)</h3>
-			</div>
-			<div class="collapsible-content">`)
-
-			for _, issue := range issues {
-				html.WriteString(`
-				<div class="issue ` + issue.Severity.String() + `">
-					<div class="file-path">` + issue.File + `:` + fmt.Sprintf("%d", issue.Line) + `:` + fmt.Sprintf("%d", issue.Column) + `</div>
-					<h4>` + issue.Message + ` <span class="severity-badge ` + issue.Severity.String() + `">` + strings.ToUpper(issue.Severity.String()) + `</span></h4>
-					<p><strong>카테고리:</strong> ` + issue.Category + `</p>`)
-
-				if issue.Description != "" {
-					html.WriteString(`<p><strong>설명:</strong> ` + issue.Description + `</p>`)
-				}
-				if issue.Suggestion != "" {
-					html.WriteString(`<p><strong>💡 권장사항:</strong> ` + issue.Suggestion + `</p>`)
-				}
-				if issue.CodeSnippet != "" {
-					html.WriteString(`<div class="code-snippet">` + issue.CodeSnippet + `</div>`)
-				}
-
-				html.WriteString(`</div>`)
-			}
-
-			html.WriteString(`</div>`)
-		}
-	} else {
-		html.WriteString(`<p>✅ 발견된 이슈가 없습니다!</p>`)
-	}
-
-	html.WriteString(`</div>`)
-	return html.String()
-}
-
-func (r *HTMLReporter) generateSeverityTab(result *types.AnalysisResult) string {
-	var html strings.Builder
-	
-	html.WriteString(`<div id="severity-tab" class="tab-pane">
-		<h2>⚠️ 심각도별 분석</h2>`)
-
-	issuesBySeverity := r.groupIssuesBySeverity(result.Issues)
-	severityOrder := []config.Severity{
-		config.SeverityCritical,
-		config.SeverityHigh,
-		config.SeverityMedium,
-		config.SeverityLow,
-	}
-
-	for _, severity := range severityOrder {
-		issues, exists := issuesBySeverity[severity]
-		if !exists || len(issues) == 0 {
-			continue
-		}
-
-		html.WriteString(`<div class="collapsible" onclick="toggleCollapsible(this)">
-			<h3><span class="severity-badge ` + severity.String() + `">` + strings.ToUpper(severity.String()) + `</span> (` + fmt.Sprintf("%d", len(issues)) + `개 이슈)</h3>
-		</div>
-		<div class="collapsible-content">`)
-
-		for _, issue := range issues {
-			html.WriteString(`
-			<div class="issue ` + issue.Severity.String() + `">
-				<div class="file-path">` + issue.File + `:` + fmt.Sprintf("%d", issue.Line) + `:` + fmt.Sprintf("%d", issue.Column) + `</div>
-				<h4>` + issue.Message + `</h4>
-				<p><strong>규칙:</strong> ` + issue.RuleID + `</p>
-				<p><strong>카테고리:</strong> ` + issue.Category + `</p>`)
-
-			if issue.Description != "" {
-				html.WriteString(`<p><strong>설명:</strong> ` + issue.Description + `</p>`)
-			}
-			if issue.Suggestion != "" {
-				html.WriteString(`<p><strong>💡 권장사항:</strong> ` + issue.Suggestion + `</p>`)
-			}
-			if issue.CodeSnippet != "" {
-				html.WriteString(`<div class="code-snippet">` + issue.CodeSnippet + `</div>`)
-			}
-
-			html.WriteString(`</div>`)
-		}
-
-		html.WriteString(`</div>`)
-	}
-
-	html.WriteString(`</div>`)
-	return html.String()
-}
-
-func (r *HTMLReporter) generateFilesTab(result *types.AnalysisResult) string {
-	var html strings.Builder
-	
-	html.WriteString(`<div id="files-tab" class="tab-pane">
-		<h2>📁 파일별 분석</h2>`)
-
-	issuesByFile := r.groupIssuesByFile(result.Issues)
-	if len(issuesByFile) > 0 {
-		for file, issues := range issuesByFile {
-			html.WriteString(`<div class="collapsible" onclick="toggleCollapsible(this)">
-				<h3>` + file + ` (` + fmt.Sprintf("%d", len(issues)) + `개 이슈)</h3>
-			</div>
-			<div class="collapsible-content">`)
-
-			for _, issue := range issues {
-				html.WriteString(`
-				<div class="issue ` + issue.Severity.String() + `">
-					<div class="file-path">Line ` + fmt.Sprintf("%d", issue.Line) + `, Column ` + fmt.Sprintf("%d", issue.Column) + `</div>
-					<h4>` + issue.Message + ` <span class="severity-badge ` + issue.Severity.String() + `">` + strings.ToUpper(issue.Severity.String()) + `</span></h4>
-					<p><strong>규칙:</strong> ` + issue.RuleID + `</p>
-					<p><strong>카테고리:</strong> ` + issue.Category + `</p>`)
-
-				if issue.Description != "" {
-					html.WriteString(`<p><strong>설명:</strong> ` + issue.Description + `</p>`)
-				}
-				if issue.Suggestion != "" {
-					html.WriteString(`<p><strong>💡 권장사항:</strong> ` + issue.Suggestion + `</p>`)
-				}
-				if issue.CodeSnippet != "" {
-					html.WriteString(`<div class="code-snippet">` + issue.CodeSnippet + `</div>`)
-				}
-
-				html.WriteString(`</div>`)
-			}
-
-			html.WriteString(`</div>`)
-		}
-	} else {
-		html.WriteString(`<p>✅ 발견된 이슈가 없습니다!</p>`)
-	}
-
-	html.WriteString(`</div>`)
-	return html.String()
-}
-
-func (r *HTMLReporter) groupIssuesByRule(issues []types.Issue) map[string][]types.Issue {
-	grouped := make(map[string][]types.Issue)
-	for _, issue := range issues {
-		grouped[issue.RuleID] = append(grouped[issue.RuleID], issue)
-	}
-	return grouped
-}
-
-func (r *HTMLReporter) groupIssuesByFile(issues []types.Issue) map[string][]types.Issue {
-	grouped := make(map[string][]types.Issue)
-	for _, issue := range issues {
-		grouped[issue.File] = append(grouped[issue.File], issue)
-	}
-	return grouped
-}
-
-func (r *HTMLReporter) groupIssuesBySeverity(issues []types.Issue) map[config.Severity][]types.Issue {
-	grouped := make(map[config.Severity][]types.Issue)
-	
-	for _, issue := range issues {
-		grouped[issue.Severity] = append(grouped[issue.Severity], issue)
-	}
-	
-	return grouped
-}
-
-func (r *HTMLReporter) writeToFile(content string, filename string) error {
-	return os.WriteFile(filename, []byte(content), 0644)
 }
\ No newline at end of file
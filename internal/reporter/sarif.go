@@ -0,0 +1,347 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// SARIF 2.1.0 (GitHub Code Scanning, IDE 연동용) 출력 형식
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifSrcRoot = "%SRCROOT%"
+)
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool       sarifTool       `json:"tool"`
+	Results    []sarifResult   `json:"results"`
+	Properties *types.Metadata `json:"properties,omitempty"` // 도구 버전, 규칙 세트 해시, 커밋, 서명
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name    string      `json:"name"`
+	Version string      `json:"version,omitempty"`
+	Rules   []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifText          `json:"shortDescription"`
+	FullDescription      *sarifText         `json:"fullDescription,omitempty"`
+	Help                 *sarifText         `json:"help,omitempty"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+	Properties           sarifRuleProps     `json:"properties"`
+}
+
+type sarifRuleProps struct {
+	Tags             []string `json:"tags,omitempty"`
+	Category         string   `json:"category,omitempty"`
+	OWASP            string   `json:"owasp,omitempty"`
+	SecuritySeverity string   `json:"security-severity,omitempty"` // GitHub Code Scanning의 보안 심각도 (0.0~10.0)
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifText struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID              string             `json:"ruleId"`
+	RuleIndex           int                `json:"ruleIndex"`
+	Level               string             `json:"level"`
+	Message             sarifText          `json:"message"`
+	Locations           []sarifLocation    `json:"locations"`
+	Fingerprints        map[string]string  `json:"fingerprints,omitempty"` // 같은 코드가 반복된 이슈도 구분하는 고유 ID
+	PartialFingerprints map[string]string  `json:"partialFingerprints,omitempty"`
+	Fixes               []sarifFix         `json:"fixes,omitempty"`
+	Suppressions        []sarifSuppression `json:"suppressions,omitempty"`
+	Properties          *sarifResultProps  `json:"properties,omitempty"`
+}
+
+// sarifResultProps 결과의 우선순위 점수와 예상 수정 시간
+type sarifResultProps struct {
+	Priority      int `json:"priority,omitempty"`
+	EffortMinutes int `json:"effortMinutes,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine   int        `json:"startLine,omitempty"`
+	StartColumn int        `json:"startColumn,omitempty"`
+	EndLine     int        `json:"endLine,omitempty"`
+	Snippet     *sarifText `json:"snippet,omitempty"`
+}
+
+type sarifFix struct {
+	Description     sarifText             `json:"description"`
+	ArtifactChanges []sarifArtifactChange `json:"artifactChanges"`
+}
+
+type sarifArtifactChange struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Replacements     []sarifReplacement    `json:"replacements"`
+}
+
+type sarifReplacement struct {
+	DeletedRegion   sarifRegion `json:"deletedRegion"`
+	InsertedContent *sarifText  `json:"insertedContent,omitempty"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"` // inSource(인라인 주석) 또는 external(베이스라인/트리아지)
+	Justification string `json:"justification,omitempty"`
+}
+
+// SARIFReporter SARIF 2.1.0 출력 리포터
+type SARIFReporter struct{}
+
+func (r *SARIFReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	jsonData, err := json.MarshalIndent(newSARIFLog(result), "", "  ")
+	if err != nil {
+		return fmt.Errorf("SARIF 마샬링 실패: %w", err)
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, jsonData, 0644)
+	}
+	fmt.Print(string(jsonData))
+	return nil
+}
+
+// newSARIFLog 분석 결과를 SARIF 로그로 변환
+// 규칙 메타데이터는 규칙 ID 순으로 정렬해 실행마다 ruleIndex가 바뀌지 않게 합니다
+func newSARIFLog(result *types.AnalysisResult) *sarifLog {
+	// 억제된 이슈도 suppressions와 함께 포함 (만료된 억제는 Issues에 이미 있음)
+	type entry struct {
+		issue      types.Issue
+		suppressed *types.SuppressedIssue
+	}
+	var entries []entry
+	for _, issue := range result.Issues {
+		entries = append(entries, entry{issue: issue})
+	}
+	for i := range result.Suppressed {
+		if !result.Suppressed[i].Expired {
+			entries = append(entries, entry{issue: result.Suppressed[i].Issue, suppressed: &result.Suppressed[i]})
+		}
+	}
+
+	rulesByID := make(map[string]types.Issue)
+	for _, e := range entries {
+		if _, ok := rulesByID[e.issue.RuleID]; !ok {
+			rulesByID[e.issue.RuleID] = e.issue
+		}
+	}
+	ruleIDs := make([]string, 0, len(rulesByID))
+	for id := range rulesByID {
+		ruleIDs = append(ruleIDs, id)
+	}
+	sort.Strings(ruleIDs)
+
+	run := sarifRun{
+		Tool:       sarifTool{Driver: sarifDriver{Name: "Code Quality Checker", Rules: []sarifRule{}}},
+		Results:    []sarifResult{},
+		Properties: result.Metadata,
+	}
+	if result.Metadata != nil {
+		run.Tool.Driver.Version = result.Metadata.ToolVersion
+	}
+	ruleIndex := make(map[string]int)
+	for i, id := range ruleIDs {
+		ruleIndex[id] = i
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, newSARIFRule(rulesByID[id]))
+	}
+
+	for _, e := range entries {
+		res := newSARIFResult(e.issue, ruleIndex[e.issue.RuleID])
+		if e.suppressed != nil {
+			res.Suppressions = []sarifSuppression{newSARIFSuppression(e.suppressed)}
+		}
+		run.Results = append(run.Results, res)
+	}
+
+	return &sarifLog{Version: sarifVersion, Schema: sarifSchema, Runs: []sarifRun{run}}
+}
+
+// newSARIFRule 규칙의 첫 이슈로 규칙 메타데이터 구성 (심각도가 상향된 이슈는 원래 심각도 사용)
+func newSARIFRule(issue types.Issue) sarifRule {
+	severity := issue.Severity
+	if issue.EscalatedFrom != nil {
+		severity = *issue.EscalatedFrom
+	}
+	rule := sarifRule{
+		ID:                   issue.RuleID,
+		ShortDescription:     sarifText{Text: issue.RuleID},
+		DefaultConfiguration: sarifConfiguration{Level: sarifLevel(severity)},
+		Properties: sarifRuleProps{
+			Category: issue.Category,
+			OWASP:    issue.OWASP,
+		},
+	}
+	if issue.Description != "" {
+		rule.ShortDescription = sarifText{Text: issue.Description}
+		rule.FullDescription = &sarifText{Text: issue.Description}
+	}
+	if issue.Suggestion != "" {
+		rule.Help = &sarifText{Text: issue.Suggestion}
+	}
+
+	if issue.Category != "" {
+		rule.Properties.Tags = append(rule.Properties.Tags, issue.Category)
+	}
+	for _, cwe := range issue.CWE {
+		rule.Properties.Tags = append(rule.Properties.Tags, "external/cwe/"+strings.ToLower(cwe))
+	}
+	if issue.Category == "security" || len(issue.CWE) > 0 {
+		if issue.Category != "security" {
+			rule.Properties.Tags = append(rule.Properties.Tags, "security")
+		}
+		rule.Properties.SecuritySeverity = sarifSecuritySeverity(severity)
+	}
+
+	return rule
+}
+
+// newSARIFResult 이슈를 SARIF 결과로 변환 (권장 수정 방법은 메시지 뒤에 덧붙임)
+func newSARIFResult(issue types.Issue, ruleIndex int) sarifResult {
+	message := issue.Message
+	if issue.Suggestion != "" {
+		message += "\n" + i18n.T("report.suggestion-label", issue.Suggestion)
+	}
+
+	artifact := sarifArtifact(issue.File)
+	location := sarifPhysicalLocation{ArtifactLocation: artifact}
+	if issue.Line > 0 {
+		location.Region = &sarifRegion{StartLine: issue.Line, StartColumn: issue.Column}
+		if issue.CodeSnippet != "" {
+			location.Region.Snippet = &sarifText{Text: issue.CodeSnippet}
+		}
+	}
+
+	result := sarifResult{
+		RuleID:    issue.RuleID,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(issue.Severity),
+		Message:   sarifText{Text: message},
+		Locations: []sarifLocation{{PhysicalLocation: location}},
+		PartialFingerprints: map[string]string{
+			"cqcFingerprint/v2": issueFingerprint(issue),
+		},
+	}
+	if issue.ID != "" {
+		result.Fingerprints = map[string]string{"cqcIssueId/v1": issue.ID}
+	}
+	if issue.Priority > 0 {
+		result.Properties = &sarifResultProps{Priority: issue.Priority, EffortMinutes: issue.EffortMinutes}
+	}
+
+	if fix := issue.Fix; fix != nil {
+		replacement := sarifReplacement{
+			DeletedRegion: sarifRegion{StartLine: fix.StartLine, EndLine: fix.EndLine},
+		}
+		if fix.Replacement != "" {
+			replacement.InsertedContent = &sarifText{Text: fix.Replacement}
+		}
+		result.Fixes = []sarifFix{{
+			Description:     sarifText{Text: issue.Suggestion},
+			ArtifactChanges: []sarifArtifactChange{{ArtifactLocation: artifact, Replacements: []sarifReplacement{replacement}}},
+		}}
+	}
+
+	return result
+}
+
+// newSARIFSuppression 억제 출처를 SARIF suppression으로 변환
+func newSARIFSuppression(suppressed *types.SuppressedIssue) sarifSuppression {
+	suppression := sarifSuppression{Kind: "external", Justification: suppressionText(*suppressed)}
+	if suppressed.Source == types.SuppressionInline {
+		suppression.Kind = "inSource"
+	}
+	return suppression
+}
+
+// sarifArtifact 파일 경로를 SARIF 위치로 변환
+// 작업 디렉터리 아래 파일은 %SRCROOT% 기준 상대 경로로 기록해 Code Scanning이 저장소 파일과 연결할 수 있게 합니다
+func sarifArtifact(path string) sarifArtifactLocation {
+	path = workdirRelative(path)
+	if filepath.IsAbs(path) {
+		uri := filepath.ToSlash(path)
+		if !strings.HasPrefix(uri, "/") {
+			uri = "/" + uri // Windows 드라이브 경로 (file:///C:/...)
+		}
+		return sarifArtifactLocation{URI: "file://" + uri}
+	}
+	return sarifArtifactLocation{URI: filepath.ToSlash(filepath.Clean(path)), URIBaseID: sarifSrcRoot}
+}
+
+// workdirRelative 작업 디렉터리 아래의 절대 경로를 상대 경로로 변환 (CI 도구가 저장소 파일과 연결할 수 있도록)
+func workdirRelative(path string) string {
+	if filepath.IsAbs(path) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+				return rel
+			}
+		}
+	}
+	return path
+}
+
+// sarifLevel 심각도를 SARIF 레벨로 변환
+func sarifLevel(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical, config.SeverityHigh:
+		return "error"
+	case config.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// sarifSecuritySeverity 심각도를 GitHub Code Scanning 보안 심각도 점수로 변환
+func sarifSecuritySeverity(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical:
+		return "9.5"
+	case config.SeverityHigh:
+		return "8.0"
+	case config.SeverityMedium:
+		return "5.5"
+	default:
+		return "3.0"
+	}
+}
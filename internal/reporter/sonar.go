@@ -0,0 +1,116 @@
+package reporter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// SonarQube 일반 외부 이슈 형식 (sonar.externalIssuesReportPaths로 가져옴)
+type sonarReport struct {
+	Issues []sonarIssue `json:"issues"`
+}
+
+type sonarIssue struct {
+	EngineID        string        `json:"engineId"`
+	RuleID          string        `json:"ruleId"`
+	Severity        string        `json:"severity"`
+	Type            string        `json:"type"`
+	PrimaryLocation sonarLocation `json:"primaryLocation"`
+	EffortMinutes   int           `json:"effortMinutes,omitempty"`
+}
+
+type sonarLocation struct {
+	Message   string          `json:"message"`
+	FilePath  string          `json:"filePath"`
+	TextRange *sonarTextRange `json:"textRange,omitempty"`
+}
+
+// sonarTextRange 이슈 위치 (열은 끝 위치 없이 지정하면 무시되므로 라인 전체를 표시)
+type sonarTextRange struct {
+	StartLine int `json:"startLine"`
+}
+
+// sonarEngineID SonarQube 화면에 표시되는 외부 분석기 이름
+const sonarEngineID = "cqc"
+
+// 카테고리별 SonarQube 이슈 유형 (없으면 CODE_SMELL)
+var sonarTypes = map[string]string{
+	"security":    "VULNERABILITY",
+	"reliability": "BUG",
+	"transaction": "BUG",
+}
+
+// SonarReporter SonarQube 일반 외부 이슈 JSON 출력 리포터
+type SonarReporter struct{}
+
+func (r *SonarReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	report := sonarReport{Issues: []sonarIssue{}}
+	for _, issue := range result.Issues {
+		report.Issues = append(report.Issues, newSonarIssue(issue))
+	}
+
+	jsonData, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("SonarQube JSON 마샬링 실패: %w", err)
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, jsonData, 0644)
+	}
+	fmt.Println(string(jsonData))
+	return nil
+}
+
+// newSonarIssue 이슈를 SonarQube 외부 이슈로 변환 (filePath는 sonar.projectBaseDir 기준 상대 경로)
+func newSonarIssue(issue types.Issue) sonarIssue {
+	entry := sonarIssue{
+		EngineID:      sonarEngineID,
+		RuleID:        issue.RuleID,
+		Severity:      sonarSeverity(issue.Severity),
+		Type:          sonarType(issue.Category),
+		EffortMinutes: issue.EffortMinutes,
+		PrimaryLocation: sonarLocation{
+			Message:  issue.Message,
+			FilePath: filepath.ToSlash(workdirRelative(issue.File)),
+		},
+	}
+
+	// 예상 수정 시간이 없는 이전 버전 결과는 심각도로 추정
+	if entry.EffortMinutes == 0 {
+		entry.EffortMinutes = types.EstimateEffort(issue.Severity)
+	}
+
+	// 라인이 없는 이슈(파일 단위)는 파일에 붙임
+	if issue.Line > 0 {
+		entry.PrimaryLocation.TextRange = &sonarTextRange{StartLine: issue.Line}
+	}
+
+	return entry
+}
+
+// sonarSeverity 심각도를 SonarQube 심각도로 변환
+func sonarSeverity(severity config.Severity) string {
+	switch severity {
+	case config.SeverityCritical:
+		return "BLOCKER"
+	case config.SeverityHigh:
+		return "CRITICAL"
+	case config.SeverityMedium:
+		return "MAJOR"
+	default:
+		return "MINOR"
+	}
+}
+
+// sonarType 카테고리를 SonarQube 이슈 유형으로 변환
+func sonarType(category string) string {
+	if issueType, ok := sonarTypes[category]; ok {
+		return issueType
+	}
+	return "CODE_SMELL"
+}
@@ -0,0 +1,85 @@
+package reporter
+
+import (
+	"os"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+)
+
+// sourceMaxBytes 소스 보기에 원문을 싣는 파일 크기 상한 (리포트가 지나치게 커지지 않도록)
+const sourceMaxBytes = 512 * 1024
+
+// sourceFile HTML 리포트 소스 보기 탭의 파일 (원문을 싣지 않으면 Skipped에 이유)
+type sourceFile struct {
+	File    string
+	Issues  int
+	Lines   []sourceLine
+	Skipped string
+}
+
+// sourceLine 소스 라인과 그 라인의 이슈 (Severity는 가장 높은 심각도, 이슈가 없으면 0)
+type sourceLine struct {
+	Number   int
+	Text     string
+	Issues   []types.Issue
+	Severity config.Severity
+}
+
+// newSourceFiles 이슈가 있는 파일의 원문을 읽어 라인별로 이슈를 붙임 (파일 경로 순)
+// 스니펫을 가린 이슈가 있는 파일은 비밀 값이 원문으로 새지 않도록 싣지 않습니다
+func newSourceFiles(files []issueGroup) []sourceFile {
+	var sources []sourceFile
+	for _, group := range files {
+		source := sourceFile{File: group.Key, Issues: len(group.Issues)}
+		sources = append(sources, source)
+		i := len(sources) - 1
+
+		if redacted(group.Issues) {
+			sources[i].Skipped = i18n.T("html-report.source-redacted")
+			continue
+		}
+		info, err := os.Stat(group.Key)
+		if err != nil {
+			sources[i].Skipped = i18n.T("html-report.source-missing")
+			continue
+		}
+		if info.Size() > sourceMaxBytes {
+			sources[i].Skipped = i18n.T("html-report.source-too-large")
+			continue
+		}
+		content, err := os.ReadFile(group.Key)
+		if err != nil {
+			sources[i].Skipped = i18n.T("html-report.source-unreadable")
+			continue
+		}
+
+		byLine := make(map[int][]types.Issue)
+		for _, issue := range group.Issues {
+			byLine[issue.Line] = append(byLine[issue.Line], issue)
+		}
+		text := strings.ReplaceAll(string(content), "\r\n", "\n")
+		for n, line := range strings.Split(strings.TrimSuffix(text, "\n"), "\n") {
+			entry := sourceLine{Number: n + 1, Text: line, Issues: byLine[n+1]}
+			for _, issue := range entry.Issues {
+				if issue.Severity > entry.Severity {
+					entry.Severity = issue.Severity
+				}
+			}
+			sources[i].Lines = append(sources[i].Lines, entry)
+		}
+	}
+	return sources
+}
+
+// redacted 스니펫을 가린 이슈가 있는지 확인
+func redacted(issues []types.Issue) bool {
+	for _, issue := range issues {
+		if issue.Redacted {
+			return true
+		}
+	}
+	return false
+}
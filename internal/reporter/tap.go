@@ -0,0 +1,113 @@
+package reporter
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/types"
+
+	"gopkg.in/yaml.v3"
+)
+
+// tapDiagnostic TAP 13 YAML 진단 블록
+type tapDiagnostic struct {
+	ID         string `yaml:"id,omitempty"`
+	Rule       string `yaml:"rule"`
+	Severity   string `yaml:"severity"`
+	Category   string `yaml:"category"`
+	Priority   int    `yaml:"priority,omitempty"`
+	File       string `yaml:"file"`
+	Line       int    `yaml:"line"`
+	Column     int    `yaml:"column,omitempty"`
+	Suggestion string `yaml:"suggestion,omitempty"`
+	Code       string `yaml:"code,omitempty"`
+}
+
+// TAPReporter TAP(Test Anything Protocol) 버전 13 출력 리포터 (prove 등 TAP 하네스용)
+// 이슈마다 YAML 진단이 붙은 not ok 줄을, 억제된 이슈는 # SKIP 줄을 출력합니다
+type TAPReporter struct{}
+
+func (r *TAPReporter) Generate(result *types.AnalysisResult, outputFile string) error {
+	content, err := RenderTAP(result)
+	if err != nil {
+		return err
+	}
+
+	if outputFile != "" {
+		return os.WriteFile(outputFile, []byte(content), 0644)
+	}
+	fmt.Print(content)
+	return nil
+}
+
+// RenderTAP TAP 문자열 생성
+func RenderTAP(result *types.AnalysisResult) (string, error) {
+	var suppressed []types.SuppressedIssue
+	for _, s := range result.Suppressed {
+		if !s.Expired { // 만료된 억제는 Issues에 이미 있음
+			suppressed = append(suppressed, s)
+		}
+	}
+
+	var tap strings.Builder
+	tap.WriteString("TAP version 13\n")
+
+	// 이슈가 없으면 통과한 테스트 하나 (1..0은 하네스가 전체 건너뜀으로 처리)
+	total := len(result.Issues) + len(suppressed)
+	if total == 0 {
+		tap.WriteString("1..1\n")
+		tap.WriteString("ok 1 - " + i18n.T("report.tap-no-issues", result.Summary.TotalFiles) + "\n")
+		return tap.String(), nil
+	}
+	tap.WriteString(fmt.Sprintf("1..%d\n", total))
+
+	n := 0
+	for _, issue := range result.Issues {
+		n++
+		tap.WriteString(fmt.Sprintf("not ok %d - %s\n", n, tapDescription(issue)))
+		diagnostic, err := yaml.Marshal(newTAPDiagnostic(issue))
+		if err != nil {
+			return "", fmt.Errorf("TAP 진단 마샬링 실패: %w", err)
+		}
+		tap.WriteString("  ---\n")
+		for _, line := range strings.Split(strings.TrimRight(string(diagnostic), "\n"), "\n") {
+			tap.WriteString("  " + line + "\n")
+		}
+		tap.WriteString("  ...\n")
+	}
+	for _, s := range suppressed {
+		n++
+		tap.WriteString(fmt.Sprintf("ok %d - %s # SKIP %s\n", n, tapDescription(s.Issue), tapLine(suppressionText(s))))
+	}
+
+	return tap.String(), nil
+}
+
+// newTAPDiagnostic 이슈의 YAML 진단 정보
+func newTAPDiagnostic(issue types.Issue) tapDiagnostic {
+	return tapDiagnostic{
+		ID:         issue.ID,
+		Rule:       issue.RuleID,
+		Severity:   issue.Severity.String(),
+		Category:   issue.Category,
+		Priority:   issue.Priority,
+		File:       issue.File,
+		Line:       issue.Line,
+		Column:     issue.Column,
+		Suggestion: issue.Suggestion,
+		Code:       issue.CodeSnippet,
+	}
+}
+
+// tapDescription 테스트 줄 설명 ([규칙] 위치 메시지)
+func tapDescription(issue types.Issue) string {
+	return tapLine(fmt.Sprintf("[%s] %s:%d %s", issue.RuleID, issue.File, issue.Line, issue.Message))
+}
+
+// tapLine 한 줄 설명에서 TAP 지시어(#)와 줄바꿈이 해석되지 않도록 처리
+func tapLine(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	return strings.ReplaceAll(text, "#", "\\#")
+}
@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+var (
+	javaImportRegex = regexp.MustCompile(`^\s*import\s+(?:static\s+)?([\w.*]+)\s*;`)
+	jsImportRegex   = regexp.MustCompile(`(?:\bfrom\s*|\bimport\s*|\brequire\s*\(\s*)['"]([^'"]+)['"]`)
+)
+
+// BannedAPIRule 금지된 import/API 사용 검사 (설정 기반)
+type BannedAPIRule struct {
+	config config.RuleConfig
+	apis   []bannedAPI
+}
+
+// bannedAPI 컴파일된 금지 API 패턴
+type bannedAPI struct {
+	entry config.BannedEntry
+	regex *regexp.Regexp
+}
+
+func NewBannedAPIRule(cfg config.RuleConfig) Rule {
+	rule := &BannedAPIRule{config: cfg}
+	for _, entry := range cfg.Banned {
+		if entry.API == "" {
+			continue
+		}
+		// 잘못된 정규식은 설정 로드 시 오류로 보고됨
+		if regex, err := regexp.Compile(entry.API); err == nil {
+			rule.apis = append(rule.apis, bannedAPI{entry: entry, regex: regex})
+		}
+	}
+	return rule
+}
+
+func (r *BannedAPIRule) ID() string                { return r.config.ID }
+func (r *BannedAPIRule) Name() string              { return r.config.Name }
+func (r *BannedAPIRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *BannedAPIRule) Category() string          { return r.config.Category }
+func (r *BannedAPIRule) Description() string       { return r.config.Description }
+func (r *BannedAPIRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *BannedAPIRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	// 금지된 import 검사
+	for i, line := range file.Lines {
+		for _, module := range r.extractImports(file.Language, line) {
+			for _, entry := range r.config.Banned {
+				if entry.Import == "" || !r.matchesImport(entry.Import, module) {
+					continue
+				}
+
+				issues = append(issues, types.Issue{
+					RuleID:      r.ID(),
+					File:        file.Path,
+					Line:        i + 1,
+					Column:      strings.Index(line, module) + 1,
+					Severity:    r.Severity(),
+					Category:    r.Category(),
+					Message:     i18n.T("banned.import.message", module),
+					Description: r.describe(entry),
+					Suggestion:  r.suggest(entry),
+					CodeSnippet: strings.TrimSpace(line),
+					Params: map[string]string{
+						"value":       module,
+						"replacement": entry.Replacement,
+					},
+				})
+				break
+			}
+		}
+	}
+
+	// 금지된 API 호출 검사
+	for _, api := range r.apis {
+		matches := api.regex.FindAllStringIndex(file.Content, -1)
+		for _, match := range matches {
+			lineNum := file.LineAt(match[0])
+			line := strings.TrimSpace(getLineContent(file, lineNum))
+			if strings.HasPrefix(line, "//") || strings.HasPrefix(line, "*") || strings.HasPrefix(line, "import ") {
+				continue
+			}
+
+			issues = append(issues, types.Issue{
+				RuleID:      r.ID(),
+				File:        file.Path,
+				Line:        lineNum,
+				Column:      file.ColumnAt(match[0]),
+				Severity:    r.Severity(),
+				Category:    r.Category(),
+				Message:     i18n.T("banned.api.message", file.Content[match[0]:match[1]]),
+				Description: r.describe(api.entry),
+				Suggestion:  r.suggest(api.entry),
+				CodeSnippet: line,
+				Params: map[string]string{
+					"value":       file.Content[match[0]:match[1]],
+					"replacement": api.entry.Replacement,
+				},
+			})
+		}
+	}
+
+	return issues
+}
+
+// extractImports 라인에서 import 대상 패키지/모듈 추출
+func (r *BannedAPIRule) extractImports(language, line string) []string {
+	if language == "java" {
+		if match := javaImportRegex.FindStringSubmatch(line); len(match) > 1 {
+			return []string{match[1]}
+		}
+		return nil
+	}
+
+	var modules []string
+	for _, match := range jsImportRegex.FindAllStringSubmatch(line, -1) {
+		modules = append(modules, match[1])
+	}
+	return modules
+}
+
+// matchesImport 금지 항목과 import 대상 비교
+func (r *BannedAPIRule) matchesImport(banned, module string) bool {
+	if strings.HasSuffix(banned, ".") || strings.HasSuffix(banned, "/") {
+		return strings.HasPrefix(module, banned)
+	}
+	// 정확히 일치하거나 하위 클래스/경로인 경우
+	return module == banned ||
+		strings.HasPrefix(module, banned+".") ||
+		strings.HasPrefix(module, banned+"/")
+}
+
+func (r *BannedAPIRule) describe(entry config.BannedEntry) string {
+	if entry.Reason != "" {
+		return entry.Reason
+	}
+	return r.Description()
+}
+
+func (r *BannedAPIRule) suggest(entry config.BannedEntry) string {
+	if entry.Replacement == "" {
+		return i18n.T("banned.suggestion")
+	}
+	return i18n.T("banned.replacement", entry.Replacement)
+}
@@ -0,0 +1,758 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+)
+
+// RuleOption 규칙이 custom으로 받는 조정 가능한 옵션
+type RuleOption struct {
+	Name        string
+	Default     string // 비어있으면 기본값 없음
+	Description string
+}
+
+// RuleDoc 규칙 설명 문서 (cqc rules describe, cqc explain)
+type RuleDoc struct {
+	Rationale string               // 규칙이 왜 중요한지
+	Options   []RuleOption         // custom으로 조정 가능한 옵션
+	Examples  []config.RuleExample // 위반 코드와 수정 코드
+	Links     []string             // 참고 문서
+}
+
+// ruleEntry 기본 제공 규칙의 문서 원본
+// 규칙이 왜 중요한지와 옵션 설명은 출력 언어에 맞추기 위해 메시지 카탈로그(doc.rationale.<규칙 ID>, doc.option.<옵션>)에 둡니다
+type ruleEntry struct {
+	Options []RuleOption // 설명(Description)은 비워 두고 DocFor에서 채움
+	Bad     string       // 규칙을 위반하는 코드
+	Good    string       // 수정한 코드
+	Links   []string
+}
+
+// DocFor 규칙 설정과 기본 제공 문서를 합친 설명 문서
+// 설정 파일의 examples가 있으면 그 예시를, links와 CWE/OWASP가 있으면 참고 문서에 더합니다
+// 사용자 정의 규칙이나 규칙 팩 규칙은 설정의 설명과 예시만 사용합니다
+func DocFor(cfg config.RuleConfig) RuleDoc {
+	entry, builtin := ruleDocs[cfg.ID]
+	if cfg.Pack != "" {
+		entry, builtin = ruleEntry{}, false // 규칙 팩이 같은 ID를 다른 의미로 정의할 수 있음
+	}
+
+	doc := RuleDoc{Rationale: cfg.Description, Examples: cfg.Examples}
+	if builtin {
+		doc.Rationale = i18n.T("doc.rationale." + cfg.ID)
+	}
+	for _, option := range entry.Options {
+		option.Description = optionDescription(option.Name)
+		doc.Options = append(doc.Options, option)
+	}
+	if len(doc.Examples) == 0 && entry.Bad != "" {
+		doc.Examples = []config.RuleExample{{Bad: entry.Bad, Good: entry.Good}}
+	}
+
+	doc.Links = append(doc.Links, cfg.Links...)
+	for _, cwe := range cfg.CWE {
+		if id := strings.TrimPrefix(strings.ToUpper(cwe), "CWE-"); id != "" {
+			doc.Links = append(doc.Links, fmt.Sprintf("https://cwe.mitre.org/data/definitions/%s.html", id))
+		}
+	}
+	if cfg.OWASP != "" {
+		doc.Links = append(doc.Links, "https://owasp.org/Top10/")
+	}
+	for _, link := range entry.Links {
+		if !containsLink(doc.Links, link) {
+			doc.Links = append(doc.Links, link)
+		}
+	}
+	return doc
+}
+
+// optionDescription 현재 언어의 옵션 설명
+func optionDescription(name string) string {
+	switch {
+	case strings.HasPrefix(name, "pattern_"):
+		return i18n.T("doc.option.pattern")
+	case strings.HasSuffix(name, "_pattern"):
+		return i18n.T("doc.option.naming_pattern", strings.TrimSuffix(name, "_pattern"))
+	}
+	return i18n.T("doc.option." + name)
+}
+
+func containsLink(links []string, link string) bool {
+	for _, l := range links {
+		if l == link {
+			return true
+		}
+	}
+	return false
+}
+
+// 여러 규칙이 함께 쓰는 옵션
+var (
+	methodFilterOptions = []RuleOption{
+		{Name: "exclude_methods"},
+		{Name: "count", Default: countLines},
+	}
+	i18nOptions = []RuleOption{
+		{Name: "contexts", Default: strings.Join(defaultI18nContexts, ",")},
+		{Name: "attributes", Default: strings.Join(defaultI18nAttributes, ",")},
+		{Name: "min_words", Default: "2"},
+		{Name: "all_html", Default: "false"},
+	}
+	featureFlagOptions = []RuleOption{
+		{Name: "constants_class", Default: defaultFlagsClassName},
+		{Name: "flag_calls", Default: strings.Join(defaultFlagCalls, ",")},
+	}
+	nullSafetyOptions = []RuleOption{
+		{Name: "nullable_annotations", Default: strings.Join(defaultNullableAnnotations, ",")},
+		{Name: "nonnull_annotations", Default: strings.Join(defaultNonNullAnnotations, ",")},
+	}
+	scheduledOptions = []RuleOption{
+		{Name: "lock_annotations", Default: "SchedulerLock"},
+		{Name: "error_annotations"},
+	}
+	webPerformanceOptions = []RuleOption{
+		{Name: "max_external_resources", Default: "10"},
+		{Name: "max_inline_style_bytes", Default: "2048"},
+		{Name: "max_inline_script_bytes", Default: "4096"},
+		{Name: "max_inline_total_bytes", Default: "10240"},
+	}
+)
+
+// importOrderOptions import 순서 규칙 옵션 (기본 그룹은 언어마다 다름)
+func importOrderOptions(groups string) []RuleOption {
+	return []RuleOption{
+		{Name: "groups", Default: groups},
+		{Name: "sort_within_group", Default: "true"},
+		{Name: "blank_line_between_groups", Default: "true"},
+	}
+}
+
+// namingOptions 요소 유형별 명명 규칙 옵션 (<유형>_pattern)
+func namingOptions(defaults map[string]string, kinds ...string) []RuleOption {
+	options := make([]RuleOption, 0, len(kinds))
+	for _, kind := range kinds {
+		options = append(options, RuleOption{Name: kind + "_pattern", Default: defaults[kind]})
+	}
+	return options
+}
+
+// 여러 규칙이 함께 쓰는 참고 문서
+const (
+	linkXSSPrevention   = "https://cheatsheetseries.owasp.org/cheatsheets/Cross_Site_Scripting_Prevention_Cheat_Sheet.html"
+	linkSQLInjection    = "https://cheatsheetseries.owasp.org/cheatsheets/SQL_Injection_Prevention_Cheat_Sheet.html"
+	linkInputValidation = "https://cheatsheetseries.owasp.org/cheatsheets/Input_Validation_Cheat_Sheet.html"
+	linkTransactional   = "https://docs.spring.io/spring-framework/reference/data-access/transaction/declarative/annotations.html"
+	linkMethodSecurity  = "https://docs.spring.io/spring-security/reference/servlet/authorization/method-security.html"
+	linkBeanValidation  = "https://docs.spring.io/spring-framework/reference/core/validation/beanvalidation.html"
+	linkJavaTime        = "https://docs.oracle.com/javase/8/docs/api/java/time/package-summary.html"
+	linkSLF4J           = "https://www.slf4j.org/manual.html"
+	linkJavaStyle       = "https://google.github.io/styleguide/javaguide.html"
+	linkShedLock        = "https://github.com/lukas-krecan/ShedLock"
+	linkThymeleaf       = "https://www.thymeleaf.org/doc/tutorials/3.1/usingthymeleaf.html"
+	linkCLS             = "https://web.dev/articles/cls"
+)
+
+// ruleDocs 기본 제공 규칙별 문서 (이유, 옵션, 위반/수정 예시, 참고 문서)
+var ruleDocs = map[string]ruleEntry{
+	// Java
+	"java-transactional-missing": {
+		Bad: `@Service
+public class OrderService {
+    public void cancelOrder(Long id) {
+        orderRepository.deleteById(id);
+    }
+}`,
+		Good: `@Service
+public class OrderService {
+    @Transactional
+    public void cancelOrder(Long id) {
+        orderRepository.deleteById(id);
+    }
+}`,
+		Links: []string{linkTransactional},
+	},
+	"java-system-out": {
+		Bad: `System.out.println("user=" + user.getId());`,
+		Good: `private static final Logger log = LoggerFactory.getLogger(UserService.class);
+log.info("user={}", user.getId());`,
+		Links: []string{linkSLF4J},
+	},
+	"java-layer-architecture": {
+		Bad: `@RestController
+public class UserController {
+    @Autowired
+    private UserDao userDao;
+}`,
+		Good: `@RestController
+public class UserController {
+    private final UserService userService;
+}`,
+	},
+	"java-magic-number": {
+		Options: []RuleOption{
+			{Name: "allowed_numbers"},
+			{Name: "ignore_contexts"},
+			{Name: "constants_files"},
+		},
+		Bad: `if (retryCount > 7) {
+    Thread.sleep(3500);
+}`,
+		Good: `private static final int MAX_RETRIES = 7;
+private static final long RETRY_DELAY_MS = 3500;
+
+if (retryCount > MAX_RETRIES) {
+    Thread.sleep(RETRY_DELAY_MS);
+}`,
+	},
+	"java-method-length": {
+		Options: append([]RuleOption{{Name: "max_lines", Default: "100"}}, methodFilterOptions...),
+		Bad: `public void process() {
+    // ... 100라인을 넘는 본문
+}`,
+		Good: `public void process() {
+    Order order = loadOrder();
+    validate(order);
+    charge(order);
+}`,
+	},
+	"java-exception-handling": {
+		Bad: `try {
+    repository.save(order);
+} catch (Exception e) {
+}`,
+		Good: `try {
+    repository.save(order);
+} catch (DataAccessException e) {
+    log.error("주문 저장 실패: orderId={}", order.getId(), e);
+    throw new OrderSaveException(order.getId(), e);
+}`,
+	},
+	"java-input-validation": {
+		Bad: `@PostMapping("/users")
+public User create(@RequestBody UserRequest request) {
+    return userService.create(request);
+}`,
+		Good: `@PostMapping("/users")
+public User create(@Valid @RequestBody UserRequest request) {
+    return userService.create(request);
+}`,
+		Links: []string{linkInputValidation, linkBeanValidation},
+	},
+	"java-cyclomatic-complexity": {
+		Options: methodFilterOptions,
+		Bad: `public int fee(Order o) {
+    if (o.isVip()) { ... } else if (o.isNew()) { ... }
+    for (Item i : o.getItems()) { if (i.isGift() && !i.isPaid()) { ... } }
+    switch (o.getType()) { case A: ... case B: ... case C: ... }
+    // 분기가 10개를 넘는 메소드
+}`,
+		Good: `public int fee(Order o) {
+    return baseFee(o.getType()) + itemFees(o.getItems()) - discount(o);
+}`,
+	},
+	"java-duplicate-code": {
+		Options: []RuleOption{
+			{Name: "scope"},
+			{Name: "similarity", Default: "80"},
+			{Name: "min_method_lines", Default: "5"},
+			{Name: "block_size", Default: "5"},
+			{Name: "min_block_lines", Default: "3"},
+			{Name: "min_occurrences", Default: "2"},
+			{Name: "min_pattern_occurrences", Default: "3"},
+			{Name: "normalize", Default: normalizeIdentifiers},
+			{Name: "builtin_patterns"},
+			{Name: "pattern_<이름>"},
+		},
+		Bad: `if (user == null) {
+    throw new IllegalArgumentException("user is null");
+}
+// ... 같은 블록이 여러 메소드에 반복`,
+		Good: `Objects.requireNonNull(user, "user");`,
+	},
+	"java-coding-conventions": {
+		Options: namingOptions(defaultJavaNamingPatterns, "class", "method", "field", "constant", "package"),
+		Bad: `public class order_service {
+    public void Process_Order() { }
+}`,
+		Good: `public class OrderService {
+    public void processOrder() { }
+}`,
+		Links: []string{linkJavaStyle},
+	},
+	"java-banned-api": {
+		Bad:  `import org.apache.commons.lang.StringUtils;`,
+		Good: `import org.apache.commons.lang3.StringUtils;`,
+	},
+	"java-import-order": {
+		Options: importOrderOptions("java,javax,*"),
+		Bad: `import com.mycorp.order.Order;
+import java.util.List;`,
+		Good: `import java.util.List;
+
+import com.mycorp.order.Order;`,
+	},
+	"spring-validation-missing": {
+		Bad: `@PostMapping("/orders")
+public OrderResponse create(@RequestBody OrderRequest request) { ... }`,
+		Good: `@PostMapping("/orders")
+public OrderResponse create(@Valid @RequestBody OrderRequest request) { ... }`,
+		Links: []string{linkBeanValidation},
+	},
+	"spring-transactional-private": {
+		Bad: `@Transactional
+private void updateStock(Long itemId) { ... }`,
+		Good: `@Transactional
+public void updateStock(Long itemId) { ... }`,
+		Links: []string{linkTransactional},
+	},
+	"spring-transactional-rollback": {
+		Bad: `@Transactional
+public void importFile(Path path) throws IOException { ... }`,
+		Good: `@Transactional(rollbackFor = Exception.class)
+public void importFile(Path path) throws IOException { ... }`,
+		Links: []string{linkTransactional},
+	},
+	"spring-security-missing": {
+		Bad: `@DeleteMapping("/admin/users/{id}")
+public void deleteUser(@PathVariable Long id) { ... }`,
+		Good: `@PreAuthorize("hasRole('ADMIN')")
+@DeleteMapping("/admin/users/{id}")
+public void deleteUser(@PathVariable Long id) { ... }`,
+		Links: []string{linkMethodSecurity},
+	},
+	"spring-secured-deprecated": {
+		Bad: `@Secured("ROLE_ADMIN")
+public void deleteUser(Long id) { ... }`,
+		Good: `@PreAuthorize("hasRole('ADMIN')")
+public void deleteUser(Long id) { ... }`,
+		Links: []string{linkMethodSecurity},
+	},
+	"spring-field-injection": {
+		Bad: `@Autowired
+private UserRepository userRepository;`,
+		Good: `private final UserRepository userRepository;
+
+public UserService(UserRepository userRepository) {
+    this.userRepository = userRepository;
+}`,
+		Links: []string{"https://docs.spring.io/spring-framework/reference/core/beans/dependencies/factory-collaborators.html"},
+	},
+	"spring-controller-advice-missing": {
+		Bad: `@RestController
+public class OrderController {
+    @ExceptionHandler(OrderNotFoundException.class)
+    public ResponseEntity<?> handle(OrderNotFoundException e) { ... }
+}
+// 프로젝트에 @RestControllerAdvice 클래스가 없음`,
+		Good: `@RestControllerAdvice
+public class GlobalExceptionHandler {
+    @ExceptionHandler(OrderNotFoundException.class)
+    public ResponseEntity<ApiResponse<Void>> handle(OrderNotFoundException e) { ... }
+}`,
+		Links: []string{"https://docs.spring.io/spring-framework/reference/web/webmvc/mvc-controller/ann-advice.html"},
+	},
+	"spring-scheduled-error-handling": {
+		Options: scheduledOptions,
+		Bad: `@Scheduled(cron = "0 0 * * * *")
+public void syncOrders() {
+    orderClient.fetchAll().forEach(orderRepository::save);
+}`,
+		Good: `@Scheduled(cron = "0 0 * * * *")
+public void syncOrders() {
+    try {
+        orderClient.fetchAll().forEach(orderRepository::save);
+    } catch (Exception e) {
+        log.error("주문 동기화 실패", e);
+    }
+}`,
+	},
+	"spring-scheduled-lock-missing": {
+		Options: scheduledOptions,
+		Bad: `@Scheduled(fixedDelay = 60000)
+public void sendReminders() { ... }`,
+		Good: `@Scheduled(fixedDelay = 60000)
+@SchedulerLock(name = "sendReminders")
+public void sendReminders() { ... }`,
+		Links: []string{linkShedLock},
+	},
+	"spring-batch-fault-tolerance": {
+		Bad: `return stepBuilderFactory.get("importStep")
+    .<Row, Order>chunk(100)
+    .reader(reader).processor(processor).writer(writer)
+    .build();`,
+		Good: `return stepBuilderFactory.get("importStep")
+    .<Row, Order>chunk(100)
+    .reader(reader).processor(processor).writer(writer)
+    .faultTolerant()
+    .skip(ParseException.class).skipLimit(10)
+    .retry(TransientDataAccessException.class).retryLimit(3)
+    .build();`,
+	},
+	"spring-api-response-convention": {
+		Options: []RuleOption{
+			{Name: "wrapper_types", Default: strings.Join(defaultWrapperTypes, ",")},
+			{Name: "forbidden_types", Default: strings.Join(defaultForbiddenTypes, ",")},
+			{Name: "allowed_types", Default: strings.Join(defaultAllowedTypes, ",")},
+			{Name: "status_checks", Default: "post,delete"},
+		},
+		Bad: `@GetMapping("/users/{id}")
+public Map<String, Object> get(@PathVariable Long id) { ... }`,
+		Good: `@GetMapping("/users/{id}")
+public ApiResponse<UserResponse> get(@PathVariable Long id) { ... }`,
+	},
+	"spring-pagination-required": {
+		Options: []RuleOption{
+			{Name: "list_calls", Default: strings.Join(defaultListCalls, ",")},
+			{Name: "bounded_calls", Default: strings.Join(defaultBoundedCalls, ",")},
+			{Name: "pageable_types", Default: strings.Join(defaultPageableTypes, ",")},
+			{Name: "layers", Default: strings.Join(defaultPaginatedLayers, ",")},
+		},
+		Bad: `@GetMapping("/orders")
+public List<Order> list() {
+    return orderRepository.findAll();
+}`,
+		Good: `@GetMapping("/orders")
+public Page<Order> list(Pageable pageable) {
+    return orderRepository.findAll(pageable);
+}`,
+	},
+	"java-i18n-hardcoded-string": {
+		Options: i18nOptions,
+		Bad:     `model.addAttribute("message", "저장되었습니다");`,
+		Good:    `model.addAttribute("message", messageSource.getMessage("order.saved", null, locale));`,
+	},
+	"java-legacy-date-api": {
+		Bad:   `Date now = new Date();`,
+		Good:  `Instant now = Instant.now();`,
+		Links: []string{linkJavaTime},
+	},
+	"java-simple-date-format": {
+		Bad:   `SimpleDateFormat format = new SimpleDateFormat("yyyy-MM-dd");`,
+		Good:  `DateTimeFormatter format = DateTimeFormatter.ofPattern("yyyy-MM-dd", Locale.KOREA).withZone(ZoneId.of("Asia/Seoul"));`,
+		Links: []string{linkJavaTime},
+	},
+	"java-optional-get-unchecked": {
+		Options: nullSafetyOptions,
+		Bad:     `User user = userRepository.findById(id).get();`,
+		Good:    `User user = userRepository.findById(id).orElseThrow(() -> new UserNotFoundException(id));`,
+		Links:   []string{"https://docs.oracle.com/javase/8/docs/api/java/util/Optional.html"},
+	},
+	"java-null-collection-return": {
+		Options: nullSafetyOptions,
+		Bad: `public List<Order> findOrders(Long userId) {
+    if (userId == null) {
+        return null;
+    }
+    ...
+}`,
+		Good: `public List<Order> findOrders(Long userId) {
+    if (userId == null) {
+        return Collections.emptyList();
+    }
+    ...
+}`,
+	},
+	"java-nullable-inconsistency": {
+		Options: nullSafetyOptions,
+		Bad: `@Nullable
+public User findUser(Long id) { ... }
+
+findUser(id).getName();`,
+		Good: `User user = findUser(id);
+if (user != null) {
+    user.getName();
+}`,
+	},
+	"java-logging-convention": {
+		Options: []RuleOption{
+			{Name: "logger_factories", Default: strings.Join(defaultLoggerFactories, ",")},
+			{Name: "business_exceptions", Default: strings.Join(defaultBusinessExceptions, ",")},
+		},
+		Bad: `Logger log = Logger.getLogger("order");
+log.info("order=" + order.getId());`,
+		Good: `private static final Logger log = LoggerFactory.getLogger(OrderService.class);
+log.info("order={}", order.getId());`,
+		Links: []string{linkSLF4J},
+	},
+	"java-feature-flag-hygiene": {
+		Options: featureFlagOptions,
+		Bad:     `if (featureClient.isEnabled("new-checkout")) { ... }`,
+		Good:    `if (featureClient.isEnabled(FeatureFlags.NEW_CHECKOUT)) { ... }`,
+	},
+	"java-taint-flow": {
+		Bad: `String name = request.getParameter("name");
+jdbcTemplate.query("SELECT * FROM users WHERE name = '" + name + "'", mapper);`,
+		Good: `String name = request.getParameter("name");
+jdbcTemplate.query("SELECT * FROM users WHERE name = ?", mapper, name);`,
+		Links: []string{linkSQLInjection, linkXSSPrevention},
+	},
+
+	// JavaScript
+	"js-innerHTML-xss": {
+		Bad:   `element.innerHTML = userInput;`,
+		Good:  `element.textContent = userInput;`,
+		Links: []string{linkXSSPrevention, "https://developer.mozilla.org/en-US/docs/Web/API/Element/innerHTML#security_considerations"},
+	},
+	"js-memory-leak": {
+		Bad: `window.addEventListener("resize", onResize);
+setInterval(poll, 1000);
+// removeEventListener/clearInterval 없음`,
+		Good: `window.addEventListener("resize", onResize);
+const timer = setInterval(poll, 1000);
+
+function destroy() {
+  window.removeEventListener("resize", onResize);
+  clearInterval(timer);
+}`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/API/EventTarget/removeEventListener"},
+	},
+	"js-console-log": {
+		Bad:  `console.log("user", user);`,
+		Good: `logger.debug("user loaded", { id: user.id });`,
+	},
+	"js-var-usage": {
+		Bad:   `var count = 0;`,
+		Good:  `let count = 0;`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Statements/let"},
+	},
+	"js-function-length": {
+		Bad: `function render() {
+  // ... 100라인을 넘는 본문
+}`,
+		Good: `function render() {
+  const data = loadData();
+  renderHeader(data);
+  renderList(data.items);
+}`,
+	},
+	"js-banned-api": {
+		Bad:  `import moment from "moment";`,
+		Good: `import { format } from "date-fns";`,
+	},
+	"js-import-order": {
+		Options: importOrderOptions("*,@/,."),
+		Bad: `import { format } from "./format";
+import React from "react";`,
+		Good: `import React from "react";
+
+import { format } from "./format";`,
+	},
+	"js-naming-convention": {
+		Options: namingOptions(defaultJSNamingPatterns, "function"),
+		Bad:     `function Load_user_list() { }`,
+		Good:    `function loadUserList() { }`,
+	},
+	"js-strict-mode": {
+		Bad: `function init() {
+  total = 0;
+}`,
+		Good: `"use strict";
+
+function init() {
+  let total = 0;
+}`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Strict_mode"},
+	},
+	"js-global-variables": {
+		Bad: `count = 0;`,
+		Good: `const state = { count: 0 };
+export default state;`,
+	},
+	"js-callback-hell": {
+		Bad: `getUser(id, function (user) {
+  getOrders(user, function (orders) {
+    getItems(orders, function (items) {
+      render(items);
+    });
+  });
+});`,
+		Good: `const user = await getUser(id);
+const orders = await getOrders(user);
+render(await getItems(orders));`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/JavaScript/Guide/Using_promises"},
+	},
+	"js-unused-variables": {
+		Bad:  `const unused = computeTotal(items);`,
+		Good: `// 사용하지 않는 선언 삭제`,
+	},
+	"js-equality-operators": {
+		Bad:   `if (value == 0) { }`,
+		Good:  `if (value === 0) { }`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/JavaScript/Equality_comparisons_and_sameness"},
+	},
+	"js-i18n-hardcoded-string": {
+		Options: i18nOptions,
+		Bad:     `alert("저장되었습니다");`,
+		Good:    `alert(t("order.saved"));`,
+	},
+	"js-date-parsing": {
+		Bad:   `const date = new Date("2024-01-31");`,
+		Good:  `const date = parseISO("2024-01-31");`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/parse"},
+	},
+	"js-feature-flag-hygiene": {
+		Options: featureFlagOptions,
+		Bad:     `if (flags.isEnabled("new-checkout")) { }`,
+		Good:    `if (flags.isEnabled(FeatureFlags.NEW_CHECKOUT)) { }`,
+	},
+	"js-taint-flow": {
+		Bad: `const name = new URLSearchParams(location.search).get("name");
+element.innerHTML = name;`,
+		Good: `const name = new URLSearchParams(location.search).get("name");
+element.textContent = name;`,
+		Links: []string{linkXSSPrevention},
+	},
+
+	// HTML
+	"html-img-alt": {
+		Bad:   `<img src="logo.png">`,
+		Good:  `<img src="logo.png" alt="회사 로고">`,
+		Links: []string{"https://www.w3.org/WAI/tutorials/images/"},
+	},
+	"html-accessibility": {
+		Bad:   `<div onclick="save()">저장</div>`,
+		Good:  `<button type="button" onclick="save()">저장</button>`,
+		Links: []string{"https://www.w3.org/WAI/ARIA/apg/"},
+	},
+	"html-seo": {
+		Bad: `<head>
+  <!-- title, meta description 없음 -->
+</head>`,
+		Good: `<head>
+  <title>주문 내역 | MyShop</title>
+  <meta name="description" content="최근 주문과 배송 상태를 확인하세요">
+</head>`,
+		Links: []string{"https://developers.google.com/search/docs/fundamentals/seo-starter-guide"},
+	},
+	"html-semantic-markup": {
+		Bad: `<div class="header">...</div>
+<div class="nav">...</div>`,
+		Good: `<header>...</header>
+<nav>...</nav>`,
+	},
+	"html-validation": {
+		Bad:   `<div><p>닫히지 않은 태그</div>`,
+		Good:  `<div><p>닫힌 태그</p></div>`,
+		Links: []string{"https://validator.w3.org/"},
+	},
+	"html-deprecated-tags": {
+		Bad:   `<center><font color="red">공지</font></center>`,
+		Good:  `<p class="notice">공지</p>`,
+		Links: []string{"https://html.spec.whatwg.org/multipage/obsolete.html"},
+	},
+	"html-inline-styles": {
+		Bad:  `<p style="color: red; margin-top: 10px">공지</p>`,
+		Good: `<p class="notice">공지</p>`,
+	},
+	"html-form-labels": {
+		Bad: `<input type="text" name="email">`,
+		Good: `<label for="email">이메일</label>
+<input type="text" id="email" name="email">`,
+		Links: []string{"https://www.w3.org/WAI/tutorials/forms/labels/"},
+	},
+	"html-i18n-hardcoded-string": {
+		Options: i18nOptions,
+		Bad:     `<button title="주문 취소">취소</button>`,
+		Good:    `<button th:title="#{order.cancel.title}" th:text="#{order.cancel}"></button>`,
+	},
+	"html-template-unescaped-output": {
+		Bad:   `<p th:utext="${comment.body}"></p>`,
+		Good:  `<p th:text="${comment.body}"></p>`,
+		Links: []string{linkXSSPrevention, linkThymeleaf},
+	},
+	"html-render-blocking-script": {
+		Options: webPerformanceOptions,
+		Bad: `<head>
+  <script src="/js/app.js"></script>
+</head>`,
+		Good: `<head>
+  <script src="/js/app.js" defer></script>
+</head>`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/HTML/Element/script#defer"},
+	},
+	"html-img-dimensions": {
+		Options: webPerformanceOptions,
+		Bad:     `<img src="banner.png" alt="배너">`,
+		Good:    `<img src="banner.png" alt="배너" width="1200" height="400">`,
+		Links:   []string{linkCLS},
+	},
+	"html-external-resource-limit": {
+		Options: webPerformanceOptions,
+		Bad: `<link rel="stylesheet" href="a.css">
+<link rel="stylesheet" href="b.css">
+<!-- ... 외부 CSS/JS가 10개 초과 -->`,
+		Good: `<link rel="stylesheet" href="bundle.css">
+<script src="bundle.js" defer></script>`,
+	},
+	"html-inline-code-budget": {
+		Options: webPerformanceOptions,
+		Bad: `<style>
+  /* ... 2KB를 넘는 인라인 스타일 */
+</style>`,
+		Good: `<link rel="stylesheet" href="page.css">`,
+	},
+
+	// CSS
+	"css-selectors": {
+		Bad:  `body div ul li a span { color: red; }`,
+		Good: `.nav-link-label { color: red; }`,
+	},
+	"css-responsive-design": {
+		Bad: `.container { width: 1200px; }`,
+		Good: `.container { max-width: 1200px; width: 100%; }
+
+@media (max-width: 768px) {
+  .container { padding: 0 16px; }
+}`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/CSS/CSS_media_queries/Using_media_queries"},
+	},
+	"css-naming-convention": {
+		Options: namingOptions(defaultCSSNamingPatterns, "class"),
+		Bad:     `.MainHeader_Title { }`,
+		Good:    `.main-header__title { }`,
+		Links:   []string{"https://getbem.com/naming/"},
+	},
+	"css-vendor-prefixes": {
+		Bad:   `.box { user-select: none; }`,
+		Good:  `.box { -webkit-user-select: none; user-select: none; }`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Glossary/Vendor_Prefix"},
+	},
+	"css-unused-styles": {
+		Bad:  `.legacy-banner { display: none; }`,
+		Good: `/* 사용하지 않는 규칙 삭제 */`,
+	},
+	"css-important-overuse": {
+		Bad:   `.title { color: red !important; margin: 0 !important; }`,
+		Good:  `.page .title { color: red; margin: 0; }`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/CSS/Specificity"},
+	},
+	"css-font-fallbacks": {
+		Bad:   `body { font-family: "Noto Sans KR"; }`,
+		Good:  `body { font-family: "Noto Sans KR", "Malgun Gothic", sans-serif; }`,
+		Links: []string{"https://developer.mozilla.org/en-US/docs/Web/CSS/font-family"},
+	},
+	"css-color-contrast": {
+		Bad:   `.hint { color: #aaa; background: #fff; }`,
+		Good:  `.hint { color: #595959; background: #fff; }`,
+		Links: []string{"https://www.w3.org/WAI/WCAG21/Understanding/contrast-minimum.html"},
+	},
+
+	// 의존성 매니페스트
+	"manifest-dependency-policy": {
+		Bad: `<dependency>
+  <groupId>org.apache.logging.log4j</groupId>
+  <artifactId>log4j-core</artifactId>
+  <version>2.14.1</version>
+</dependency>`,
+		Good: `<dependency>
+  <groupId>org.apache.logging.log4j</groupId>
+  <artifactId>log4j-core</artifactId>
+  <version>2.17.1</version>
+</dependency>`,
+		Links: []string{"https://nvd.nist.gov/vuln/detail/CVE-2021-44228"},
+	},
+}
@@ -5,10 +5,24 @@ import (
 	"strings"
 
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
 	"code-quality-checker/internal/parser"
 	"code-quality-checker/internal/types"
 )
 
+// CSS 규칙에서 사용하는 정규식
+var (
+	tagNameRegex      = regexp.MustCompile(`^[a-z]+$`)
+	cssRuleBlockRegex = regexp.MustCompile(`([^{}]+)\s*\{([^{}]*)\}`)
+	whitespaceRegex   = regexp.MustCompile(`\s+`)
+	mediaQueryRegex   = regexp.MustCompile(`@media\s*\([^)]+\)`)
+	fixedWidthRegex   = regexp.MustCompile(`width\s*:\s*\d+px`)
+	viewportRegex     = regexp.MustCompile(`<meta[^>]*name\s*=\s*["']viewport["']`)
+	flexGridRegex     = regexp.MustCompile(`display\s*:\s*(flex|grid)`)
+	layoutRegex       = regexp.MustCompile(`(width|height|margin|padding|position)\s*:`)
+	pxValueRegex      = regexp.MustCompile(`:\s*\d+px`)
+)
+
 // CSSSelectorsRule CSS 셀렉터 효율성 검사
 type CSSSelectorsRule struct {
 	config config.RuleConfig
@@ -23,6 +37,7 @@ func (r *CSSSelectorsRule) Name() string               { return r.config.Name }
 func (r *CSSSelectorsRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *CSSSelectorsRule) Category() string          { return r.config.Category }
 func (r *CSSSelectorsRule) Description() string       { return r.config.Description }
+func (r *CSSSelectorsRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *CSSSelectorsRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -55,9 +70,9 @@ func (r *CSSSelectorsRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      0,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "과도하게 중첩된 CSS 셀렉터입니다",
-				Description: "깊은 중첩은 CSS 성능을 저하시키고 유지보수를 어렵게 합니다",
-				Suggestion:  "셀렉터 중첩을 3단계 이하로 줄이세요",
+				Message:     i18n.T("css.nested-selector.message"),
+				Description: i18n.T("css.nested-selector.description"),
+				Suggestion:  i18n.T("css.nested-selector.suggestion"),
 				CodeSnippet: selector,
 			})
 		}
@@ -71,9 +86,9 @@ func (r *CSSSelectorsRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      0,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "전체 셀렉터(*) 사용이 발견되었습니다",
-				Description: "전체 셀렉터는 모든 요소를 검사하여 성능을 저하시킵니다",
-				Suggestion:  "더 구체적인 셀렉터를 사용하세요",
+				Message:     i18n.T("css.universal-selector.message"),
+				Description: i18n.T("css.universal-selector.description"),
+				Suggestion:  i18n.T("css.universal-selector.suggestion"),
 				CodeSnippet: selector,
 			})
 		}
@@ -87,9 +102,9 @@ func (r *CSSSelectorsRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      0,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "비효율적인 자손 셀렉터입니다",
-				Description: "태그명으로 시작하는 복잡한 셀렉터는 성능이 떨어집니다",
-				Suggestion:  "클래스나 ID로 시작하는 셀렉터를 사용하세요",
+				Message:     i18n.T("css.descendant-selector.message"),
+				Description: i18n.T("css.descendant-selector.description"),
+				Suggestion:  i18n.T("css.descendant-selector.suggestion"),
 				CodeSnippet: selector,
 			})
 		}
@@ -103,9 +118,9 @@ func (r *CSSSelectorsRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      0,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "여러 ID 셀렉터가 사용되었습니다",
-				Description: "ID는 문서에서 고유해야 하므로 여러 ID 셀렉터는 불필요합니다",
-				Suggestion:  "하나의 ID만 사용하거나 클래스 셀렉터를 사용하세요",
+				Message:     i18n.T("css.multiple-ids.message"),
+				Description: i18n.T("css.multiple-ids.description"),
+				Suggestion:  i18n.T("css.multiple-ids.suggestion"),
 				CodeSnippet: selector,
 			})
 		}
@@ -137,7 +152,7 @@ func (r *CSSSelectorsRule) isInefficientDescendantSelector(selector string) bool
 
 	// 첫 번째 부분이 태그명인지 확인 (소문자로만 구성)
 	firstPart := parts[0]
-	if regexp.MustCompile(`^[a-z]+$`).MatchString(firstPart) && len(parts) > 3 {
+	if tagNameRegex.MatchString(firstPart) && len(parts) > 3 {
 		return true
 	}
 
@@ -154,8 +169,7 @@ func (r *CSSSelectorsRule) checkDuplicateStyles(file *parser.ParsedFile) []types
 	var issues []types.Issue
 
 	// CSS 규칙 블록 추출
-	ruleRegex := regexp.MustCompile(`([^{}]+)\s*\{([^{}]*)\}`)
-	matches := ruleRegex.FindAllStringSubmatch(file.Content, -1)
+	matches := cssRuleBlockRegex.FindAllStringSubmatch(file.Content, -1)
 
 	styleBlocks := make(map[string][]string) // 스타일 -> 셀렉터 목록
 
@@ -185,10 +199,11 @@ func (r *CSSSelectorsRule) checkDuplicateStyles(file *parser.ParsedFile) []types
 				Line:        lineNum,
 				Column:      0,
 				Severity:    config.SeverityMedium,
+				Confidence:  config.ConfidenceLow,
 				Category:    "performance",
-				Message:     "중복된 CSS 스타일이 발견되었습니다",
-				Description: "동일한 스타일이 여러 셀렉터에 중복 정의되어 있습니다",
-				Suggestion:  "공통 클래스를 만들어 중복을 제거하세요",
+				Message:     i18n.T("css.duplicate-styles.message"),
+				Description: i18n.T("css.duplicate-styles.description"),
+				Suggestion:  i18n.T("css.duplicate-styles.suggestion"),
 				CodeSnippet: strings.Join(selectors, ", ") + " { " + styles + " }",
 			})
 		}
@@ -199,7 +214,7 @@ func (r *CSSSelectorsRule) checkDuplicateStyles(file *parser.ParsedFile) []types
 
 func (r *CSSSelectorsRule) normalizeStyles(styles string) string {
 	// 스타일 정규화: 공백 제거, 정렬, 세미콜론 정리
-	styles = regexp.MustCompile(`\s+`).ReplaceAllString(styles, " ")
+	styles = whitespaceRegex.ReplaceAllString(styles, " ")
 	styles = strings.TrimSpace(styles)
 	styles = strings.Trim(styles, ";")
 
@@ -244,6 +259,7 @@ func (r *ResponsiveDesignRule) Name() string               { return r.config.Nam
 func (r *ResponsiveDesignRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *ResponsiveDesignRule) Category() string          { return r.config.Category }
 func (r *ResponsiveDesignRule) Description() string       { return r.config.Description }
+func (r *ResponsiveDesignRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *ResponsiveDesignRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -259,10 +275,11 @@ func (r *ResponsiveDesignRule) Check(file *parser.ParsedFile) []types.Issue {
 			Line:        1,
 			Column:      1,
 			Severity:    r.Severity(),
+			Confidence:  config.ConfidenceLow,
 			Category:    r.Category(),
-			Message:     "고정 너비를 사용하지만 미디어 쿼리가 없습니다",
-			Description: "반응형 디자인을 위해 미디어 쿼리가 필요합니다",
-			Suggestion:  "@media 쿼리를 추가하여 다양한 화면 크기에 대응하세요",
+			Message:     i18n.T("css.media-query.message"),
+			Description: i18n.T("css.media-query.description"),
+			Suggestion:  i18n.T("css.media-query.suggestion"),
 			CodeSnippet: "@media (max-width: 768px) { /* 모바일 스타일 */ }",
 		})
 	}
@@ -279,10 +296,11 @@ func (r *ResponsiveDesignRule) Check(file *parser.ParsedFile) []types.Issue {
 			Line:        1,
 			Column:      1,
 			Severity:    config.SeverityLow,
+			Confidence:  config.ConfidenceLow,
 			Category:    r.Category(),
-			Message:     "모던 레이아웃 기법이 사용되지 않았습니다",
-			Description: "Flexbox나 Grid를 사용하면 더 유연한 레이아웃을 만들 수 있습니다",
-			Suggestion:  "display: flex 또는 display: grid를 고려해보세요",
+			Message:     i18n.T("css.modern-layout.message"),
+			Description: i18n.T("css.modern-layout.description"),
+			Suggestion:  i18n.T("css.modern-layout.suggestion"),
 			CodeSnippet: "display: flex; /* 또는 */ display: grid;",
 		})
 	}
@@ -291,27 +309,22 @@ func (r *ResponsiveDesignRule) Check(file *parser.ParsedFile) []types.Issue {
 }
 
 func (r *ResponsiveDesignRule) hasMediaQueries(content string) bool {
-	mediaQueryRegex := regexp.MustCompile(`@media\s*\([^)]+\)`)
 	return mediaQueryRegex.MatchString(content)
 }
 
 func (r *ResponsiveDesignRule) hasFixedWidths(content string) bool {
-	fixedWidthRegex := regexp.MustCompile(`width\s*:\s*\d+px`)
 	return fixedWidthRegex.MatchString(content)
 }
 
 func (r *ResponsiveDesignRule) hasViewportMeta(content string) bool {
-	viewportRegex := regexp.MustCompile(`<meta[^>]*name\s*=\s*["']viewport["']`)
 	return viewportRegex.MatchString(content)
 }
 
 func (r *ResponsiveDesignRule) hasFlexOrGrid(content string) bool {
-	flexGridRegex := regexp.MustCompile(`display\s*:\s*(flex|grid)`)
 	return flexGridRegex.MatchString(content)
 }
 
 func (r *ResponsiveDesignRule) hasLayoutProperties(content string) bool {
-	layoutRegex := regexp.MustCompile(`(width|height|margin|padding|position)\s*:`)
 	return layoutRegex.MatchString(content)
 }
 
@@ -319,26 +332,25 @@ func (r *ResponsiveDesignRule) checkFixedUnits(file *parser.ParsedFile) []types.
 	var issues []types.Issue
 
 	// px 단위 과다 사용 검사
-	pxRegex := regexp.MustCompile(`:\s*\d+px`)
-	matches := pxRegex.FindAllStringIndex(file.Content, -1)
+	matches := pxValueRegex.FindAllStringIndex(file.Content, -1)
 
 	pxCount := len(matches)
 	if pxCount > 10 { // 임계값: 10개 이상
 		// 처음 몇 개만 보고
 		for i, match := range matches[:3] {
-			lineNum := getLineNumberFromPosition(file.Content, match[0])
+			lineNum := file.LineAt(match[0])
 
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    config.SeverityLow,
 				Category:    r.Category(),
-				Message:     "px 단위를 과도하게 사용하고 있습니다",
-				Description: "고정 단위는 반응형 디자인에 제한적입니다",
-				Suggestion:  "em, rem, %, vw, vh 등 상대 단위 사용을 고려하세요",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("css.fixed-units.message"),
+				Description: i18n.T("css.fixed-units.description"),
+				Suggestion:  i18n.T("css.fixed-units.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 
 			if i >= 2 { // 최대 3개까지만
@@ -348,11 +360,4 @@ func (r *ResponsiveDesignRule) checkFixedUnits(file *parser.ParsedFile) []types.
 	}
 
 	return issues
-}
-
-func (r *ResponsiveDesignRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
 }
\ No newline at end of file
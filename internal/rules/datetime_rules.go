@@ -0,0 +1,149 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 날짜/시간 규칙에서 사용하는 정규식
+var (
+	legacyDateImportRegex   = regexp.MustCompile(`(?m)^\s*import\s+(java\.util\.(?:Date|Calendar|GregorianCalendar|TimeZone));`)
+	legacyDateWildcardRegex = regexp.MustCompile(`(?m)^\s*import\s+java\.util\.\*;`)
+	// java.util.* 로 가져오거나 패키지명을 붙여 쓴 경우의 사용 위치
+	legacyDateUsageRegex  = regexp.MustCompile(`\bnew\s+(?:java\.util\.)?(?:Date|GregorianCalendar)\s*\(|\b(?:java\.util\.)?Calendar\s*\.\s*getInstance\s*\(`)
+	simpleDateFormatRegex = regexp.MustCompile(`\bnew\s+(?:java\.text\.)?SimpleDateFormat\s*\(([^;]*?)\)\s*[;,)]`)
+	setTimeZoneRegex      = regexp.MustCompile(`\.\s*setTimeZone\s*\(`)
+	// new Date("2024-01-01"), new Date(dateStr), Date.parse(...)
+	jsDateParseRegex = regexp.MustCompile(`\bnew\s+Date\s*\(\s*(["'` + "`" + `][^)]*|[\w.]*(?:[sS]tr|[sS]tring|[tT]ext))\s*\)|\bDate\s*\.\s*parse\s*\(`)
+)
+
+// DateTimeRule 레거시 날짜/시간 API와 잘못된 날짜 파싱 검사
+// java-legacy-date-api, java-simple-date-format, js-date-parsing 세 규칙 ID로 등록됩니다
+type DateTimeRule struct {
+	config config.RuleConfig
+}
+
+func NewDateTimeRule(cfg config.RuleConfig) Rule {
+	return &DateTimeRule{config: cfg}
+}
+
+func (r *DateTimeRule) ID() string                { return r.config.ID }
+func (r *DateTimeRule) Name() string              { return r.config.Name }
+func (r *DateTimeRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *DateTimeRule) Category() string          { return r.config.Category }
+func (r *DateTimeRule) Description() string       { return r.config.Description }
+func (r *DateTimeRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *DateTimeRule) Check(file *parser.ParsedFile) []types.Issue {
+	switch r.ID() {
+	case "java-legacy-date-api":
+		return r.checkLegacyAPI(file)
+	case "java-simple-date-format":
+		return r.checkSimpleDateFormat(file)
+	case "js-date-parsing":
+		return r.checkJSDateParsing(file)
+	}
+	return nil
+}
+
+// checkLegacyAPI java.util.Date/Calendar import와 (와일드카드 import 시) 사용 위치 검사
+func (r *DateTimeRule) checkLegacyAPI(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	for _, match := range legacyDateImportRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		name := file.Content[match[2]:match[3]]
+		issues = append(issues, r.newIssue(file, match[2],
+			i18n.T("datetime.legacy-api.message", name),
+			i18n.T("datetime.legacy-api.description"),
+			i18n.T("datetime.legacy-api.suggestion")))
+	}
+
+	// 클래스별 import가 없으면 사용 위치로 보고 (java.util.* import 또는 패키지명을 붙인 경우)
+	wildcard := legacyDateWildcardRegex.MatchString(file.Content)
+	for _, match := range legacyDateUsageRegex.FindAllStringIndex(file.Content, -1) {
+		usage := file.Content[match[0]:match[1]]
+		if r.inComment(file, match[0]) || !(wildcard || strings.Contains(usage, "java.util.")) {
+			continue
+		}
+		issues = append(issues, r.newIssue(file, match[0],
+			i18n.T("datetime.legacy-api.message", strings.TrimSpace(strings.TrimSuffix(usage, "("))),
+			i18n.T("datetime.legacy-api.description"),
+			i18n.T("datetime.legacy-api.suggestion")))
+	}
+
+	return issues
+}
+
+// checkSimpleDateFormat Locale 없이 생성하거나 TimeZone을 지정하지 않은 SimpleDateFormat 검사
+func (r *DateTimeRule) checkSimpleDateFormat(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	hasTimeZone := setTimeZoneRegex.MatchString(file.Content)
+	for _, match := range simpleDateFormatRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		if r.inComment(file, match[0]) {
+			continue
+		}
+
+		var missing []string
+		if !strings.Contains(file.Content[match[2]:match[3]], ",") {
+			missing = append(missing, "Locale")
+		}
+		if !hasTimeZone {
+			missing = append(missing, "TimeZone")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		issues = append(issues, r.newIssue(file, match[0],
+			i18n.T("datetime.simple-date-format.message", strings.Join(missing, "/")),
+			i18n.T("datetime.simple-date-format.description"),
+			i18n.T("datetime.simple-date-format.suggestion")))
+	}
+
+	return issues
+}
+
+// checkJSDateParsing 문자열을 new Date(...)나 Date.parse(...)로 파싱하는 코드 검사
+func (r *DateTimeRule) checkJSDateParsing(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	for _, match := range jsDateParseRegex.FindAllStringIndex(file.Content, -1) {
+		if r.inComment(file, match[0]) {
+			continue
+		}
+		issues = append(issues, r.newIssue(file, match[0],
+			i18n.T("datetime.js-date-parse.message", strings.TrimSuffix(file.Content[match[0]:match[1]], "(")),
+			i18n.T("datetime.js-date-parse.description"),
+			i18n.T("datetime.js-date-parse.suggestion")))
+	}
+
+	return issues
+}
+
+// inComment 위치가 주석 라인인지 확인
+func (r *DateTimeRule) inComment(file *parser.ParsedFile, offset int) bool {
+	line := strings.TrimSpace(getLineContent(file, file.LineAt(offset)))
+	return strings.HasPrefix(line, "//") || strings.HasPrefix(line, "*") || strings.HasPrefix(line, "/*")
+}
+
+func (r *DateTimeRule) newIssue(file *parser.ParsedFile, offset int, message, description, suggestion string) types.Issue {
+	line := file.LineAt(offset)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      file.ColumnAt(offset),
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, line),
+	}
+}
@@ -13,20 +13,30 @@ type Rule interface {
 	Severity() config.Severity
 	Category() string
 	Description() string
+	Doc() RuleDoc // 이유, 옵션, 위반/수정 예시, 참고 문서 (cqc explain)
 	Check(file *parser.ParsedFile) []types.Issue
 }
 
+// LineRule 한 줄씩 검사할 수 있는 규칙 (대용량 파일 스트리밍 분석에 사용)
+// file에는 Path와 Language만 채워져 있을 수 있습니다
+type LineRule interface {
+	Rule
+	CheckLine(file *parser.ParsedFile, lineNum int, line string) []types.Issue
+}
+
 // Engine 규칙 엔진
 type Engine struct {
-	config *config.Config
-	rules  map[string][]Rule // 언어별 규칙
+	config      *config.Config
+	rules       map[string][]Rule            // 언어별 규칙
+	ruleConfigs map[string]config.RuleConfig // 규칙 ID별 설정 (메시지 템플릿, 예시)
 }
 
 // NewEngine 새로운 규칙 엔진 생성
 func NewEngine(cfg *config.Config) *Engine {
 	engine := &Engine{
-		config: cfg,
-		rules:  make(map[string][]Rule),
+		config:      cfg,
+		rules:       make(map[string][]Rule),
+		ruleConfigs: make(map[string]config.RuleConfig),
 	}
 
 	// 언어별 규칙 초기화
@@ -35,6 +45,18 @@ func NewEngine(cfg *config.Config) *Engine {
 	return engine
 }
 
+// Rule 등록된 규칙 중 ID가 일치하는 규칙과 그 언어 (없으면 false)
+func (e *Engine) Rule(id string) (Rule, string, bool) {
+	for language, rules := range e.rules {
+		for _, rule := range rules {
+			if rule.ID() == id {
+				return rule, language, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
 // initializeRules 규칙 초기화
 func (e *Engine) initializeRules() {
 	// Java 규칙 등록
@@ -48,6 +70,16 @@ func (e *Engine) initializeRules() {
 	
 	// CSS 규칙 등록
 	e.registerCSSRules()
+
+	// 의존성 매니페스트 규칙 등록
+	e.registerManifestRules()
+
+	// 규칙별 설정 수집 (메시지 템플릿, 예시)
+	for _, langRules := range e.config.Languages {
+		for _, rule := range e.config.GetRulesForLanguage(langRules.Language) {
+			e.ruleConfigs[rule.ID] = rule
+		}
+	}
 }
 
 // CheckFile 파일 검사
@@ -62,12 +94,110 @@ func (e *Engine) CheckFile(file *parser.ParsedFile, language string) []types.Iss
 	// 각 규칙 실행
 	for _, rule := range rules {
 		issues := rule.Check(file)
-		allIssues = append(allIssues, issues...)
+		e.decorateIssues(rule, issues)
+		allIssues = append(allIssues, dedupeIssues(issues)...)
 	}
 
 	return allIssues
 }
 
+// CheckLargeFile 대용량 파일을 한 줄씩 읽으며 라인 단위 규칙만 검사
+// 본문 추출이 필요한 규칙은 건너뛰고 그 ID 목록을 함께 반환합니다
+func (e *Engine) CheckLargeFile(filePath, language string) ([]types.Issue, []string, error) {
+	var allIssues []types.Issue
+	var lineRules []LineRule
+	var skipped []string
+
+	for _, rule := range e.rules[language] {
+		if lineRule, ok := rule.(LineRule); ok {
+			lineRules = append(lineRules, lineRule)
+		} else {
+			skipped = append(skipped, rule.ID())
+		}
+	}
+
+	if len(lineRules) == 0 {
+		return allIssues, skipped, nil
+	}
+
+	file := &parser.ParsedFile{Path: filePath, Language: language}
+	issuesByRule := make([][]types.Issue, len(lineRules))
+
+	err := parser.ScanLines(filePath, func(lineNum int, line string) {
+		for i, rule := range lineRules {
+			issuesByRule[i] = append(issuesByRule[i], rule.CheckLine(file, lineNum, line)...)
+		}
+	})
+	if err != nil {
+		return nil, skipped, err
+	}
+
+	for i, rule := range lineRules {
+		e.decorateIssues(rule, issuesByRule[i])
+		allIssues = append(allIssues, dedupeIssues(issuesByRule[i])...)
+	}
+
+	return allIssues, skipped, nil
+}
+
+// decorateIssues 규칙 설정의 메시지 템플릿, 수정 예시, 신뢰도, 보안 분류 적용
+func (e *Engine) decorateIssues(rule Rule, issues []types.Issue) {
+	ruleConfig, ok := e.ruleConfigs[rule.ID()]
+	if ok {
+		applyMessageTemplates(issues, ruleConfig.Messages)
+		attachExamples(issues, ruleConfig.Examples)
+	}
+	applyConfidence(issues, config.ParseConfidence(ruleConfig.Confidence))
+	applyClassification(issues, rule.ID(), ruleConfig)
+	applyMaturity(issues, ruleConfig.Maturity)
+}
+
+// applyMaturity 실험/폐기 예정 규칙의 이슈에 성숙도 기록 (stable은 기록하지 않음)
+func applyMaturity(issues []types.Issue, maturity string) {
+	if maturity == "" || maturity == config.MaturityStable {
+		return
+	}
+	for i := range issues {
+		issues[i].Maturity = maturity
+	}
+}
+
+// applyConfidence 설정된 신뢰도로 재정의하고, 규칙이 지정하지 않은 이슈는 high로 설정
+func applyConfidence(issues []types.Issue, override config.Confidence) {
+	for i := range issues {
+		if override != 0 {
+			issues[i].Confidence = override
+		} else if issues[i].Confidence == 0 {
+			issues[i].Confidence = config.ConfidenceHigh
+		}
+	}
+}
+
+// issueKey 중복 판단 기준 (규칙, 파일, 라인, 메시지)
+type issueKey struct {
+	ruleID  string
+	file    string
+	line    int
+	message string
+}
+
+// dedupeIssues 동일한 이슈 제거 (겹치는 정규식 패턴 등으로 인한 중복, 처음 발견된 이슈 유지)
+func dedupeIssues(issues []types.Issue) []types.Issue {
+	seen := make(map[issueKey]bool, len(issues))
+	unique := issues[:0]
+
+	for _, issue := range issues {
+		key := issueKey{issue.RuleID, issue.File, issue.Line, issue.Message}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, issue)
+	}
+
+	return unique
+}
+
 // registerJavaRules Java 규칙 등록
 func (e *Engine) registerJavaRules() {
 	javaRules := e.config.GetRulesForLanguage("java")
@@ -95,6 +225,10 @@ func (e *Engine) registerJavaRules() {
 			rules = append(rules, NewDuplicateCodeRule(ruleConfig))
 		case "java-coding-conventions":
 			rules = append(rules, NewCodingConventionRule(ruleConfig))
+		case "java-banned-api":
+			rules = append(rules, NewBannedAPIRule(ruleConfig))
+		case "java-import-order":
+			rules = append(rules, NewImportOrderRule(ruleConfig))
 		// Spring Framework 규칙들
 		case "spring-validation-missing":
 			rules = append(rules, NewSpringValidationRule(ruleConfig))
@@ -110,6 +244,32 @@ func (e *Engine) registerJavaRules() {
 			rules = append(rules, NewSpringDependencyInjectionRule(ruleConfig))
 		case "spring-controller-advice-missing":
 			rules = append(rules, NewSpringExceptionHandlingRule(ruleConfig))
+		case "spring-scheduled-error-handling":
+			rules = append(rules, NewSpringScheduledRule(ruleConfig))
+		case "spring-scheduled-lock-missing":
+			rules = append(rules, NewSpringScheduledRule(ruleConfig))
+		case "spring-batch-fault-tolerance":
+			rules = append(rules, NewSpringBatchStepRule(ruleConfig))
+		case "spring-api-response-convention":
+			rules = append(rules, NewSpringResponseConventionRule(ruleConfig))
+		case "spring-pagination-required":
+			rules = append(rules, NewSpringPaginationRule(ruleConfig))
+		case "java-i18n-hardcoded-string":
+			rules = append(rules, NewI18nHardcodedStringRule(ruleConfig))
+		case "java-legacy-date-api", "java-simple-date-format":
+			rules = append(rules, NewDateTimeRule(ruleConfig))
+		case "java-optional-get-unchecked", "java-null-collection-return", "java-nullable-inconsistency":
+			rules = append(rules, NewNullSafetyRule(ruleConfig))
+		case "java-logging-convention":
+			rules = append(rules, NewLoggingConventionRule(ruleConfig))
+		case "java-feature-flag-hygiene":
+			rules = append(rules, NewFeatureFlagRule(ruleConfig))
+		case "java-taint-flow":
+			rules = append(rules, NewTaintFlowRule(ruleConfig, e.config.Taint("java")))
+		default:
+			if rule := e.newCustomRule(ruleConfig); rule != nil {
+				rules = append(rules, rule)
+			}
 		}
 	}
 
@@ -133,6 +293,24 @@ func (e *Engine) registerJavaScriptRules() {
 			rules = append(rules, NewConsoleLogRule(ruleConfig))
 		case "js-var-usage":
 			rules = append(rules, NewVarUsageRule(ruleConfig))
+		case "js-banned-api":
+			rules = append(rules, NewBannedAPIRule(ruleConfig))
+		case "js-import-order":
+			rules = append(rules, NewImportOrderRule(ruleConfig))
+		case "js-naming-convention":
+			rules = append(rules, NewNamingConventionRule(ruleConfig))
+		case "js-i18n-hardcoded-string":
+			rules = append(rules, NewI18nHardcodedStringRule(ruleConfig))
+		case "js-date-parsing":
+			rules = append(rules, NewDateTimeRule(ruleConfig))
+		case "js-feature-flag-hygiene":
+			rules = append(rules, NewFeatureFlagRule(ruleConfig))
+		case "js-taint-flow":
+			rules = append(rules, NewTaintFlowRule(ruleConfig, e.config.Taint("javascript")))
+		default:
+			if rule := e.newCustomRule(ruleConfig); rule != nil {
+				rules = append(rules, rule)
+			}
 		}
 	}
 
@@ -153,6 +331,16 @@ func (e *Engine) registerHTMLRules() {
 			rules = append(rules, NewAccessibilityRule(ruleConfig))
 		case "html-seo":
 			rules = append(rules, NewSEORule(ruleConfig))
+		case "html-i18n-hardcoded-string":
+			rules = append(rules, NewI18nHardcodedStringRule(ruleConfig))
+		case "html-template-unescaped-output":
+			rules = append(rules, NewTemplateOutputRule(ruleConfig))
+		case "html-render-blocking-script", "html-img-dimensions", "html-external-resource-limit", "html-inline-code-budget":
+			rules = append(rules, NewWebPerformanceRule(ruleConfig))
+		default:
+			if rule := e.newCustomRule(ruleConfig); rule != nil {
+				rules = append(rules, rule)
+			}
 		}
 	}
 
@@ -170,8 +358,54 @@ func (e *Engine) registerCSSRules() {
 			rules = append(rules, NewCSSSelectorsRule(ruleConfig))
 		case "css-responsive-design":
 			rules = append(rules, NewResponsiveDesignRule(ruleConfig))
+		case "css-naming-convention":
+			rules = append(rules, NewNamingConventionRule(ruleConfig))
+		default:
+			if rule := e.newCustomRule(ruleConfig); rule != nil {
+				rules = append(rules, rule)
+			}
 		}
 	}
 
 	e.rules["css"] = rules
+}
+
+// registerManifestRules 의존성 매니페스트(pom.xml, build.gradle, package.json) 규칙 등록
+func (e *Engine) registerManifestRules() {
+	manifestRules := e.config.GetRulesForLanguage("manifest")
+	var rules []Rule
+
+	for _, ruleConfig := range manifestRules {
+		switch ruleConfig.ID {
+		case "manifest-dependency-policy":
+			rules = append(rules, NewDependencyPolicyRule(ruleConfig))
+		default:
+			if rule := e.newCustomRule(ruleConfig); rule != nil {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	e.rules["manifest"] = rules
+}
+
+// newCustomRule 설정만으로 정의된 규칙 생성 (조건식 규칙, 규칙 팩의 정규식 규칙, 잘못된 패턴은 무시)
+func (e *Engine) newCustomRule(ruleConfig config.RuleConfig) Rule {
+	if ruleConfig.Pattern.Type == config.PatternExpression {
+		rule, err := NewExpressionRule(ruleConfig)
+		if err != nil {
+			return nil
+		}
+		return rule
+	}
+
+	if ruleConfig.Pack == "" || ruleConfig.Pattern.Type != "regex" || ruleConfig.Pattern.Regex == "" {
+		return nil
+	}
+
+	rule, err := NewPatternRule(ruleConfig)
+	if err != nil {
+		return nil
+	}
+	return rule
 }
\ No newline at end of file
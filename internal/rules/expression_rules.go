@@ -0,0 +1,266 @@
+package rules
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/expr"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// callRegex 메소드 호출 (호출 대상 변수, 메소드 이름)
+var callRegex = regexp.MustCompile(`(?:([A-Za-z_$][\w$]*)\s*\.\s*)?([A-Za-z_$][\w$]*)\s*\(`)
+
+// callKeywords 호출처럼 보이는 제어문 키워드
+var callKeywords = map[string]bool{
+	"if": true, "for": true, "while": true, "switch": true, "catch": true,
+	"synchronized": true, "return": true, "new": true, "super": true, "this": true,
+}
+
+// ExpressionRule pattern.type이 expression인 사용자 정의 규칙
+// conditions가 모두 참인 메소드마다 이슈를 보고합니다. 조건식이 method를 참조하지 않으면 클래스(또는 파일)마다 한 번 평가합니다.
+// class/method 사실은 Java 파일에서만 제공되므로 다른 언어에서는 file 사실만 쓰는 규칙이 동작합니다.
+type ExpressionRule struct {
+	config     config.RuleConfig
+	conditions []*expr.Expr
+}
+
+func NewExpressionRule(cfg config.RuleConfig) (Rule, error) {
+	rule := &ExpressionRule{config: cfg}
+	for _, condition := range cfg.Pattern.Conditions {
+		parsed, err := expr.Parse(condition)
+		if err != nil {
+			return nil, err
+		}
+		rule.conditions = append(rule.conditions, parsed)
+	}
+	return rule, nil
+}
+
+func (r *ExpressionRule) ID() string                { return r.config.ID }
+func (r *ExpressionRule) Name() string              { return r.config.Name }
+func (r *ExpressionRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *ExpressionRule) Category() string          { return r.config.Category }
+func (r *ExpressionRule) Description() string       { return r.config.Description }
+func (r *ExpressionRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *ExpressionRule) Check(file *parser.ParsedFile) []types.Issue {
+	if len(r.conditions) == 0 {
+		return nil
+	}
+
+	env := &factEnv{file: file}
+	if !r.uses(expr.ObjectClass) && !r.uses(expr.ObjectMethod) {
+		if r.matches(env) {
+			return []types.Issue{r.newIssue(file, 1, "", "")}
+		}
+		return nil
+	}
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok || javaClass == nil {
+		return nil
+	}
+	env.class = javaClass
+
+	if !r.uses(expr.ObjectMethod) {
+		if r.matches(env) {
+			return []types.Issue{r.newIssue(file, classLine(file, javaClass.Name), javaClass.Name, "")}
+		}
+		return nil
+	}
+
+	var issues []types.Issue
+	for i := range javaClass.Methods {
+		method := &javaClass.Methods[i]
+		env.method = method
+		env.body = stripJavaComments(extractBlockFromLine(file, method.Line))
+		if r.matches(env) {
+			issues = append(issues, r.newIssue(file, method.Line, javaClass.Name, method.Name))
+		}
+	}
+	return issues
+}
+
+// uses 조건식 중 하나라도 객체의 사실을 참조하는지
+func (r *ExpressionRule) uses(object string) bool {
+	for _, condition := range r.conditions {
+		if condition.Uses(object) {
+			return true
+		}
+	}
+	return false
+}
+
+// matches 조건식이 모두 참인지
+func (r *ExpressionRule) matches(env *factEnv) bool {
+	for _, condition := range r.conditions {
+		if !condition.Eval(env) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *ExpressionRule) newIssue(file *parser.ParsedFile, lineNum int, className, methodName string) types.Issue {
+	message := r.config.Custom["message"]
+	if message == "" {
+		message = r.config.Name
+	}
+
+	issue := types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        lineNum,
+		Column:      1,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: r.Description(),
+		Suggestion:  r.config.Custom["suggestion"],
+		CodeSnippet: strings.TrimSpace(getLineContent(file, lineNum)),
+		Params:      map[string]string{},
+	}
+	if className != "" {
+		issue.Params["class"] = className
+	}
+	if methodName != "" {
+		issue.Params["method"] = methodName
+	}
+	return issue
+}
+
+// classLine 클래스 선언 라인 (찾지 못하면 1)
+func classLine(file *parser.ParsedFile, name string) int {
+	if name == "" {
+		return 1
+	}
+	declaration := regexp.MustCompile(`\b(?:class|interface|enum|record)\s+` + regexp.QuoteMeta(name) + `\b`)
+	for i, line := range file.Lines {
+		if declaration.MatchString(line) {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// factEnv 조건식 평가에 쓰는 파일/클래스/메소드 사실
+type factEnv struct {
+	file   *parser.ParsedFile
+	class  *parser.JavaClass
+	method *parser.JavaMethod
+	body   string // 주석을 뺀 메소드 본문
+}
+
+func (e *factEnv) Fact(fact *expr.Fact, arg string, regex *regexp.Regexp) interface{} {
+	switch fact.Object {
+	case expr.ObjectFile:
+		return e.fileFact(fact.Name, arg, regex)
+	case expr.ObjectClass:
+		if e.class != nil {
+			return e.classFact(fact.Name, arg, regex)
+		}
+	case expr.ObjectMethod:
+		if e.method != nil {
+			return e.methodFact(fact.Name, arg, regex)
+		}
+	}
+	return nil
+}
+
+func (e *factEnv) fileFact(name, arg string, regex *regexp.Regexp) interface{} {
+	switch name {
+	case "path":
+		return filepath.ToSlash(e.file.Path)
+	case "lines":
+		return len(e.file.Lines)
+	case "contains":
+		return strings.Contains(e.file.Content, arg)
+	case "matches":
+		return regex.MatchString(e.file.Content)
+	}
+	return nil
+}
+
+func (e *factEnv) classFact(name, arg string, regex *regexp.Regexp) interface{} {
+	switch name {
+	case "name":
+		return e.class.Name
+	case "package":
+		return e.class.Package
+	case "nameMatches":
+		return regex.MatchString(e.class.Name)
+	case "hasAnnotation":
+		return hasAnnotationNamed(e.class.Annotations, arg)
+	case "hasImport":
+		for _, imp := range e.class.Imports {
+			if strings.Contains(imp, arg) {
+				return true
+			}
+		}
+		return false
+	case "hasFieldType":
+		for _, field := range e.class.Fields {
+			if strings.Contains(field.Type, arg) {
+				return true
+			}
+		}
+		return false
+	case "methodCount":
+		return len(e.class.Methods)
+	case "fieldCount":
+		return len(e.class.Fields)
+	}
+	return nil
+}
+
+func (e *factEnv) methodFact(name, arg string, regex *regexp.Regexp) interface{} {
+	switch name {
+	case "name":
+		return e.method.Name
+	case "returnType":
+		return e.method.ReturnType
+	case "nameMatches":
+		return regex.MatchString(e.method.Name)
+	case "hasAnnotation":
+		return hasAnnotationNamed(e.method.Annotations, arg)
+	case "params":
+		return len(e.method.Parameters)
+	case "lines":
+		if e.body == "" {
+			return 0
+		}
+		return strings.Count(e.body, "\n") + 1
+	case "isPublic":
+		return e.method.IsPublic
+	case "isPrivate":
+		return e.method.IsPrivate
+	case "isStatic":
+		return e.method.IsStatic
+	case "callCount":
+		return countCalls(e.body, arg)
+	case "contains":
+		return strings.Contains(e.body, arg)
+	case "matches":
+		return regex.MatchString(e.body)
+	}
+	return nil
+}
+
+// countCalls 호출 대상 변수나 메소드 이름에 name을 포함하는 호출 수 (대소문자 무시)
+func countCalls(body, name string) int {
+	name = strings.ToLower(name)
+	count := 0
+	for _, match := range callRegex.FindAllStringSubmatch(body, -1) {
+		if callKeywords[match[2]] {
+			continue
+		}
+		if strings.Contains(strings.ToLower(match[1]), name) || strings.Contains(strings.ToLower(match[2]), name) {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,129 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// FeatureFlagRuleIDs 언어 설정 섹션별 기능 플래그 규칙 ID (TypeScript는 javascript 섹션 사용)
+var FeatureFlagRuleIDs = map[string]string{
+	"java":       "java-feature-flag-hygiene",
+	"javascript": "js-feature-flag-hygiene",
+}
+
+// flagLiteralRegex 문자열 리터럴 인자
+var flagLiteralRegex = regexp.MustCompile("^[\"'`]([^\"'`]+)[\"'`]$")
+
+// 기본 옵션값
+var (
+	defaultFlagCalls      = []string{"isEnabled", "isFeatureEnabled", "boolVariation", "stringVariation"}
+	defaultFlagsClassName = "FeatureFlags"
+)
+
+// FeatureFlagUsage 기능 플래그 SDK 호출에서 찾은 플래그 (Literal이면 문자열을 직접 전달)
+type FeatureFlagUsage struct {
+	Name    string
+	Literal bool
+	Column  int
+}
+
+// FeatureFlagScanner 설정된 SDK 호출 패턴으로 기능 플래그 사용 위치를 찾음
+// 규칙과 분석기의 플래그 목록 집계가 같은 기준을 쓰도록 공유합니다
+type FeatureFlagScanner struct {
+	calls          []*regexp.Regexp
+	constantsClass string
+}
+
+// NewFeatureFlagScanner 규칙 설정으로 스캐너 생성
+// flag_calls: SDK 호출 (메소드 이름 또는 receiver.메소드, 기본값 isEnabled,isFeatureEnabled,boolVariation,stringVariation)
+// constants_class: 플래그 이름을 모아 둔 상수 클래스 (기본값 FeatureFlags)
+func NewFeatureFlagScanner(cfg config.RuleConfig) *FeatureFlagScanner {
+	scanner := &FeatureFlagScanner{constantsClass: cfg.Custom["constants_class"]}
+	if scanner.constantsClass == "" {
+		scanner.constantsClass = defaultFlagsClassName
+	}
+	for _, call := range listOrDefault(cfg.Custom["flag_calls"], defaultFlagCalls) {
+		// 첫 번째 인자 캡처
+		scanner.calls = append(scanner.calls, regexp.MustCompile(`\b`+regexp.QuoteMeta(call)+`\s*\(\s*([^,()]+?)\s*[,)]`))
+	}
+	return scanner
+}
+
+// ScanLine 라인에서 플래그 사용 위치 추출 (상수 클래스 참조와 문자열 리터럴만, 변수로 전달한 경우와 주석은 제외)
+func (s *FeatureFlagScanner) ScanLine(line string) []FeatureFlagUsage {
+	var usages []FeatureFlagUsage
+
+	trimmed := strings.TrimSpace(line)
+	if strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "*") || strings.HasPrefix(trimmed, "/*") {
+		return usages
+	}
+
+	for _, call := range s.calls {
+		for _, match := range call.FindAllStringSubmatchIndex(line, -1) {
+			argument := line[match[2]:match[3]]
+			usage := FeatureFlagUsage{Name: argument, Column: match[2] + 1}
+			if literal := flagLiteralRegex.FindStringSubmatch(argument); literal != nil {
+				usage.Name = literal[1]
+				usage.Literal = true
+			} else if !strings.HasPrefix(argument, s.constantsClass+".") {
+				continue
+			}
+			usages = append(usages, usage)
+		}
+	}
+	return usages
+}
+
+// FeatureFlagRule 기능 플래그 이름을 상수 클래스 대신 문자열로 직접 전달하는 코드 검사
+// java-feature-flag-hygiene, js-feature-flag-hygiene 두 규칙 ID로 등록되며 사용된 플래그 목록은 리포트에 따로 표시됩니다
+type FeatureFlagRule struct {
+	config  config.RuleConfig
+	scanner *FeatureFlagScanner
+}
+
+func NewFeatureFlagRule(cfg config.RuleConfig) Rule {
+	return &FeatureFlagRule{config: cfg, scanner: NewFeatureFlagScanner(cfg)}
+}
+
+func (r *FeatureFlagRule) ID() string                { return r.config.ID }
+func (r *FeatureFlagRule) Name() string              { return r.config.Name }
+func (r *FeatureFlagRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *FeatureFlagRule) Category() string          { return r.config.Category }
+func (r *FeatureFlagRule) Description() string       { return r.config.Description }
+func (r *FeatureFlagRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *FeatureFlagRule) Check(file *parser.ParsedFile) []types.Issue {
+	return checkLines(r, file)
+}
+
+func (r *FeatureFlagRule) CheckLine(file *parser.ParsedFile, lineNum int, line string) []types.Issue {
+	var issues []types.Issue
+
+	for _, usage := range r.scanner.ScanLine(line) {
+		if !usage.Literal {
+			continue
+		}
+		issues = append(issues, types.Issue{
+			RuleID:      r.ID(),
+			File:        file.Path,
+			Line:        lineNum,
+			Column:      usage.Column,
+			Severity:    r.Severity(),
+			Category:    r.Category(),
+			Message:     i18n.T("feature-flag.literal.message", usage.Name),
+			Description: i18n.T("feature-flag.literal.description"),
+			Suggestion:  i18n.T("feature-flag.literal.suggestion", r.scanner.constantsClass),
+			CodeSnippet: strings.TrimSpace(line),
+			Params: map[string]string{
+				"value": usage.Name,
+			},
+		})
+	}
+
+	return issues
+}
@@ -0,0 +1,124 @@
+package rules
+
+import (
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 규칙 공통 헬퍼 함수들
+
+// mustCompileAll 정규식 목록을 한 번에 컴파일
+func mustCompileAll(patterns ...string) []*regexp.Regexp {
+	regexes := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		regexes[i] = regexp.MustCompile(pattern)
+	}
+	return regexes
+}
+
+// countMatches 정규식 목록의 전체 매칭 횟수
+func countMatches(regexes []*regexp.Regexp, content string) int {
+	count := 0
+	for _, regex := range regexes {
+		count += len(regex.FindAllStringIndex(content, -1))
+	}
+	return count
+}
+
+// matchesAny 정규식 목록 중 하나라도 매칭되는지 확인
+func matchesAny(regexes []*regexp.Regexp, content string) bool {
+	for _, regex := range regexes {
+		if regex.MatchString(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// getCodeSnippet 해당 라인의 코드 (앞뒤 공백 제거)
+func getCodeSnippet(file *parser.ParsedFile, line int) string {
+	if line <= 0 || line > len(file.Lines) {
+		return ""
+	}
+	return strings.TrimSpace(file.Lines[line-1])
+}
+
+// getLineContent 해당 라인의 원본 내용
+func getLineContent(file *parser.ParsedFile, lineNum int) string {
+	if lineNum <= 0 || lineNum > len(file.Lines) {
+		return ""
+	}
+	return file.Lines[lineNum-1]
+}
+
+func intToString(i int) string {
+	return strconv.Itoa(i)
+}
+
+// checkLines 파일의 모든 라인에 라인 단위 규칙 적용
+func checkLines(rule LineRule, file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+	for i, line := range file.Lines {
+		issues = append(issues, rule.CheckLine(file, i+1, line)...)
+	}
+	return issues
+}
+
+// endsWithNewline 디스크의 파일이 개행 문자로 끝나는지 확인 (파싱 결과는 항상 개행으로 끝나므로 원본 확인)
+func endsWithNewline(path string) (bool, error) {
+	if content, ok := parser.Overlay(path); ok {
+		return content == "" || strings.HasSuffix(content, "\n"), nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return false, err
+	}
+	if info.Size() == 0 {
+		return true, nil
+	}
+
+	last := make([]byte, 1)
+	if _, err := f.ReadAt(last, info.Size()-1); err != nil {
+		return false, err
+	}
+	return last[0] == '\n', nil
+}
+
+// extractBlockFromLine 해당 라인 이후 첫 '{'부터 짝이 맞는 '}'까지의 블록 추출 (메소드/함수 본문)
+func extractBlockFromLine(file *parser.ParsedFile, line int) string {
+	offset := file.LineStart(line)
+	if offset < 0 || offset > len(file.Content) {
+		return ""
+	}
+
+	start := strings.IndexByte(file.Content[offset:], '{')
+	if start == -1 {
+		return ""
+	}
+	start += offset
+
+	depth := 0
+	for i := start; i < len(file.Content); i++ {
+		switch file.Content[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return file.Content[start : i+1]
+			}
+		}
+	}
+	return ""
+}
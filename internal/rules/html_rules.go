@@ -5,10 +5,35 @@ import (
 	"strings"
 
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
 	"code-quality-checker/internal/parser"
 	"code-quality-checker/internal/types"
 )
 
+// HTML 규칙에서 사용하는 정규식
+var (
+	clickableDivRegex = regexp.MustCompile(`<div[^>]*onclick[^>]*>`)
+	buttonTagRegex    = regexp.MustCompile(`<button[^>]*>`)
+	buttonTextRegex   = regexp.MustCompile(`<button[^>]*>(.*?)</button>`)
+	inputTagRegex     = regexp.MustCompile(`<input[^>]*>`)
+	htmlTagRegex      = regexp.MustCompile(`<[^>]*>`)
+	h1TagRegex        = regexp.MustCompile(`<h1[^>]*>`)
+	titleTagRegex     = regexp.MustCompile(`<title[^>]*>.*?</title>`)
+	metaDescRegex     = regexp.MustCompile(`<meta[^>]*name\s*=\s*["']description["'][^>]*>`)
+	// 버튼 내용을 런타임에 채우는 템플릿 속성과 메시지 태그 (Thymeleaf, JSTL/Spring)
+	templateTextRegex = regexp.MustCompile(`\s(?:th|data-th)[:-](?:u?text|aria-label)\s*=|<(?:spring|fmt):message\b`)
+)
+
+// htmlTemplateOf 파서가 인식한 서버 사이드 템플릿 정보 (텍스트 분석으로 대체된 파일은 내용으로 다시 판단)
+func htmlTemplateOf(file *parser.ParsedFile) parser.HTMLTemplate {
+	if htmlData, ok := file.AST.(map[string]interface{}); ok {
+		if template, ok := htmlData["template"].(parser.HTMLTemplate); ok {
+			return template
+		}
+	}
+	return parser.DetectTemplate(file.Path, file.Content)
+}
+
 // ImgAltRule img 태그 alt 속성 누락 검사
 type ImgAltRule struct {
 	config config.RuleConfig
@@ -23,6 +48,7 @@ func (r *ImgAltRule) Name() string               { return r.config.Name }
 func (r *ImgAltRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *ImgAltRule) Category() string          { return r.config.Category }
 func (r *ImgAltRule) Description() string       { return r.config.Description }
+func (r *ImgAltRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *ImgAltRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -56,9 +82,9 @@ func (r *ImgAltRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      0,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "img 태그에 alt 속성이 누락되었거나 비어있습니다",
-				Description: "시각 장애인을 위한 대체 텍스트가 필요합니다",
-				Suggestion:  "img 태그에 의미있는 alt 속성을 추가하세요",
+				Message:     i18n.T("html.img-alt.message"),
+				Description: i18n.T("html.img-alt.description"),
+				Suggestion:  i18n.T("html.img-alt.suggestion"),
 				CodeSnippet: imgTag,
 			})
 		}
@@ -90,38 +116,37 @@ func (r *AccessibilityRule) Name() string               { return r.config.Name }
 func (r *AccessibilityRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *AccessibilityRule) Category() string          { return r.config.Category }
 func (r *AccessibilityRule) Description() string       { return r.config.Description }
+func (r *AccessibilityRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *AccessibilityRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// 클릭 가능한 div 요소 검사 (onclick이 있는 div)
-	clickableDivRegex := regexp.MustCompile(`<div[^>]*onclick[^>]*>`)
 	matches := clickableDivRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range matches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
+		lineNum := file.LineAt(match[0])
 		
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      file.ColumnAt(match[0]),
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "div 요소에 onclick이 사용되었습니다",
-			Description: "키보드 접근성이 떨어지며 스크린 리더에서 인식하기 어렵습니다",
-			Suggestion:  "button 요소를 사용하거나 적절한 ARIA 속성을 추가하세요",
-			CodeSnippet: r.getCodeSnippet(file, lineNum),
+			Message:     i18n.T("html.div-onclick.message"),
+			Description: i18n.T("html.div-onclick.description"),
+			Suggestion:  i18n.T("html.div-onclick.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
 		})
 	}
 
 	// aria-label 없는 버튼 검사
-	buttonRegex := regexp.MustCompile(`<button[^>]*>`)
-	buttonMatches := buttonRegex.FindAllStringIndex(file.Content, -1)
+	buttonMatches := buttonTagRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range buttonMatches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
-		buttonText := r.getCodeSnippet(file, lineNum)
+		lineNum := file.LineAt(match[0])
+		buttonText := getCodeSnippet(file, lineNum)
 		
 		// aria-label이 있는지 확인
 		if !strings.Contains(buttonText, "aria-label") && !r.hasButtonText(buttonText) {
@@ -129,24 +154,23 @@ func (r *AccessibilityRule) Check(file *parser.ParsedFile) []types.Issue {
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "button 요소에 접근 가능한 텍스트가 없습니다",
-				Description: "스크린 리더 사용자가 버튼의 목적을 알 수 없습니다",
-				Suggestion:  "aria-label 속성이나 버튼 텍스트를 추가하세요",
+				Message:     i18n.T("html.button-text.message"),
+				Description: i18n.T("html.button-text.description"),
+				Suggestion:  i18n.T("html.button-text.suggestion"),
 				CodeSnippet: buttonText,
 			})
 		}
 	}
 
 	// form input 요소의 label 연결 검사
-	inputRegex := regexp.MustCompile(`<input[^>]*>`)
-	inputMatches := inputRegex.FindAllStringIndex(file.Content, -1)
+	inputMatches := inputTagRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range inputMatches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
-		inputText := r.getCodeSnippet(file, lineNum)
+		lineNum := file.LineAt(match[0])
+		inputText := getCodeSnippet(file, lineNum)
 		
 		// aria-label 또는 aria-labelledby가 있는지 확인
 		if !strings.Contains(inputText, "aria-label") && !strings.Contains(inputText, "aria-labelledby") {
@@ -154,13 +178,14 @@ func (r *AccessibilityRule) Check(file *parser.ParsedFile) []types.Issue {
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    r.Severity(),
+				Confidence:  config.ConfidenceMedium,
 				Category:    r.Category(),
-				Message:     "input 요소에 레이블이 연결되지 않았습니다",
-				Description: "사용자가 입력 필드의 목적을 알기 어렵습니다",
-				Suggestion:  "label 요소를 사용하거나 aria-label 속성을 추가하세요",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("html.input-label.message"),
+				Description: i18n.T("html.input-label.description"),
+				Suggestion:  i18n.T("html.input-label.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 	}
@@ -169,27 +194,24 @@ func (r *AccessibilityRule) Check(file *parser.ParsedFile) []types.Issue {
 }
 
 func (r *AccessibilityRule) hasButtonText(buttonHTML string) bool {
+	// th:text나 <spring:message>로 서버에서 채우는 텍스트
+	if templateTextRegex.MatchString(buttonHTML) {
+		return true
+	}
+
 	// 버튼 태그 사이의 텍스트 추출
-	textRegex := regexp.MustCompile(`<button[^>]*>(.*?)</button>`)
-	match := textRegex.FindStringSubmatch(buttonHTML)
+	match := buttonTextRegex.FindStringSubmatch(buttonHTML)
 	
 	if len(match) > 1 {
 		text := strings.TrimSpace(match[1])
 		// HTML 태그 제거
-		textWithoutTags := regexp.MustCompile(`<[^>]*>`).ReplaceAllString(text, "")
+		textWithoutTags := htmlTagRegex.ReplaceAllString(text, "")
 		return strings.TrimSpace(textWithoutTags) != ""
 	}
 	
 	return false
 }
 
-func (r *AccessibilityRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // SEORule SEO 최적화 검사
 type SEORule struct {
 	config config.RuleConfig
@@ -204,12 +226,17 @@ func (r *SEORule) Name() string               { return r.config.Name }
 func (r *SEORule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SEORule) Category() string          { return r.config.Category }
 func (r *SEORule) Description() string       { return r.config.Description }
+func (r *SEORule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SEORule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
+	// 템플릿 조각이나 레이아웃/include로 head를 가져오는 페이지는 title, description, h1이 다른 파일에 있음
+	template := htmlTemplateOf(file)
+	partial := template.Fragment || template.Decorated
+
 	// title 태그 검사
-	if !r.hasTitle(file.Content) {
+	if !partial && !r.hasTitle(file.Content) {
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
@@ -217,15 +244,15 @@ func (r *SEORule) Check(file *parser.ParsedFile) []types.Issue {
 			Column:      1,
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "title 태그가 없습니다",
-			Description: "페이지 제목은 SEO에 매우 중요합니다",
-			Suggestion:  "<title> 태그를 head 영역에 추가하세요",
+			Message:     i18n.T("html.seo-title.message"),
+			Description: i18n.T("html.seo-title.description"),
+			Suggestion:  i18n.T("html.seo-title.suggestion"),
 			CodeSnippet: "<title>페이지 제목</title>",
 		})
 	}
 
 	// meta description 검사
-	if !r.hasMetaDescription(file.Content) {
+	if !partial && !r.hasMetaDescription(file.Content) {
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
@@ -233,16 +260,16 @@ func (r *SEORule) Check(file *parser.ParsedFile) []types.Issue {
 			Column:      1,
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "meta description이 없습니다",
-			Description: "검색 결과에 표시될 페이지 설명이 필요합니다",
-			Suggestion:  `<meta name="description" content="페이지 설명"> 태그를 추가하세요`,
+			Message:     i18n.T("html.seo-meta-description.message"),
+			Description: i18n.T("html.seo-meta-description.description"),
+			Suggestion:  i18n.T("html.seo-meta-description.suggestion"),
 			CodeSnippet: `<meta name="description" content="페이지 설명">`,
 		})
 	}
 
 	// h1 태그 검사
 	h1Count := r.countH1Tags(file.Content)
-	if h1Count == 0 {
+	if h1Count == 0 && !partial {
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
@@ -250,29 +277,28 @@ func (r *SEORule) Check(file *parser.ParsedFile) []types.Issue {
 			Column:      1,
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "h1 태그가 없습니다",
-			Description: "페이지의 주요 제목이 필요합니다",
-			Suggestion:  "페이지의 주요 제목에 h1 태그를 사용하세요",
+			Message:     i18n.T("html.seo-h1-missing.message"),
+			Description: i18n.T("html.seo-h1-missing.description"),
+			Suggestion:  i18n.T("html.seo-h1-missing.suggestion"),
 			CodeSnippet: "<h1>페이지 주제목</h1>",
 		})
 	} else if h1Count > 1 {
-		h1Regex := regexp.MustCompile(`<h1[^>]*>`)
-		matches := h1Regex.FindAllStringIndex(file.Content, -1)
+		matches := h1TagRegex.FindAllStringIndex(file.Content, -1)
 		
 		for i, match := range matches[1:] { // 첫 번째 h1은 제외
-			lineNum := getLineNumberFromPosition(file.Content, match[0])
+			lineNum := file.LineAt(match[0])
 			
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "h1 태그가 여러 개 사용되었습니다",
-				Description: "페이지당 하나의 h1 태그만 사용하는 것이 좋습니다",
-				Suggestion:  "추가 제목에는 h2, h3 등을 사용하세요",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("html.seo-h1-multiple.message"),
+				Description: i18n.T("html.seo-h1-multiple.description"),
+				Suggestion:  i18n.T("html.seo-h1-multiple.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 			
 			if i >= 2 { // 최대 3개까지만 보고
@@ -285,24 +311,14 @@ func (r *SEORule) Check(file *parser.ParsedFile) []types.Issue {
 }
 
 func (r *SEORule) hasTitle(content string) bool {
-	titleRegex := regexp.MustCompile(`<title[^>]*>.*?</title>`)
-	return titleRegex.MatchString(content)
+	return titleTagRegex.MatchString(content)
 }
 
 func (r *SEORule) hasMetaDescription(content string) bool {
-	metaDescRegex := regexp.MustCompile(`<meta[^>]*name\s*=\s*["']description["'][^>]*>`)
 	return metaDescRegex.MatchString(content)
 }
 
 func (r *SEORule) countH1Tags(content string) int {
-	h1Regex := regexp.MustCompile(`<h1[^>]*>`)
-	matches := h1Regex.FindAllString(content, -1)
+	matches := h1TagRegex.FindAllString(content, -1)
 	return len(matches)
-}
-
-func (r *SEORule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
 }
\ No newline at end of file
@@ -0,0 +1,235 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 다국어 규칙에서 사용하는 정규식
+var (
+	hangulRegex      = regexp.MustCompile(`\p{Hangul}`)
+	englishWordRegex = regexp.MustCompile(`[A-Za-z]{2,}`)
+	javaLiteralRegex = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+	jsLiteralRegex   = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"|'((?:[^'\\]|\\.)*)'|` + "`([^`$]*)`")
+	// 태그 사이의 텍스트와 사용자에게 보이는 속성값
+	htmlTextNodeRegex = regexp.MustCompile(`>([^<>]+)<`)
+	htmlTextAttrRegex = regexp.MustCompile(`([\w:-]+)\s*=\s*"([^"]*)"`)
+	// 템플릿 표현식 (${...}, #{...}, *{...}, [[...]])
+	templateExprRegex = regexp.MustCompile(`[$#*]\{[^}]*\}|\[\[[^\]]*\]\]|<%.*?%>`)
+	i18nLogCallRegex  = regexp.MustCompile(`\b(?:log|logger|LOG|LOGGER|console)\s*\.`)
+)
+
+// 기본 옵션값
+var (
+	defaultI18nContexts = []string{
+		"message", "msg", "alert", "confirm", "label", "title", "text", "notice", "toast",
+		"error", "placeholder", "exception", "addAttribute", "addFlashAttribute",
+	}
+	defaultI18nAttributes = []string{"alt", "title", "placeholder", "aria-label"}
+)
+
+// I18nHardcodedStringRule 화면에 노출되는 하드코딩 문구 검사
+// java-i18n-hardcoded-string(컨트롤러), js-i18n-hardcoded-string, html-i18n-hardcoded-string(Thymeleaf/JSP)
+// 세 규칙 ID로 등록되며 파일 언어에 따라 검사 방식이 달라집니다
+type I18nHardcodedStringRule struct {
+	config     config.RuleConfig
+	contexts   []string // 문구가 사용자에게 노출된다고 보는 문맥 (contexts, 소문자)
+	attributes []string // 검사할 HTML 속성 (attributes)
+	minWords   int      // 영어 문구로 볼 최소 단어 수 (min_words, 기본값 2)
+	allHTML    bool     // Thymeleaf/JSP가 아닌 정적 HTML도 검사 (all_html)
+}
+
+func NewI18nHardcodedStringRule(cfg config.RuleConfig) Rule {
+	rule := &I18nHardcodedStringRule{
+		config:     cfg,
+		attributes: listOrDefault(cfg.Custom["attributes"], defaultI18nAttributes),
+		minWords:   customInt(cfg.Custom, "min_words", 2),
+		allHTML:    cfg.Custom["all_html"] == "true",
+	}
+	for _, context := range listOrDefault(cfg.Custom["contexts"], defaultI18nContexts) {
+		rule.contexts = append(rule.contexts, strings.ToLower(context))
+	}
+	return rule
+}
+
+func (r *I18nHardcodedStringRule) ID() string   { return r.config.ID }
+func (r *I18nHardcodedStringRule) Name() string { return r.config.Name }
+func (r *I18nHardcodedStringRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *I18nHardcodedStringRule) Category() string    { return r.config.Category }
+func (r *I18nHardcodedStringRule) Description() string { return r.config.Description }
+func (r *I18nHardcodedStringRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *I18nHardcodedStringRule) Check(file *parser.ParsedFile) []types.Issue {
+	switch file.Language {
+	case "java":
+		javaClass, ok := file.AST.(*parser.JavaClass)
+		if !ok || !(hasAnnotationNamed(javaClass.Annotations, "Controller") || hasAnnotationNamed(javaClass.Annotations, "RestController")) {
+			return nil
+		}
+		return r.checkCode(file, javaLiteralRegex,
+			i18n.T("i18n.java.suggestion"))
+	case "javascript", "typescript":
+		return r.checkCode(file, jsLiteralRegex,
+			i18n.T("i18n.js.suggestion"))
+	case "html":
+		return r.checkTemplate(file)
+	}
+	return nil
+}
+
+// checkCode 사용자 노출 문맥(alert, message, addAttribute 등)에 쓰인 문자열 리터럴 검사
+func (r *I18nHardcodedStringRule) checkCode(file *parser.ParsedFile, literal *regexp.Regexp, suggestion string) []types.Issue {
+	var issues []types.Issue
+
+	for i, line := range file.Lines {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "//") || strings.HasPrefix(trimmed, "*") || strings.HasPrefix(trimmed, "/*") ||
+			strings.HasPrefix(trimmed, "@") || strings.HasPrefix(trimmed, "import ") || i18nLogCallRegex.MatchString(line) {
+			continue
+		}
+
+		for _, match := range literal.FindAllStringSubmatchIndex(line, -1) {
+			text := submatchText(line, match)
+			if !r.isUserFacing(text) || !r.inContext(line[:match[0]]) {
+				continue
+			}
+			issues = append(issues, r.newIssue(file, i+1, match[0]+1, text, suggestion))
+		}
+	}
+
+	return issues
+}
+
+// checkTemplate Thymeleaf/JSP 템플릿의 텍스트 노드와 속성값 검사
+// th:text처럼 런타임에 바뀌는 요소의 기본 텍스트와 <script>/<style> 안은 제외합니다
+func (r *I18nHardcodedStringRule) checkTemplate(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	engine := htmlTemplateOf(file).Engine
+	if !r.allHTML && engine == "" {
+		return issues
+	}
+	jsp := engine == parser.TemplateJSP
+	suggestion := i18n.T("i18n.thymeleaf.suggestion")
+	if jsp {
+		suggestion = i18n.T("i18n.jsp.suggestion")
+	}
+
+	inScript := false
+	for i, line := range file.Lines {
+		lower := strings.ToLower(line)
+		if inScript {
+			if strings.Contains(lower, "</script") || strings.Contains(lower, "</style") {
+				inScript = false
+			}
+			continue
+		}
+		if strings.Contains(lower, "<script") || strings.Contains(lower, "<style") {
+			inScript = !strings.Contains(lower, "</script") && !strings.Contains(lower, "</style")
+			continue
+		}
+
+		for _, match := range htmlTextNodeRegex.FindAllStringSubmatchIndex(line, -1) {
+			text := line[match[2]:match[3]]
+			if r.isUserFacing(templateExprRegex.ReplaceAllString(text, "")) && !replacedAtRuntime(line[:match[0]]) {
+				issues = append(issues, r.newIssue(file, i+1, match[2]+1, strings.TrimSpace(text), suggestion))
+			}
+		}
+		for _, match := range htmlTextAttrRegex.FindAllStringSubmatchIndex(line, -1) {
+			name := strings.ToLower(line[match[2]:match[3]])
+			value := line[match[4]:match[5]]
+			// th:placeholder처럼 같은 속성을 런타임에 바꾸면 정적 값은 미리보기용
+			if !containsType(r.attributes, name) || strings.Contains(line, "th:"+name+"=") ||
+				!r.isUserFacing(templateExprRegex.ReplaceAllString(value, "")) {
+				continue
+			}
+			issues = append(issues, r.newIssue(file, i+1, match[4]+1, value, suggestion))
+		}
+	}
+
+	return issues
+}
+
+func (r *I18nHardcodedStringRule) newIssue(file *parser.ParsedFile, line, column int, text, suggestion string) types.Issue {
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      column,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     i18n.T("i18n.hardcoded.message", truncateText(text, 40)),
+		Description: i18n.T("i18n.hardcoded.description"),
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, line),
+		Params: map[string]string{
+			"value": text,
+		},
+	}
+}
+
+// isUserFacing 사람이 읽는 문장으로 보이는지 확인 (한글 포함, 또는 min_words 이상의 영어 단어로 된 문장)
+func (r *I18nHardcodedStringRule) isUserFacing(text string) bool {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return false
+	}
+	if hangulRegex.MatchString(text) {
+		return true
+	}
+	// 경로, 키, 포맷 문자열, SQL 조각 등은 제외
+	if !strings.Contains(text, " ") || strings.ContainsAny(text, "/\\{}<>=_#$%|") {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(text)
+	if !unicode.IsLetter(first) {
+		return false
+	}
+	return len(englishWordRegex.FindAllString(text, -1)) >= r.minWords
+}
+
+// inContext 리터럴 앞부분에 사용자 노출 문맥 키워드가 있는지 확인
+func (r *I18nHardcodedStringRule) inContext(prefix string) bool {
+	prefix = strings.ToLower(prefix)
+	for _, context := range r.contexts {
+		if strings.Contains(prefix, context) {
+			return true
+		}
+	}
+	return false
+}
+
+// replacedAtRuntime 텍스트를 감싼 요소가 th:text/th:utext로 내용을 바꾸는지 확인
+func replacedAtRuntime(before string) bool {
+	if i := strings.LastIndex(before, "<"); i >= 0 {
+		before = before[i:]
+	}
+	return strings.Contains(before, "th:text") || strings.Contains(before, "th:utext")
+}
+
+// submatchText 정규식의 여러 캡처 그룹 중 일치한 그룹의 문자열
+func submatchText(line string, match []int) string {
+	for g := 2; g+1 < len(match); g += 2 {
+		if match[g] >= 0 {
+			return line[match[g]:match[g+1]]
+		}
+	}
+	return ""
+}
+
+// truncateText 메시지에 넣기 위해 긴 문자열을 rune 단위로 자름
+func truncateText(text string, max int) string {
+	if utf8.RuneCountInString(text) <= max {
+		return text
+	}
+	return string([]rune(text)[:max]) + "..."
+}
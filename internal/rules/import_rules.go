@@ -0,0 +1,241 @@
+package rules
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+var jsImportLineRegex = regexp.MustCompile(`^\s*import\s+(?:[^'"]*\s+from\s+)?['"]([^'"]+)['"]\s*;?\s*$`)
+
+// ImportOrderRule import 그룹/정렬 순서 검사
+type ImportOrderRule struct {
+	config config.RuleConfig
+	groups []string
+}
+
+// importLine import 문 정보
+type importLine struct {
+	line   int // 0부터 시작하는 라인 인덱스
+	module string
+	text   string
+	group  int
+}
+
+func NewImportOrderRule(cfg config.RuleConfig) Rule {
+	groups := splitList(cfg.Custom["groups"])
+	if len(groups) == 0 {
+		if strings.HasPrefix(cfg.ID, "js-") {
+			groups = []string{"*", "@/", "."}
+		} else {
+			groups = []string{"java", "javax", "*"}
+		}
+	}
+	return &ImportOrderRule{config: cfg, groups: groups}
+}
+
+func (r *ImportOrderRule) ID() string                { return r.config.ID }
+func (r *ImportOrderRule) Name() string              { return r.config.Name }
+func (r *ImportOrderRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *ImportOrderRule) Category() string          { return r.config.Category }
+func (r *ImportOrderRule) Description() string       { return r.config.Description }
+func (r *ImportOrderRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *ImportOrderRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	imports, start, end, fixable := r.collectImportBlock(file)
+	newline := lineEnding(file.Lines)
+	if len(imports) < 2 {
+		return issues
+	}
+
+	expected := make([]importLine, len(imports))
+	copy(expected, imports)
+	sortWithinGroup := r.config.Custom["sort_within_group"] != "false"
+	sort.SliceStable(expected, func(i, j int) bool {
+		if expected[i].group != expected[j].group {
+			return expected[i].group < expected[j].group
+		}
+		if sortWithinGroup {
+			return expected[i].module < expected[j].module
+		}
+		return false
+	})
+
+	for i := range imports {
+		if imports[i].module == expected[i].module {
+			continue
+		}
+
+		issue := types.Issue{
+			RuleID:      r.ID(),
+			File:        file.Path,
+			Line:        imports[i].line + 1,
+			Column:      1,
+			Severity:    r.Severity(),
+			Category:    r.Category(),
+			Message:     i18n.T("import-order.order.message", expected[i].module, imports[i].module),
+			Description: i18n.T("import-order.description", strings.Join(r.groups, ", ")),
+			Suggestion:  i18n.T("import-order.order.suggestion"),
+			CodeSnippet: strings.TrimSpace(imports[i].text),
+		}
+		if fixable {
+			issue.Fix = &types.Fix{
+				StartLine:   start + 1,
+				EndLine:     end + 1,
+				Replacement: r.renderBlock(expected, newline),
+			}
+		}
+		return append(issues, issue) // 블록당 하나만 보고
+	}
+
+	// 순서는 맞지만 그룹 구분(빈 줄)이 다른 경우
+	if r.config.Custom["blank_line_between_groups"] != "false" && fixable {
+		var actual []string
+		for _, line := range file.Lines[start : end+1] {
+			actual = append(actual, strings.TrimRight(line, " \t\r"))
+		}
+		block := r.renderBlock(expected, newline)
+		if strings.Join(actual, newline) != block {
+			issues = append(issues, types.Issue{
+				RuleID:      r.ID(),
+				File:        file.Path,
+				Line:        start + 1,
+				Column:      1,
+				Severity:    r.Severity(),
+				Category:    r.Category(),
+				Message:     i18n.T("import-order.groups.message"),
+				Description: i18n.T("import-order.description", strings.Join(r.groups, ", ")),
+				Suggestion:  i18n.T("import-order.groups.suggestion"),
+				CodeSnippet: strings.TrimSpace(imports[0].text),
+				Fix: &types.Fix{
+					StartLine:   start + 1,
+					EndLine:     end + 1,
+					Replacement: block,
+				},
+			})
+		}
+	}
+
+	return issues
+}
+
+// collectImportBlock 파일 상단의 연속된 import 블록 수집
+func (r *ImportOrderRule) collectImportBlock(file *parser.ParsedFile) ([]importLine, int, int, bool) {
+	var imports []importLine
+	start, end := -1, -1
+	fixable := true
+
+	for i, line := range file.Lines {
+		trimmed := strings.TrimSpace(line)
+		module := r.parseImport(file.Language, line)
+
+		if module == "" {
+			if start == -1 || trimmed == "" {
+				continue
+			}
+			if strings.HasPrefix(trimmed, "//") {
+				fixable = false // 블록 내 주석은 자동 수정 시 유실되므로 수정 제외
+				continue
+			}
+			break
+		}
+
+		if start == -1 {
+			start = i
+		}
+		end = i
+		imports = append(imports, importLine{
+			line:   i,
+			module: module,
+			text:   line,
+			group:  r.groupIndex(file.Language, module),
+		})
+	}
+
+	return imports, start, end, fixable
+}
+
+func (r *ImportOrderRule) parseImport(language, line string) string {
+	var match []string
+	if language == "java" {
+		match = javaImportRegex.FindStringSubmatch(line)
+	} else {
+		match = jsImportLineRegex.FindStringSubmatch(line)
+	}
+	if len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
+// groupIndex 가장 길게 일치하는 그룹의 순서 반환 ('*'는 나머지 전체)
+func (r *ImportOrderRule) groupIndex(language, module string) int {
+	best, bestLen := -1, -1
+	wildcard := len(r.groups)
+
+	for i, group := range r.groups {
+		if group == "*" {
+			wildcard = i
+			continue
+		}
+		if !r.matchesGroup(language, group, module) {
+			continue
+		}
+		if len(group) > bestLen {
+			best, bestLen = i, len(group)
+		}
+	}
+
+	if best == -1 {
+		return wildcard
+	}
+	return best
+}
+
+func (r *ImportOrderRule) matchesGroup(language, group, module string) bool {
+	if language == "java" {
+		group = strings.TrimSuffix(group, ".")
+		return module == group || strings.HasPrefix(module, group+".")
+	}
+	return strings.HasPrefix(module, group)
+}
+
+// renderBlock 그룹 사이에 빈 줄을 넣어 import 블록 생성 (줄바꿈은 newline)
+func (r *ImportOrderRule) renderBlock(imports []importLine, newline string) string {
+	separate := r.config.Custom["blank_line_between_groups"] != "false"
+
+	var lines []string
+	for i, imp := range imports {
+		if separate && i > 0 && imp.group != imports[i-1].group {
+			lines = append(lines, "")
+		}
+		lines = append(lines, strings.TrimRight(imp.text, " \t\r"))
+	}
+	return strings.Join(lines, newline)
+}
+
+// lineEnding 파일의 줄바꿈 (첫 줄이 CR로 끝나면 CRLF)
+func lineEnding(lines []string) string {
+	if len(lines) > 0 && strings.HasSuffix(lines[0], "\r") {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// splitList 쉼표로 구분된 설정값을 목록으로 변환
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
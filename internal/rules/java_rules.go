@@ -1,16 +1,112 @@
 package rules
 
 import (
-	"fmt"
+	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/editorconfig"
+	"code-quality-checker/internal/i18n"
 	"code-quality-checker/internal/parser"
 	"code-quality-checker/internal/types"
 )
 
+// Java 규칙에서 사용하는 정규식 (파일마다 다시 컴파일하지 않도록 미리 컴파일)
+var (
+	repositoryCallRegexes = mustCompileAll(
+		`\w+Repository\.\w+\(`,
+		`\w+DAO\.\w+\(`,
+		`\w+Mapper\.\w+\(`,
+	)
+	conditionalDataOperationRegex = regexp.MustCompile(`if\s*\([^)]+\)\s*\{[^}]*(?:save|update|delete|insert|remove)\([^}]*\}`)
+	dataOperationRegexes          = map[string]*regexp.Regexp{
+		"save":   regexp.MustCompile(`(?i)\w*save\w*\(`),
+		"update": regexp.MustCompile(`(?i)\w*update\w*\(`),
+		"delete": regexp.MustCompile(`(?i)\w*delete\w*\(`),
+		"insert": regexp.MustCompile(`(?i)\w*insert\w*\(`),
+		"remove": regexp.MustCompile(`(?i)\w*remove\w*\(`),
+	}
+	externalCallRegexes = mustCompileAll(
+		`(?i)restTemplate\.\w+\(`,
+		`(?i)webClient\.\w+\(`,
+		`(?i)\w*Client\.\w+\(`,
+		`(?i)\w*Service\.\w+\(.*http`,
+		`(?i)@FeignClient`,
+		`(?i)kafka\w*\.\w+\(`,
+		`(?i)jms\w*\.\w+\(`,
+	)
+
+	systemOutRegex         = regexp.MustCompile(`System\.out\.(print|println)`)
+	magicNumberRegex       = regexp.MustCompile(`\b((?:[1-9]\d{2,})|(?:\d+\.\d+))\b`)
+	printStackTraceRegex   = regexp.MustCompile(`\.printStackTrace\(\)`)
+	genericThrowRegex      = regexp.MustCompile(`throw\s+new\s+Exception\s*\([^)]*\)`)
+	benefitValidationRegex = regexp.MustCompile(`BenefitValidation\.(isEmpty|isNull|isValid)`)
+
+	// 순환 복잡도 분기문 패턴
+	branchRegexes = mustCompileAll(
+		`\bif\s*\(`,        // if 문
+		`\belse\s+if\s*\(`, // else if 문
+		`\belse\b`,         // else 문
+		`\bwhile\s*\(`,     // while 문
+		`\bfor\s*\(`,       // for 문
+		`\bdo\s*\{`,        // do-while 문
+		`\bswitch\s*\(`,    // switch 문
+		`\bcase\s+`,        // case 문
+		`\bcatch\s*\(`,     // catch 문
+		`\?\s*[^:]+\s*:`,   // 삼항연산자
+		`\&\&`,             // 논리 AND
+		`\|\|`,             // 논리 OR
+	)
+
+	stringLiteralRegex = regexp.MustCompile(`"[^"]*"`)
+	numberLiteralRegex = regexp.MustCompile(`\b\d+\b`)
+	identifierRegex    = regexp.MustCompile(`\b[a-zA-Z_][a-zA-Z0-9_]*\b`)
+
+	resourceAnnotationRegex  = regexp.MustCompile(`@Resource`)
+	autowiredAnnotationRegex = regexp.MustCompile(`@Autowired`)
+	spaceIndentRegex         = regexp.MustCompile(`^\s{4,}`)
+)
+
+// duplicatePattern 반복 시 중복으로 간주하는 코드 패턴
+type duplicatePattern struct {
+	name        string
+	regex       *regexp.Regexp
+	description string // 메시지 키
+	suggestion  string // 메시지 키
+}
+
+// 공통 중복 코드 패턴들 (custom의 builtin_patterns로 선택)
+var duplicatePatterns = []duplicatePattern{
+	{
+		name:        "response-put",
+		regex:       regexp.MustCompile(`responseBody\.put\(.*?\);`),
+		description: "java.duplicate-pattern.response-put.description",
+		suggestion:  "java.duplicate-pattern.response-put.suggestion",
+	},
+	{
+		name:        "code-list",
+		regex:       regexp.MustCompile(`cdService\.selectCdList\([^)]+\)`),
+		description: "java.duplicate-pattern.code-list.description",
+		suggestion:  "java.duplicate-pattern.code-list.suggestion",
+	},
+	{
+		name:        "null-check-throw",
+		regex:       regexp.MustCompile(`if\s*\([^)]*==\s*null[^)]*\)\s*\{[^}]*throw[^}]*\}`),
+		description: "java.duplicate-pattern.null-check-throw.description",
+		suggestion:  "java.duplicate-pattern.null-check-throw.suggestion",
+	},
+	{
+		name:        "log-return",
+		regex:       regexp.MustCompile(`logger\.(info|debug|error)\([^)]*\);\s*return`),
+		description: "java.duplicate-pattern.log-return.description",
+		suggestion:  "java.duplicate-pattern.log-return.suggestion",
+	},
+}
+
 // TransactionalRule @Transactional 어노테이션 누락 검사
 type TransactionalRule struct {
 	config config.RuleConfig
@@ -25,6 +121,7 @@ func (r *TransactionalRule) Name() string               { return r.config.Name }
 func (r *TransactionalRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *TransactionalRule) Category() string          { return r.config.Category }
 func (r *TransactionalRule) Description() string       { return r.config.Description }
+func (r *TransactionalRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *TransactionalRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -60,11 +157,16 @@ func (r *TransactionalRule) Check(file *parser.ParsedFile) []types.Issue {
 					Line:        method.Line,
 					Column:      method.Column,
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceMedium,
 					Category:    r.Category(),
 					Message:     r.generateTransactionalMessage(method.Name, complexity),
-					Description: "복잡한 데이터 변경 작업에는 트랜잭션이 필요합니다",
-					Suggestion:  "@Transactional 어노테이션을 메소드에 추가하세요",
-					CodeSnippet: r.getCodeSnippet(file, method.Line),
+					Description: i18n.T("java.transactional.description"),
+					Suggestion:  i18n.T("java.transactional.suggestion"),
+					CodeSnippet: getCodeSnippet(file, method.Line),
+					Params: map[string]string{
+						"method": method.Name,
+						"value":  complexity.reason,
+					},
 				})
 			}
 		}
@@ -108,7 +210,7 @@ type MethodComplexity struct {
 
 // analyzeMethodComplexity 메소드의 트랜잭션 필요성 분석
 func (r *TransactionalRule) analyzeMethodComplexity(file *parser.ParsedFile, method parser.JavaMethod) MethodComplexity {
-	methodBody := r.extractMethodBody(file, method)
+	methodBody := extractBlockFromLine(file, method.Line)
 	
 	complexity := MethodComplexity{
 		requiresTransaction: false,
@@ -116,17 +218,7 @@ func (r *TransactionalRule) analyzeMethodComplexity(file *parser.ParsedFile, met
 	}
 	
 	// 1. Repository/DAO 호출 횟수 체크
-	repositoryPatterns := []string{
-		`\w+Repository\.\w+\(`,
-		`\w+DAO\.\w+\(`,
-		`\w+Mapper\.\w+\(`,
-	}
-	
-	for _, pattern := range repositoryPatterns {
-		regex := regexp.MustCompile(pattern)
-		matches := regex.FindAllString(methodBody, -1)
-		complexity.repositoryCalls += len(matches)
-	}
+	complexity.repositoryCalls = countMatches(repositoryCallRegexes, methodBody)
 	
 	// 2. 조건부 로직 검사 (if/else와 데이터 변경이 함께)
 	if r.hasConditionalDataOperations(methodBody) {
@@ -149,56 +241,18 @@ func (r *TransactionalRule) analyzeMethodComplexity(file *parser.ParsedFile, met
 	return complexity
 }
 
-// extractMethodBody 메소드 본문 추출
-func (r *TransactionalRule) extractMethodBody(file *parser.ParsedFile, method parser.JavaMethod) string {
-	// 메소드 시작 위치 찾기
-	methodPattern := regexp.QuoteMeta(method.Name) + `\s*\([^)]*\)\s*\{`
-	methodRegex := regexp.MustCompile(methodPattern)
-	
-	match := methodRegex.FindStringIndex(file.Content)
-	if match == nil {
-		return ""
-	}
-	
-	// 메소드 본문 추출 (중괄호 매칭)
-	start := match[1] - 1 // '{' 위치
-	braceCount := 1
-	i := start + 1
-	
-	content := []rune(file.Content)
-	for i < len(content) && braceCount > 0 {
-		if content[i] == '{' {
-			braceCount++
-		} else if content[i] == '}' {
-			braceCount--
-		}
-		i++
-	}
-	
-	if braceCount == 0 {
-		return string(content[start:i])
-	}
-	
-	return ""
-}
-
 // hasConditionalDataOperations 조건부 데이터 작업 검사
 func (r *TransactionalRule) hasConditionalDataOperations(methodBody string) bool {
 	// if문과 데이터 변경 작업이 함께 있는지 검사
-	ifPattern := `if\s*\([^)]+\)\s*\{[^}]*(?:save|update|delete|insert|remove)\([^}]*\}`
-	matched, _ := regexp.MatchString(ifPattern, methodBody)
-	return matched
+	return conditionalDataOperationRegex.MatchString(methodBody)
 }
 
 // hasMultipleDataOperations 여러 종류의 데이터 작업 검사
 func (r *TransactionalRule) hasMultipleDataOperations(methodBody string) bool {
-	operations := []string{"save", "update", "delete", "insert", "remove"}
 	foundOperations := make(map[string]bool)
 	
-	for _, op := range operations {
-		pattern := `\w*` + op + `\w*\(`
-		matched, _ := regexp.MatchString(`(?i)`+pattern, methodBody)
-		if matched {
+	for op, regex := range dataOperationRegexes {
+		if regex.MatchString(methodBody) {
 			foundOperations[op] = true
 		}
 	}
@@ -209,24 +263,7 @@ func (r *TransactionalRule) hasMultipleDataOperations(methodBody string) bool {
 
 // hasExternalSystemCalls 외부 시스템 호출 검사
 func (r *TransactionalRule) hasExternalSystemCalls(methodBody string) bool {
-	externalPatterns := []string{
-		`restTemplate\.\w+\(`,
-		`webClient\.\w+\(`,
-		`\w*Client\.\w+\(`,
-		`\w*Service\.\w+\(.*http`,
-		`@FeignClient`,
-		`kafka\w*\.\w+\(`,
-		`jms\w*\.\w+\(`,
-	}
-	
-	for _, pattern := range externalPatterns {
-		matched, _ := regexp.MatchString(`(?i)`+pattern, methodBody)
-		if matched {
-			return true
-		}
-	}
-	
-	return false
+	return matchesAny(externalCallRegexes, methodBody)
 }
 
 // determineTransactionNeed 트랜잭션 필요성 최종 판단
@@ -235,22 +272,22 @@ func (r *TransactionalRule) determineTransactionNeed(complexity MethodComplexity
 	
 	// 2개 이상의 Repository 호출
 	if complexity.repositoryCalls >= 2 {
-		reasons = append(reasons, fmt.Sprintf("여러 테이블 작업(%d개 Repository 호출)", complexity.repositoryCalls))
+		reasons = append(reasons, i18n.T("java.transactional.reason-repositories", complexity.repositoryCalls))
 	}
 	
 	// 조건부 데이터 작업
 	if complexity.conditionalLogic {
-		reasons = append(reasons, "조건부 데이터 변경 로직")
+		reasons = append(reasons, i18n.T("java.transactional.reason-conditional"))
 	}
 	
 	// 여러 종류의 데이터 작업
 	if complexity.multipleOperations {
-		reasons = append(reasons, "복합 데이터 작업(생성/수정/삭제)")
+		reasons = append(reasons, i18n.T("java.transactional.reason-mixed"))
 	}
 	
 	// 외부 시스템 호출과 DB 작업이 함께
 	if complexity.externalCalls && complexity.repositoryCalls > 0 {
-		reasons = append(reasons, "외부 시스템 연동과 DB 작업")
+		reasons = append(reasons, i18n.T("java.transactional.reason-external"))
 	}
 	
 	requiresTransaction := len(reasons) > 0
@@ -261,14 +298,7 @@ func (r *TransactionalRule) determineTransactionNeed(complexity MethodComplexity
 
 // generateTransactionalMessage 트랜잭션 누락 메시지 생성
 func (r *TransactionalRule) generateTransactionalMessage(methodName string, complexity MethodComplexity) string {
-	return fmt.Sprintf("메소드 '%s'에 @Transactional이 필요합니다: %s", methodName, complexity.reason)
-}
-
-func (r *TransactionalRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
+	return i18n.T("java.transactional.message", methodName, complexity.reason)
 }
 
 // SystemOutRule System.out.println 사용 검사
@@ -285,40 +315,35 @@ func (r *SystemOutRule) Name() string               { return r.config.Name }
 func (r *SystemOutRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SystemOutRule) Category() string          { return r.config.Category }
 func (r *SystemOutRule) Description() string       { return r.config.Description }
+func (r *SystemOutRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SystemOutRule) Check(file *parser.ParsedFile) []types.Issue {
+	return checkLines(r, file)
+}
+
+func (r *SystemOutRule) CheckLine(file *parser.ParsedFile, lineNum int, line string) []types.Issue {
 	var issues []types.Issue
 
-	systemOutRegex := regexp.MustCompile(`System\.out\.(print|println)`)
-	matches := systemOutRegex.FindAllStringIndex(file.Content, -1)
+	matches := systemOutRegex.FindAllStringIndex(line, -1)
 
 	for _, match := range matches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
-		
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      match[0] + 1,
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "System.out.println 사용이 발견되었습니다",
-			Description: "프로덕션 환경에서 불필요한 정보 노출 위험이 있습니다",
-			Suggestion:  "Logger를 사용하여 로깅하세요",
-			CodeSnippet: r.getCodeSnippet(file, lineNum),
+			Message:     i18n.T("java.system-out.message"),
+			Description: i18n.T("java.system-out.description"),
+			Suggestion:  i18n.T("java.system-out.suggestion"),
+			CodeSnippet: strings.TrimSpace(line),
 		})
 	}
 
 	return issues
 }
 
-func (r *SystemOutRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // LayerArchitectureRule 레이어 아키텍처 위반 검사
 type LayerArchitectureRule struct {
 	config config.RuleConfig
@@ -333,6 +358,7 @@ func (r *LayerArchitectureRule) Name() string               { return r.config.Na
 func (r *LayerArchitectureRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *LayerArchitectureRule) Category() string          { return r.config.Category }
 func (r *LayerArchitectureRule) Description() string       { return r.config.Description }
+func (r *LayerArchitectureRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *LayerArchitectureRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -370,11 +396,12 @@ func (r *LayerArchitectureRule) checkControllerDAODependency(file *parser.Parsed
 				Line:        field.Line,
 				Column:      0,
 				Severity:    r.Severity(),
+				Confidence:  config.ConfidenceMedium,
 				Category:    r.Category(),
-				Message:     "Controller에서 DAO를 직접 의존하고 있습니다",
-				Description: "레이어 아키텍처 위반으로 유지보수성이 저하됩니다",
-				Suggestion:  "Service 레이어를 통해 데이터에 접근하세요",
-				CodeSnippet: r.getCodeSnippet(file, field.Line),
+				Message:     i18n.T("java.controller-dao.message"),
+				Description: i18n.T("java.controller-dao.description"),
+				Suggestion:  i18n.T("java.controller-dao.suggestion"),
+				CodeSnippet: getCodeSnippet(file, field.Line),
 			})
 		}
 	}
@@ -389,20 +416,77 @@ func (r *LayerArchitectureRule) isDAOField(field parser.JavaField) bool {
 		   strings.Contains(fieldTypeLower, "mapper")
 }
 
-func (r *LayerArchitectureRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
+// 매직 넘버로 보지 않는 숫자 묶음 (custom의 allowed_numbers에 이름으로 지정)
+var magicNumberPresets = map[string][]string{
+	"http-status": {"200", "201", "202", "204", "301", "302", "304", "400", "401", "403", "404", "405", "409", "422", "429", "500", "502", "503", "504"},
+	"ports":       {"80", "443", "3306", "5432", "6379", "8080", "8443", "27017"},
 }
 
+// 테스트 파일 패턴 (ignore_contexts의 tests)
+var magicNumberTestGlobs = []string{"*Test.java", "*Tests.java", "*IT.java", "**/src/test/**"}
+
+var arraySizeRegex = regexp.MustCompile(`new\s+[\w.<>]+\s*(?:\[\s*\])*\[\s*$`)
+
 // MagicNumberRule 매직 넘버 검사
 type MagicNumberRule struct {
-	config config.RuleConfig
+	config         config.RuleConfig
+	allowed        map[string]bool
+	contexts       map[string]bool  // 숫자를 허용하는 문맥 (annotations, array-sizes, constants, tests)
+	constantsFiles []*regexp.Regexp // 숫자를 허용하는 상수 파일 (constants_files)
+	testFiles      []*regexp.Regexp
 }
 
 func NewMagicNumberRule(cfg config.RuleConfig) Rule {
-	return &MagicNumberRule{config: cfg}
+	r := &MagicNumberRule{
+		config:   cfg,
+		allowed:  make(map[string]bool),
+		contexts: make(map[string]bool),
+	}
+
+	// 기본 제외 숫자 (0, 1, 2, 100 등 일반적인 숫자)와 allowed_numbers (숫자 또는 묶음 이름)
+	for _, number := range []string{"0", "1", "2", "10", "100", "1000"} {
+		r.allowed[number] = true
+	}
+	for _, item := range splitList(cfg.Custom["allowed_numbers"]) {
+		if preset, ok := magicNumberPresets[item]; ok {
+			for _, number := range preset {
+				r.allowed[number] = true
+			}
+			continue
+		}
+		r.allowed[item] = true
+	}
+
+	for _, context := range splitList(cfg.Custom["ignore_contexts"]) {
+		r.contexts[context] = true
+	}
+	r.constantsFiles = compileGlobs(splitList(cfg.Custom["constants_files"]))
+	if r.contexts["tests"] {
+		r.testFiles = compileGlobs(magicNumberTestGlobs)
+	}
+	return r
+}
+
+// compileGlobs 파일 glob 목록 컴파일 (잘못된 패턴은 무시)
+func compileGlobs(globs []string) []*regexp.Regexp {
+	var patterns []*regexp.Regexp
+	for _, glob := range globs {
+		if pattern, err := editorconfig.CompileGlob(glob); err == nil {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return patterns
+}
+
+// matchesAnyGlob 파일 경로가 glob 중 하나와 일치하는지 확인
+func matchesAnyGlob(patterns []*regexp.Regexp, path string) bool {
+	path = filepath.ToSlash(path)
+	for _, pattern := range patterns {
+		if pattern.MatchString(path) {
+			return true
+		}
+	}
+	return false
 }
 
 func (r *MagicNumberRule) ID() string                 { return r.config.ID }
@@ -410,12 +494,17 @@ func (r *MagicNumberRule) Name() string               { return r.config.Name }
 func (r *MagicNumberRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *MagicNumberRule) Category() string          { return r.config.Category }
 func (r *MagicNumberRule) Description() string       { return r.config.Description }
+func (r *MagicNumberRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *MagicNumberRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
+	// 상수 파일과 테스트 파일은 숫자 허용
+	if matchesAnyGlob(r.constantsFiles, file.Path) || matchesAnyGlob(r.testFiles, file.Path) {
+		return issues
+	}
+
 	// 매직 넘버 패턴 (정수 리터럴, 부동소수점 리터럴)
-	magicNumberRegex := regexp.MustCompile(`\b((?:[1-9]\d{2,})|(?:\d+\.\d+))\b`)
 	matches := magicNumberRegex.FindAllStringSubmatch(file.Content, -1)
 	indices := magicNumberRegex.FindAllStringIndex(file.Content, -1)
 
@@ -423,24 +512,29 @@ func (r *MagicNumberRule) Check(file *parser.ParsedFile) []types.Issue {
 		if len(match) > 1 {
 			number := match[1]
 			
-			// 제외할 숫자들 (0, 1, 2, 100 등 일반적인 숫자)
-			if r.isExcludedNumber(number) {
+			// 허용된 숫자와 허용된 문맥 제외
+			if r.allowed[number] {
 				continue
 			}
 
-			lineNum := getLineNumberFromPosition(file.Content, indices[i][0])
+			lineNum := file.LineAt(indices[i][0])
+			if r.isAllowedContext(file, lineNum, indices[i][0]) {
+				continue
+			}
 			
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, indices[i][0]),
+				Column:      file.ColumnAt(indices[i][0]),
 				Severity:    r.Severity(),
+				Confidence:  config.ConfidenceMedium,
 				Category:    r.Category(),
-				Message:     "매직 넘버가 발견되었습니다: " + number,
-				Description: "하드코딩된 숫자는 코드 가독성을 저하시킵니다",
-				Suggestion:  "의미있는 상수로 정의하세요",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("java.magic-number.message", number),
+				Description: i18n.T("java.magic-number.description"),
+				Suggestion:  i18n.T("java.magic-number.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
+				Params:      map[string]string{"value": number},
 			})
 		}
 	}
@@ -448,30 +542,37 @@ func (r *MagicNumberRule) Check(file *parser.ParsedFile) []types.Issue {
 	return issues
 }
 
-func (r *MagicNumberRule) isExcludedNumber(number string) bool {
-	excludedNumbers := []string{"0", "1", "2", "10", "100", "1000"}
-	for _, excluded := range excludedNumbers {
-		if number == excluded {
+// isAllowedContext 어노테이션 값, 배열 크기, 상수 선언처럼 숫자가 허용된 문맥인지 확인
+func (r *MagicNumberRule) isAllowedContext(file *parser.ParsedFile, lineNum, offset int) bool {
+	if len(r.contexts) == 0 || lineNum < 1 || lineNum > len(file.Lines) {
+		return false
+	}
+	line := strings.TrimSpace(file.Lines[lineNum-1])
+
+	if r.contexts["annotations"] && strings.HasPrefix(line, "@") {
+		return true
+	}
+	if r.contexts["constants"] && strings.Contains(line, "static final ") {
+		return true
+	}
+	if r.contexts["array-sizes"] {
+		lineStart := file.LineStart(lineNum)
+		if lineStart >= 0 && arraySizeRegex.MatchString(file.Content[lineStart:offset]) {
 			return true
 		}
 	}
 	return false
 }
 
-func (r *MagicNumberRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // MethodLengthRule 메소드 길이 검사
 type MethodLengthRule struct {
 	config config.RuleConfig
+	filter methodFilter // 계산에서 제외할 메소드 (exclude_methods)
+	count  string       // lines 또는 statements (count)
 }
 
 func NewMethodLengthRule(cfg config.RuleConfig) Rule {
-	return &MethodLengthRule{config: cfg}
+	return &MethodLengthRule{config: cfg, filter: newMethodFilter(cfg.Custom), count: cfg.Custom["count"]}
 }
 
 func (r *MethodLengthRule) ID() string                 { return r.config.ID }
@@ -479,6 +580,7 @@ func (r *MethodLengthRule) Name() string               { return r.config.Name }
 func (r *MethodLengthRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *MethodLengthRule) Category() string          { return r.config.Category }
 func (r *MethodLengthRule) Description() string       { return r.config.Description }
+func (r *MethodLengthRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *MethodLengthRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -492,7 +594,11 @@ func (r *MethodLengthRule) Check(file *parser.ParsedFile) []types.Issue {
 	maxLines := r.getMaxLines()
 
 	for _, method := range javaClass.Methods {
-		methodLength := r.calculateMethodLength(file, method)
+		body := extractBlockFromLine(file, method.Line)
+		if r.filter.skip(method, body) {
+			continue
+		}
+		methodLength := r.calculateMethodLength(body)
 		
 		if methodLength > maxLines {
 			issues = append(issues, types.Issue{
@@ -502,10 +608,15 @@ func (r *MethodLengthRule) Check(file *parser.ParsedFile) []types.Issue {
 				Column:      method.Column,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "메소드가 너무 깁니다 (" + method.Name + ": " + intToString(methodLength) + " 라인, 임계값: " + intToString(maxLines) + ")",
-				Description: "긴 메소드는 가독성과 유지보수성을 저하시킵니다",
-				Suggestion:  "메소드를 더 작은 단위로 분할하세요",
-				CodeSnippet: r.getCodeSnippet(file, method.Line),
+				Message:     i18n.T("java.long-method.message", method.Name, methodLength, maxLines),
+				Description: i18n.T("java.long-method.description"),
+				Suggestion:  i18n.T("java.long-method.suggestion"),
+				CodeSnippet: getCodeSnippet(file, method.Line),
+				Params: map[string]string{
+					"method":    method.Name,
+					"value":     intToString(methodLength),
+					"threshold": intToString(maxLines),
+				},
 			})
 		}
 	}
@@ -513,17 +624,16 @@ func (r *MethodLengthRule) Check(file *parser.ParsedFile) []types.Issue {
 	return issues
 }
 
-func (r *MethodLengthRule) calculateMethodLength(file *parser.ParsedFile, method parser.JavaMethod) int {
-	// 간단한 방법: 메소드 시작부터 다음 메소드까지의 라인 수 계산
-	// 실제로는 더 정교한 파싱이 필요하지만, 여기서는 근사치 사용
-	methodBodyRegex := regexp.MustCompile(`(?s)` + regexp.QuoteMeta(method.Name) + `\s*\([^)]*\)\s*\{.*?\}`)
-	match := methodBodyRegex.FindString(file.Content)
-	
-	if match == "" {
+func (r *MethodLengthRule) calculateMethodLength(body string) int {
+	if body == "" {
 		return 0
 	}
-	
-	return strings.Count(match, "\n")
+	if r.count == countStatements {
+		return countCodeLines(body)
+	}
+
+	// 메소드 본문 중괄호 블록의 라인 수
+	return strings.Count(body, "\n")
 }
 
 func (r *MethodLengthRule) getMaxLines() int {
@@ -537,30 +647,6 @@ func (r *MethodLengthRule) getMaxLines() int {
 	return 100
 }
 
-func (r *MethodLengthRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
-// 헬퍼 함수들
-func getLineNumberFromPosition(content string, pos int) int {
-	return strings.Count(content[:pos], "\n") + 1
-}
-
-func getColumnFromPosition(content string, pos int) int {
-	lines := strings.Split(content[:pos], "\n")
-	if len(lines) == 0 {
-		return 1
-	}
-	return len(lines[len(lines)-1]) + 1
-}
-
-func intToString(i int) string {
-	return strconv.Itoa(i)
-}
-
 // ExceptionHandlingRule 예외 처리 검사
 type ExceptionHandlingRule struct {
 	config config.RuleConfig
@@ -575,47 +661,46 @@ func (r *ExceptionHandlingRule) Name() string               { return r.config.Na
 func (r *ExceptionHandlingRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *ExceptionHandlingRule) Category() string          { return r.config.Category }
 func (r *ExceptionHandlingRule) Description() string       { return r.config.Description }
+func (r *ExceptionHandlingRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *ExceptionHandlingRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// printStackTrace 사용 검사
-	printStackTraceRegex := regexp.MustCompile(`\.printStackTrace\(\)`)
 	matches := printStackTraceRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range matches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
+		lineNum := file.LineAt(match[0])
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      file.ColumnAt(match[0]),
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "printStackTrace() 사용이 발견되었습니다",
-			Description: "예외 스택트레이스가 콘솔에 노출되어 보안 위험이 있습니다",
-			Suggestion:  "Logger를 사용하여 적절한 로깅을 하세요",
-			CodeSnippet: r.getCodeSnippet(file, lineNum),
+			Message:     i18n.T("java.print-stack-trace.message"),
+			Description: i18n.T("java.print-stack-trace.description"),
+			Suggestion:  i18n.T("java.print-stack-trace.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
 		})
 	}
 
 	// throw new Exception() without proper handling 검사
-	throwRegex := regexp.MustCompile(`throw\s+new\s+Exception\s*\([^)]*\)`)
-	throwMatches := throwRegex.FindAllStringIndex(file.Content, -1)
+	throwMatches := genericThrowRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range throwMatches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
+		lineNum := file.LineAt(match[0])
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      file.ColumnAt(match[0]),
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "일반적인 Exception 타입을 사용하고 있습니다",
-			Description: "구체적인 예외 타입을 사용하는 것이 좋습니다",
-			Suggestion:  "구체적인 예외 클래스(BusinessException 등)를 정의하여 사용하세요",
-			CodeSnippet: r.getCodeSnippet(file, lineNum),
+			Message:     i18n.T("java.generic-exception.message"),
+			Description: i18n.T("java.generic-exception.description"),
+			Suggestion:  i18n.T("java.generic-exception.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
 		})
 	}
 
@@ -628,10 +713,11 @@ func (r *ExceptionHandlingRule) Check(file *parser.ParsedFile) []types.Issue {
 			Line:        1,
 			Column:      1,
 			Severity:    r.Severity(),
+			Confidence:  config.ConfidenceLow,
 			Category:    r.Category(),
-			Message:     "전역 예외 처리기(@ControllerAdvice)가 없습니다",
-			Description: "일관된 예외 처리를 위해 전역 예외 처리기가 필요합니다",
-			Suggestion:  "@ControllerAdvice 클래스를 생성하여 전역 예외 처리를 구현하세요",
+			Message:     i18n.T("java.controller-advice.message"),
+			Description: i18n.T("java.controller-advice.description"),
+			Suggestion:  i18n.T("java.controller-advice.suggestion"),
 			CodeSnippet: "",
 		})
 	}
@@ -652,13 +738,6 @@ func (r *ExceptionHandlingRule) hasGlobalExceptionHandler(content string) bool {
 	return strings.Contains(content, "@ControllerAdvice") || strings.Contains(content, "@RestControllerAdvice")
 }
 
-func (r *ExceptionHandlingRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // InputValidationRule 입력 검증 검사
 type InputValidationRule struct {
 	config config.RuleConfig
@@ -673,6 +752,7 @@ func (r *InputValidationRule) Name() string               { return r.config.Name
 func (r *InputValidationRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *InputValidationRule) Category() string          { return r.config.Category }
 func (r *InputValidationRule) Description() string       { return r.config.Description }
+func (r *InputValidationRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *InputValidationRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -688,22 +768,22 @@ func (r *InputValidationRule) Check(file *parser.ParsedFile) []types.Issue {
 	}
 
 	// BenefitValidation 커스텀 검증 로직 사용 검사
-	benefitValidationRegex := regexp.MustCompile(`BenefitValidation\.(isEmpty|isNull|isValid)`)
 	matches := benefitValidationRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range matches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
+		lineNum := file.LineAt(match[0])
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      file.ColumnAt(match[0]),
 			Severity:    r.Severity(),
+			Confidence:  config.ConfidenceMedium,
 			Category:    r.Category(),
-			Message:     "커스텀 검증 로직 대신 Bean Validation 표준을 사용하세요",
-			Description: "표준 검증 미적용 시 SQL인젝션, XSS 등 보안 취약점 위험이 증가합니다",
-			Suggestion:  "@Valid, @NotNull, @Size 등 Bean Validation 어노테이션을 사용하세요",
-			CodeSnippet: r.getCodeSnippet(file, lineNum),
+			Message:     i18n.T("java.bean-validation.message"),
+			Description: i18n.T("java.bean-validation.description"),
+			Suggestion:  i18n.T("java.bean-validation.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
 		})
 	}
 
@@ -718,11 +798,12 @@ func (r *InputValidationRule) Check(file *parser.ParsedFile) []types.Issue {
 					Line:        method.Line,
 					Column:      method.Column,
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceLow,
 					Category:    r.Category(),
-					Message:     "@RequestBody 파라미터에 @Valid 어노테이션이 누락되었습니다",
-					Description: "입력 검증이 누락되어 잘못된 데이터가 처리될 수 있습니다",
-					Suggestion:  "@RequestBody @Valid 를 사용하여 자동 검증을 적용하세요",
-					CodeSnippet: r.getCodeSnippet(file, method.Line),
+					Message:     i18n.T("java.request-body-valid.message"),
+					Description: i18n.T("java.request-body-valid.description"),
+					Suggestion:  i18n.T("java.request-body-valid.suggestion"),
+					CodeSnippet: getCodeSnippet(file, method.Line),
 				})
 			}
 		}
@@ -789,20 +870,15 @@ func (r *InputValidationRule) hasRequestBodyParameter(parameters []string, conte
 	return false
 }
 
-func (r *InputValidationRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // CyclomaticComplexityRule 순환 복잡도 검사
 type CyclomaticComplexityRule struct {
 	config config.RuleConfig
+	filter methodFilter // 계산에서 제외할 메소드 (exclude_methods)
+	count  string       // statements면 주석을 빼고 분기문 계산 (count)
 }
 
 func NewCyclomaticComplexityRule(cfg config.RuleConfig) Rule {
-	return &CyclomaticComplexityRule{config: cfg}
+	return &CyclomaticComplexityRule{config: cfg, filter: newMethodFilter(cfg.Custom), count: cfg.Custom["count"]}
 }
 
 func (r *CyclomaticComplexityRule) ID() string                 { return r.config.ID }
@@ -810,6 +886,7 @@ func (r *CyclomaticComplexityRule) Name() string               { return r.config
 func (r *CyclomaticComplexityRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *CyclomaticComplexityRule) Category() string          { return r.config.Category }
 func (r *CyclomaticComplexityRule) Description() string       { return r.config.Description }
+func (r *CyclomaticComplexityRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *CyclomaticComplexityRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -820,7 +897,11 @@ func (r *CyclomaticComplexityRule) Check(file *parser.ParsedFile) []types.Issue
 	}
 
 	for _, method := range javaClass.Methods {
-		complexity := r.calculateComplexity(file, method)
+		body := extractBlockFromLine(file, method.Line)
+		if r.filter.skip(method, body) {
+			continue
+		}
+		complexity := r.calculateComplexity(body)
 		
 		if complexity > 10 { // 순환 복잡도 임계값
 			issues = append(issues, types.Issue{
@@ -830,10 +911,15 @@ func (r *CyclomaticComplexityRule) Check(file *parser.ParsedFile) []types.Issue
 				Column:      method.Column,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     fmt.Sprintf("메소드 '%s'의 순환 복잡도가 너무 높습니다 (복잡도: %d)", method.Name, complexity),
-				Description: "높은 순환 복잡도는 코드 이해도와 테스트 어려움을 증가시킵니다",
-				Suggestion:  "메소드를 더 작은 단위로 분할하여 복잡도를 낮추세요",
-				CodeSnippet: r.getCodeSnippet(file, method.Line),
+				Message:     i18n.T("java.complexity.message", method.Name, complexity),
+				Description: i18n.T("java.complexity.description"),
+				Suggestion:  i18n.T("java.complexity.suggestion"),
+				CodeSnippet: getCodeSnippet(file, method.Line),
+				Params: map[string]string{
+					"method":    method.Name,
+					"value":     intToString(complexity),
+					"threshold": "10",
+				},
 			})
 		}
 	}
@@ -841,86 +927,104 @@ func (r *CyclomaticComplexityRule) Check(file *parser.ParsedFile) []types.Issue
 	return issues
 }
 
-func (r *CyclomaticComplexityRule) calculateComplexity(file *parser.ParsedFile, method parser.JavaMethod) int {
-	// 메소드 본문 추출
-	methodBody := r.extractMethodBody(file, method)
+func (r *CyclomaticComplexityRule) calculateComplexity(methodBody string) int {
 	if methodBody == "" {
 		return 1 // 기본 복잡도
 	}
-
-	complexity := 1 // 기본 경로 1개
-
-	// 분기문 패턴들
-	branchPatterns := []string{
-		`\bif\s*\(`,          // if 문
-		`\belse\s+if\s*\(`,   // else if 문  
-		`\belse\b`,           // else 문
-		`\bwhile\s*\(`,       // while 문
-		`\bfor\s*\(`,         // for 문
-		`\bdo\s*\{`,          // do-while 문
-		`\bswitch\s*\(`,      // switch 문
-		`\bcase\s+`,          // case 문
-		`\bcatch\s*\(`,       // catch 문
-		`\?\s*[^:]+\s*:`,     // 삼항연산자
-		`\&\&`,               // 논리 AND
-		`\|\|`,               // 논리 OR
-	}
-
-	for _, pattern := range branchPatterns {
-		regex := regexp.MustCompile(pattern)
-		matches := regex.FindAllString(methodBody, -1)
-		complexity += len(matches)
+	if r.count == countStatements {
+		methodBody = stripJavaComments(methodBody) // 주석 안의 if/for는 세지 않음
 	}
 
-	return complexity
+	// 기본 경로 1개 + 분기문 개수
+	return 1 + countMatches(branchRegexes, methodBody)
 }
 
-func (r *CyclomaticComplexityRule) extractMethodBody(file *parser.ParsedFile, method parser.JavaMethod) string {
-	// 메소드 시작 위치 찾기
-	methodPattern := regexp.QuoteMeta(method.Name) + `\s*\([^)]*\)\s*\{`
-	methodRegex := regexp.MustCompile(methodPattern)
-	
-	match := methodRegex.FindStringIndex(file.Content)
-	if match == nil {
-		return ""
-	}
+// 중복 블록 정규화 수준 (custom의 normalize)
+const (
+	normalizeNone        = "none"        // 앞뒤 공백만 제거
+	normalizeLiterals    = "literals"    // 문자열/숫자 리터럴 치환
+	normalizeIdentifiers = "identifiers" // 리터럴과 식별자까지 치환 (구조만 비교)
+)
 
-	// 메소드 본문 추출 (중괄호 매칭)
-	start := match[1] - 1 // '{' 위치
-	braceCount := 1
-	i := start + 1
+// DuplicateCodeRule 중복 코드 검사
+type DuplicateCodeRule struct {
+	config         config.RuleConfig
+	patterns       []duplicatePattern
+	minPatternHits int    // 패턴이 이 횟수 이상 반복되면 중복 (min_pattern_occurrences)
+	blockSize      int    // 비교할 블록 라인 수 (block_size)
+	minBlockLines  int    // 블록 안의 실제 코드 라인 최소 수 (min_block_lines)
+	minBlockHits   int    // 같은 블록이 이 횟수 이상 나타나면 중복 (min_occurrences)
+	normalize      string // 정규화 수준 (normalize)
+	scope          string // method면 메소드 본문끼리, window면 block_size 라인 창끼리 비교 (scope)
+	minMethodLines int    // 비교할 메소드 본문의 실제 코드 라인 최소 수 (min_method_lines)
+	similarity     int    // 이 비율(%) 이상 같으면 복제 메소드 (similarity)
+}
 
-	content := []rune(file.Content)
-	for i < len(content) && braceCount > 0 {
-		if content[i] == '{' {
-			braceCount++
-		} else if content[i] == '}' {
-			braceCount--
-		}
-		i++
+func NewDuplicateCodeRule(cfg config.RuleConfig) Rule {
+	normalize := cfg.Custom["normalize"]
+	if normalize != normalizeNone && normalize != normalizeLiterals {
+		normalize = normalizeIdentifiers
 	}
 
-	if braceCount == 0 {
-		return string(content[start:i])
+	return &DuplicateCodeRule{
+		config:         cfg,
+		patterns:       selectDuplicatePatterns(cfg.Custom),
+		minPatternHits: customInt(cfg.Custom, "min_pattern_occurrences", 3),
+		blockSize:      customInt(cfg.Custom, "block_size", 5),
+		minBlockLines:  customInt(cfg.Custom, "min_block_lines", 3),
+		minBlockHits:   customInt(cfg.Custom, "min_occurrences", 2),
+		normalize:      normalize,
+		scope:          cfg.Custom["scope"],
+		minMethodLines: customInt(cfg.Custom, "min_method_lines", 5),
+		similarity:     customInt(cfg.Custom, "similarity", 80),
 	}
-
-	return ""
 }
 
-func (r *CyclomaticComplexityRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
+// selectDuplicatePatterns builtin_patterns로 고른 기본 패턴과 pattern_<이름>으로 추가한 패턴
+// builtin_patterns를 생략하면 기본 패턴 전체, none이면 기본 패턴을 쓰지 않음
+func selectDuplicatePatterns(custom map[string]string) []duplicatePattern {
+	var patterns []duplicatePattern
+
+	builtin, ok := custom["builtin_patterns"]
+	enabled := make(map[string]bool)
+	for _, name := range splitList(builtin) {
+		enabled[name] = true
+	}
+	for _, dp := range duplicatePatterns {
+		if !ok || enabled[dp.name] {
+			patterns = append(patterns, dp)
+		}
 	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
 
-// DuplicateCodeRule 중복 코드 검사
-type DuplicateCodeRule struct {
-	config config.RuleConfig
+	// 프로젝트별 패턴 (잘못된 정규식은 무시)
+	var names []string
+	for key := range custom {
+		if strings.HasPrefix(key, "pattern_") {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	for _, key := range names {
+		regex, err := regexp.Compile(custom[key])
+		if err != nil {
+			continue
+		}
+		patterns = append(patterns, duplicatePattern{
+			name:        strings.TrimPrefix(key, "pattern_"),
+			regex:       regex,
+			description: "java.duplicate-pattern.custom.description",
+			suggestion:  "java.duplicate-pattern.custom.suggestion",
+		})
+	}
+	return patterns
 }
 
-func NewDuplicateCodeRule(cfg config.RuleConfig) Rule {
-	return &DuplicateCodeRule{config: cfg}
+// customInt custom 설정의 양의 정수 값 (없거나 잘못되면 기본값)
+func customInt(custom map[string]string, key string, fallback int) int {
+	if value, err := strconv.Atoi(custom[key]); err == nil && value > 0 {
+		return value
+	}
+	return fallback
 }
 
 func (r *DuplicateCodeRule) ID() string                 { return r.config.ID }
@@ -928,69 +1032,121 @@ func (r *DuplicateCodeRule) Name() string               { return r.config.Name }
 func (r *DuplicateCodeRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *DuplicateCodeRule) Category() string          { return r.config.Category }
 func (r *DuplicateCodeRule) Description() string       { return r.config.Description }
+func (r *DuplicateCodeRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *DuplicateCodeRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// 공통 패턴들 검사
-	duplicatePatterns := []struct {
-		pattern     string
-		description string
-		suggestion  string
-	}{
-		{
-			pattern:     `responseBody\.put\(.*?\);`,
-			description: "API 응답 생성 패턴이 중복되고 있습니다",
-			suggestion:  "공통 응답 클래스(ApiResponse)를 만들어 사용하세요",
-		},
-		{
-			pattern:     `cdService\.selectCdList\([^)]+\)`,
-			description: "코드 목록 조회가 반복되고 있습니다",
-			suggestion:  "캐싱을 적용하거나 공통 메소드로 추출하세요",
-		},
-		{
-			pattern:     `if\s*\([^)]*==\s*null[^)]*\)\s*\{[^}]*throw[^}]*\}`,
-			description: "null 체크 후 예외 발생 패턴이 중복됩니다",
-			suggestion:  "공통 검증 메소드를 만들어 사용하세요",
-		},
-		{
-			pattern:     `logger\.(info|debug|error)\([^)]*\);\s*return`,
-			description: "로깅 후 return 패턴이 반복됩니다",
-			suggestion:  "공통 로깅 유틸리티를 만들어 사용하세요",
-		},
-	}
+	for _, dp := range r.patterns {
+		matches := dp.regex.FindAllStringIndex(file.Content, -1)
 
-	for _, dp := range duplicatePatterns {
-		regex := regexp.MustCompile(dp.pattern)
-		matches := regex.FindAllStringIndex(file.Content, -1)
-
-		if len(matches) >= 3 { // 3번 이상 반복되면 중복으로 간주
+		if len(matches) >= r.minPatternHits {
 			for _, match := range matches {
-				lineNum := getLineNumberFromPosition(file.Content, match[0])
+				lineNum := file.LineAt(match[0])
 				issues = append(issues, types.Issue{
 					RuleID:      r.ID(),
 					File:        file.Path,
 					Line:        lineNum,
-					Column:      getColumnFromPosition(file.Content, match[0]),
+					Column:      file.ColumnAt(match[0]),
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceLow,
 					Category:    r.Category(),
-					Message:     fmt.Sprintf("중복 코드 패턴이 발견되었습니다 (%d회 반복)", len(matches)),
-					Description: dp.description,
-					Suggestion:  dp.suggestion,
-					CodeSnippet: r.getCodeSnippet(file, lineNum),
+					Message:     i18n.T("java.duplicate-pattern.message", len(matches)),
+					Description: i18n.T(dp.description),
+					Suggestion:  i18n.T(dp.suggestion),
+					CodeSnippet: getCodeSnippet(file, lineNum),
 				})
 			}
 		}
 	}
 
-	// 동일한 라인 블록 검사 (5라인 이상)
-	r.checkDuplicateBlocks(file, &issues)
+	// 메소드 경계를 알 수 있으면 메소드 본문끼리 비교, 아니면 라인 블록 비교
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if ok && len(javaClass.Methods) > 0 && r.scope != "window" {
+		r.checkDuplicateMethods(file, javaClass.Methods, &issues)
+	} else {
+		r.checkDuplicateBlocks(file, &issues)
+	}
 
 	return issues
 }
 
+// methodBody 정규화된 메소드 본문
+type methodBody struct {
+	method parser.JavaMethod
+	lines  []string
+}
+
+// checkDuplicateMethods 메소드 본문을 쌍으로 비교하여 유사도가 기준 이상인 복제 쌍 보고 (뒤에 나온 메소드에 보고)
+func (r *DuplicateCodeRule) checkDuplicateMethods(file *parser.ParsedFile, methods []parser.JavaMethod, issues *[]types.Issue) {
+	var bodies []methodBody
+	for _, method := range methods {
+		block := extractBlockFromLine(file, method.Line)
+		lines := r.normalizeLines(strings.Split(block, "\n"))
+		if len(lines) >= r.minMethodLines {
+			bodies = append(bodies, methodBody{method: method, lines: lines})
+		}
+	}
+
+	for i := 1; i < len(bodies); i++ {
+		for j := 0; j < i; j++ {
+			original, clone := bodies[j], bodies[i]
+			similarity := lineSimilarity(original.lines, clone.lines)
+			if similarity < r.similarity {
+				continue
+			}
+
+			*issues = append(*issues, types.Issue{
+				RuleID:      r.ID(),
+				File:        file.Path,
+				Line:        clone.method.Line,
+				Column:      clone.method.Column,
+				Severity:    r.Severity(),
+				Confidence:  config.ConfidenceMedium,
+				Category:    r.Category(),
+				Message:     i18n.T("java.duplicate-method.message", clone.method.Name, original.method.Name, original.method.Line, similarity),
+				Description: i18n.T("java.duplicate-method.description"),
+				Suggestion:  i18n.T("java.duplicate-method.suggestion"),
+				CodeSnippet: getCodeSnippet(file, clone.method.Line),
+				Params: map[string]string{
+					"method":    clone.method.Name,
+					"original":  original.method.Name,
+					"value":     strconv.Itoa(similarity),
+					"threshold": strconv.Itoa(r.similarity),
+				},
+			})
+			break // 가장 먼저 나온 원본 하나만 보고
+		}
+	}
+}
+
+// lineSimilarity 두 라인 목록의 유사도 (최장 공통 부분열 기준, 0~100%)
+func lineSimilarity(a, b []string) int {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				curr[j] = prev[j-1] + 1
+			case prev[j] >= curr[j-1]:
+				curr[j] = prev[j]
+			default:
+				curr[j] = curr[j-1]
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)] * 200 / (len(a) + len(b))
+}
+
 func (r *DuplicateCodeRule) checkDuplicateBlocks(file *parser.ParsedFile, issues *[]types.Issue) {
-	blockSize := 5 // 최소 5라인 블록
+	blockSize := r.blockSize
 	blocks := make(map[string][]int) // 정규화된 블록 -> 라인 번호들
 
 	for i := 0; i <= len(file.Lines)-blockSize; i++ {
@@ -1001,7 +1157,7 @@ func (r *DuplicateCodeRule) checkDuplicateBlocks(file *parser.ParsedFile, issues
 	}
 
 	for _, lines := range blocks {
-		if len(lines) >= 2 { // 2번 이상 나타나면 중복
+		if len(lines) >= r.minBlockHits {
 			for _, lineNum := range lines {
 				*issues = append(*issues, types.Issue{
 					RuleID:      r.ID(),
@@ -1009,11 +1165,12 @@ func (r *DuplicateCodeRule) checkDuplicateBlocks(file *parser.ParsedFile, issues
 					Line:        lineNum,
 					Column:      1,
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceLow,
 					Category:    r.Category(),
-					Message:     fmt.Sprintf("중복된 코드 블록이 발견되었습니다 (%d개 위치에서 반복)", len(lines)),
-					Description: "동일한 코드 블록이 여러 곳에서 반복되고 있습니다",
-					Suggestion:  "공통 메소드로 추출하여 중복을 제거하세요",
-					CodeSnippet: r.getCodeSnippet(file, lineNum),
+					Message:     i18n.T("java.duplicate-block.message", len(lines)),
+					Description: i18n.T("java.duplicate-block.description"),
+					Suggestion:  i18n.T("java.duplicate-block.suggestion"),
+					CodeSnippet: getCodeSnippet(file, lineNum),
 				})
 			}
 		}
@@ -1021,6 +1178,16 @@ func (r *DuplicateCodeRule) checkDuplicateBlocks(file *parser.ParsedFile, issues
 }
 
 func (r *DuplicateCodeRule) normalizeBlock(lines []string) string {
+	normalized := r.normalizeLines(lines)
+	if len(normalized) < r.minBlockLines { // 실제 코드가 너무 적으면 제외
+		return ""
+	}
+	
+	return strings.Join(normalized, "\n")
+}
+
+// normalizeLines 빈 라인과 주석을 빼고 각 라인 정규화
+func (r *DuplicateCodeRule) normalizeLines(lines []string) []string {
 	var normalized []string
 	
 	for _, line := range lines {
@@ -1033,44 +1200,50 @@ func (r *DuplicateCodeRule) normalizeBlock(lines []string) string {
 		// 변수명, 문자열 등을 플레이스홀더로 변경하여 구조적 유사성 검사
 		normalized = append(normalized, r.normalizeCodeLine(trimmed))
 	}
-	
-	if len(normalized) < 3 { // 실제 코드가 3라인 미만이면 제외
-		return ""
-	}
-	
-	return strings.Join(normalized, "\n")
+	return normalized
 }
 
 func (r *DuplicateCodeRule) normalizeCodeLine(line string) string {
+	if r.normalize == normalizeNone {
+		return line
+	}
+
 	// 문자열 리터럴을 플레이스홀더로 변경
-	stringRegex := regexp.MustCompile(`"[^"]*"`)
-	line = stringRegex.ReplaceAllString(line, `"STRING"`)
+	line = stringLiteralRegex.ReplaceAllString(line, `"STRING"`)
 	
 	// 숫자를 플레이스홀더로 변경
-	numberRegex := regexp.MustCompile(`\b\d+\b`)
-	line = numberRegex.ReplaceAllString(line, "NUM")
+	line = numberLiteralRegex.ReplaceAllString(line, "NUM")
 	
+	if r.normalize == normalizeLiterals {
+		return line
+	}
+
 	// 변수명을 단순화 (camelCase, snake_case 등)
-	variableRegex := regexp.MustCompile(`\b[a-zA-Z_][a-zA-Z0-9_]*\b`)
-	line = variableRegex.ReplaceAllString(line, "VAR")
+	line = identifierRegex.ReplaceAllString(line, "VAR")
 	
 	return line
 }
 
-func (r *DuplicateCodeRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
-}
-
 // CodingConventionRule 코딩 컨벤션 검사
 type CodingConventionRule struct {
 	config config.RuleConfig
+	naming map[string]*regexp.Regexp // 요소 유형별 명명 규칙
+}
+
+// 요소 유형별 기본 명명 규칙 (custom의 <유형>_pattern으로 변경 가능)
+var defaultJavaNamingPatterns = map[string]string{
+	"class":    `^[A-Z][A-Za-z0-9]*$`,
+	"method":   `^[a-z][A-Za-z0-9]*$`,
+	"field":    `^[a-z][A-Za-z0-9]*$`,
+	"constant": `^[A-Z][A-Z0-9]*(?:_[A-Z0-9]+)*$`,
+	"package":  `^[a-z][a-z0-9_]*(?:\.[a-z][a-z0-9_]*)*$`,
 }
 
 func NewCodingConventionRule(cfg config.RuleConfig) Rule {
-	return &CodingConventionRule{config: cfg}
+	return &CodingConventionRule{
+		config: cfg,
+		naming: compileNamingPatterns(cfg, defaultJavaNamingPatterns),
+	}
 }
 
 func (r *CodingConventionRule) ID() string                 { return r.config.ID }
@@ -1078,6 +1251,7 @@ func (r *CodingConventionRule) Name() string               { return r.config.Nam
 func (r *CodingConventionRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *CodingConventionRule) Category() string          { return r.config.Category }
 func (r *CodingConventionRule) Description() string       { return r.config.Description }
+func (r *CodingConventionRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *CodingConventionRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -1105,155 +1279,233 @@ func (r *CodingConventionRule) checkAnnotationConsistency(file *parser.ParsedFil
 
 	if hasResource && hasAutowired {
 		// @Resource와 @Autowired가 모두 사용된 경우
-		resourceRegex := regexp.MustCompile(`@Resource`)
-		autowiredRegex := regexp.MustCompile(`@Autowired`)
-
-		resourceMatches := resourceRegex.FindAllStringIndex(file.Content, -1)
-		autowiredMatches := autowiredRegex.FindAllStringIndex(file.Content, -1)
+		resourceMatches := resourceAnnotationRegex.FindAllStringIndex(file.Content, -1)
+		autowiredMatches := autowiredAnnotationRegex.FindAllStringIndex(file.Content, -1)
 
 		// @Resource 사용 위치에 경고
 		for _, match := range resourceMatches {
-			lineNum := getLineNumberFromPosition(file.Content, match[0])
+			lineNum := file.LineAt(match[0])
 			*issues = append(*issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "@Resource와 @Autowired가 혼용되고 있습니다",
-				Description: "일관되지 않은 어노테이션 사용은 코드 품질을 저하시킵니다",
-				Suggestion:  "@Autowired로 통일하여 사용하세요 (Spring 권장사항)",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("java.annotation-mix.message"),
+				Description: i18n.T("java.annotation-mix.description"),
+				Suggestion:  i18n.T("java.annotation-mix.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 
 		// @Autowired 사용 위치에도 정보성 메시지
 		for _, match := range autowiredMatches {
-			lineNum := getLineNumberFromPosition(file.Content, match[0])
+			lineNum := file.LineAt(match[0])
 			*issues = append(*issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    config.SeverityLow, // 정보성 메시지
 				Category:    r.Category(),
-				Message:     "동일 클래스에서 @Resource와 @Autowired가 혼용되고 있습니다",
-				Description: "의존성 주입 어노테이션을 통일하는 것이 좋습니다",
-				Suggestion:  "프로젝트 전체에서 @Autowired로 통일하세요",
-				CodeSnippet: r.getCodeSnippet(file, lineNum),
+				Message:     i18n.T("java.annotation-mix.info-message"),
+				Description: i18n.T("java.annotation-mix.info-description"),
+				Suggestion:  i18n.T("java.annotation-mix.info-suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 	}
 }
 
 func (r *CodingConventionRule) checkNamingConvention(javaClass *parser.JavaClass, file *parser.ParsedFile, issues *[]types.Issue) {
-	// 클래스명 PascalCase 검사
-	if !r.isPascalCase(javaClass.Name) {
-		*issues = append(*issues, types.Issue{
-			RuleID:      r.ID(),
-			File:        file.Path,
-			Line:        1,
-			Column:      1,
-			Severity:    r.Severity(),
-			Category:    r.Category(),
-			Message:     "클래스명이 PascalCase 규칙을 따르지 않습니다: " + javaClass.Name,
-			Description: "Java 네이밍 컨벤션에 따라 클래스명은 PascalCase를 사용해야 합니다",
-			Suggestion:  "클래스명을 PascalCase로 변경하세요",
-			CodeSnippet: "class " + javaClass.Name,
-		})
+	// 패키지명 검사
+	if javaClass.Package != "" && !r.naming["package"].MatchString(javaClass.Package) {
+		*issues = append(*issues, r.namingIssue(file, 1, javaClass.Package, "package", "package "+javaClass.Package+";"))
 	}
 
-	// 메소드명 camelCase 검사
+	// 클래스명 검사
+	if javaClass.Name != "" && !r.naming["class"].MatchString(javaClass.Name) {
+		*issues = append(*issues, r.namingIssue(file, 1, javaClass.Name, "class", "class "+javaClass.Name))
+	}
+
+	// 메소드명 검사
 	for _, method := range javaClass.Methods {
-		if !r.isCamelCase(method.Name) && !r.isSpecialMethod(method.Name) {
+		if !r.naming["method"].MatchString(method.Name) && !r.isSpecialMethod(method.Name) {
+			*issues = append(*issues, r.namingIssue(file, method.Line, method.Name, "method", getCodeSnippet(file, method.Line)))
+		}
+	}
+
+	// 필드명/상수명 검사
+	for _, field := range javaClass.Fields {
+		kind := "field"
+		if r.isConstant(field) {
+			kind = "constant"
+		}
+		if !r.naming[kind].MatchString(field.Name) {
+			*issues = append(*issues, r.namingIssue(file, field.Line, field.Name, kind, getCodeSnippet(file, field.Line)))
+		}
+	}
+}
+
+// namingIssue 명명 규칙 위반 이슈 생성
+func (r *CodingConventionRule) namingIssue(file *parser.ParsedFile, line int, name, kind, snippet string) types.Issue {
+	pattern := r.naming[kind].String()
+	label := i18n.T("naming.label." + kind)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      1,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     i18n.T("naming.message", label, name),
+		Description: i18n.T("naming.description", kind, pattern),
+		Suggestion:  i18n.T("naming.suggestion", label, pattern),
+		CodeSnippet: snippet,
+		Params: map[string]string{
+			"value":   name,
+			"pattern": pattern,
+		},
+	}
+}
+
+// defaultMaxLineLength .editorconfig에 max_line_length가 없을 때의 라인 길이 기준
+const defaultMaxLineLength = 120
+
+func (r *CodingConventionRule) checkCodeStyle(file *parser.ParsedFile, issues *[]types.Issue) {
+	// .editorconfig 설정 (없거나 읽을 수 없으면 기본 기준 사용)
+	props, err := editorconfig.Resolve(file.Path)
+	if err != nil {
+		props = editorconfig.Properties{}
+	}
+
+	// 들여쓰기 검사
+	switch props.IndentStyle() {
+	case "tab", "space":
+		r.checkIndentStyle(file, props, issues)
+	default:
+		// 탭과 스페이스 혼용 검사
+		hasTab := strings.Contains(file.Content, "\t")
+		hasSpaceIndent := spaceIndentRegex.MatchString(file.Content)
+
+		if hasTab && hasSpaceIndent {
 			*issues = append(*issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
-				Line:        method.Line,
-				Column:      method.Column,
+				Line:        1,
+				Column:      1,
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "메소드명이 camelCase 규칙을 따르지 않습니다: " + method.Name,
-				Description: "Java 네이밍 컨벤션에 따라 메소드명은 camelCase를 사용해야 합니다",
-				Suggestion:  "메소드명을 camelCase로 변경하세요",
-				CodeSnippet: r.getCodeSnippet(file, method.Line),
+				Message:     i18n.T("java.mixed-indent.message"),
+				Description: i18n.T("java.mixed-indent.description"),
+				Suggestion:  i18n.T("java.mixed-indent.suggestion"),
+				CodeSnippet: "",
 			})
 		}
 	}
 
-	// 필드명 camelCase 검사
-	for _, field := range javaClass.Fields {
-		if !r.isCamelCase(field.Name) && !r.isConstant(field) {
+	// 긴 라인 검사 (기본 120자 초과, .editorconfig의 max_line_length 우선)
+	maxLength := props.MaxLineLength()
+	if maxLength == 0 {
+		maxLength = defaultMaxLineLength
+	}
+	if maxLength > 0 {
+		threshold := intToString(maxLength)
+		for i, line := range file.Lines {
+			length := utf8.RuneCountInString(line)
+			if length > maxLength {
+				*issues = append(*issues, types.Issue{
+					RuleID:      r.ID(),
+					File:        file.Path,
+					Line:        i + 1,
+					Column:      maxLength + 1,
+					Severity:    config.SeverityLow,
+					Category:    r.Category(),
+					Message:     i18n.T("java.line-length.message", length),
+					Description: i18n.T("java.line-length.description"),
+					Suggestion:  i18n.T("java.line-length.suggestion", threshold),
+					CodeSnippet: getCodeSnippet(file, i+1),
+					Params: map[string]string{
+						"value":     intToString(length),
+						"threshold": threshold,
+					},
+				})
+			}
+		}
+	}
+
+	// 파일 끝 개행 검사
+	if want, ok := props.InsertFinalNewline(); ok {
+		if has, err := endsWithNewline(file.Path); err == nil && has != want {
+			message, suggestion := i18n.T("java.final-newline.missing-message"), i18n.T("java.final-newline.missing-suggestion")
+			if !want {
+				message, suggestion = i18n.T("java.final-newline.extra-message"), i18n.T("java.final-newline.extra-suggestion")
+			}
+			// 파싱 결과는 항상 개행으로 끝나므로 마지막 빈 요소는 제외
+			lastLine := len(file.Lines)
+			if lastLine > 1 && file.Lines[lastLine-1] == "" {
+				lastLine--
+			}
 			*issues = append(*issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
-				Line:        field.Line,
+				Line:        lastLine,
 				Column:      1,
-				Severity:    r.Severity(),
+				Severity:    config.SeverityLow,
 				Category:    r.Category(),
-				Message:     "필드명이 camelCase 규칙을 따르지 않습니다: " + field.Name,
-				Description: "Java 네이밍 컨벤션에 따라 필드명은 camelCase를 사용해야 합니다",
-				Suggestion:  "필드명을 camelCase로 변경하세요",
-				CodeSnippet: r.getCodeSnippet(file, field.Line),
+				Message:     message,
+				Description: i18n.T("java.final-newline.description"),
+				Suggestion:  suggestion,
 			})
 		}
 	}
 }
 
-func (r *CodingConventionRule) checkCodeStyle(file *parser.ParsedFile, issues *[]types.Issue) {
-	// 탭과 스페이스 혼용 검사
-	hasTab := strings.Contains(file.Content, "\t")
-	hasSpaceIndent := regexp.MustCompile(`^\s{4,}`).MatchString(file.Content)
+// checkIndentStyle .editorconfig의 indent_style/indent_size와 다른 들여쓰기 검사 (파일당 첫 위반만 보고)
+func (r *CodingConventionRule) checkIndentStyle(file *parser.ParsedFile, props editorconfig.Properties, issues *[]types.Issue) {
+	style := props.IndentStyle()
+	size := props.IndentSize()
+
+	for i, line := range file.Lines {
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if indent == "" || strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		var message, suggestion string
+		switch {
+		case style == "space" && strings.Contains(indent, "\t"):
+			message, suggestion = i18n.T("java.indent.tab-message"), i18n.T("java.indent.tab-suggestion")
+		case style == "tab" && strings.HasPrefix(indent, " ") && !isCommentContinuation(line):
+			message, suggestion = i18n.T("java.indent.space-message"), i18n.T("java.indent.space-suggestion")
+		case style == "space" && size > 0 && len(indent)%size != 0 && !isCommentContinuation(line):
+			message = i18n.T("java.indent.size-message", size, len(indent))
+			suggestion = i18n.T("java.indent.size-suggestion", size)
+		default:
+			continue
+		}
 
-	if hasTab && hasSpaceIndent {
 		*issues = append(*issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
-			Line:        1,
+			Line:        i + 1,
 			Column:      1,
 			Severity:    r.Severity(),
 			Category:    r.Category(),
-			Message:     "탭과 스페이스가 혼용되고 있습니다",
-			Description: "일관된 들여쓰기를 사용해야 코드 가독성이 향상됩니다",
-			Suggestion:  "탭 또는 스페이스 중 하나로 통일하세요",
-			CodeSnippet: "",
+			Message:     message,
+			Description: i18n.T("java.indent.description", style),
+			Suggestion:  suggestion,
+			CodeSnippet: getCodeSnippet(file, i+1),
 		})
+		return
 	}
-
-	// 긴 라인 검사 (120자 초과)
-	for i, line := range file.Lines {
-		if len(line) > 120 {
-			*issues = append(*issues, types.Issue{
-				RuleID:      r.ID(),
-				File:        file.Path,
-				Line:        i + 1,
-				Column:      121,
-				Severity:    config.SeverityLow,
-				Category:    r.Category(),
-				Message:     fmt.Sprintf("라인이 너무 깁니다 (%d자)", len(line)),
-				Description: "긴 라인은 가독성을 저하시킵니다",
-				Suggestion:  "라인을 120자 이하로 분할하세요",
-				CodeSnippet: r.getCodeSnippet(file, i+1),
-			})
-		}
-	}
-}
-
-func (r *CodingConventionRule) isPascalCase(name string) bool {
-	if len(name) == 0 {
-		return false
-	}
-	// 첫 글자가 대문자이고, 언더스코어나 다른 특수문자가 없어야 함
-	return name[0] >= 'A' && name[0] <= 'Z' && !strings.Contains(name, "_")
 }
 
-func (r *CodingConventionRule) isCamelCase(name string) bool {
-	if len(name) == 0 {
-		return false
-	}
-	// 첫 글자가 소문자이고, 언더스코어가 없어야 함
-	return name[0] >= 'a' && name[0] <= 'z' && !strings.Contains(name, "_")
+// isCommentContinuation 블록 주석의 연속 라인인지 확인 (" * ..." 형태는 정렬용 공백 허용)
+func isCommentContinuation(line string) bool {
+	trimmed := strings.TrimLeft(line, " \t")
+	return strings.HasPrefix(trimmed, "*")
 }
 
 func (r *CodingConventionRule) isSpecialMethod(name string) bool {
@@ -1271,11 +1523,4 @@ func (r *CodingConventionRule) isSpecialMethod(name string) bool {
 func (r *CodingConventionRule) isConstant(field parser.JavaField) bool {
 	// static final 필드는 상수로 간주
 	return field.IsStatic && field.IsFinal
-}
-
-func (r *CodingConventionRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return strings.TrimSpace(file.Lines[line-1])
 }
\ No newline at end of file
@@ -0,0 +1,227 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 로깅 규약 규칙에서 사용하는 정규식
+var (
+	// 로거 필드 선언: (제한자)* Logger 이름 = 초기화식;
+	loggerFieldRegex = regexp.MustCompile(`(?m)^[ \t]*((?:(?:private|protected|public|static|final)\s+)*)(?:[\w.]*\.)?(?:Logger|Log)\s+(\w+)\s*=\s*([^;]+);`)
+	logCallRegex     = regexp.MustCompile(`\b(\w+)\s*\.\s*(trace|debug|info|warn|error)\s*\(`)
+	// 로그 메시지를 문자열 연결로 만드는 경우 ("..." + value, value + "...")
+	logConcatRegex = regexp.MustCompile(`"\s*\+|\+\s*"`)
+	catchTypeRegex = regexp.MustCompile(`\bcatch\s*\(\s*(?:final\s+)?([\w.|\s]+?)\s+\w+\s*\)`)
+	throwNewRegex  = regexp.MustCompile(`\bthrow\s+new\s+([\w.]+)\s*\(`)
+	lombokLogRegex = regexp.MustCompile(`^@(?:lombok\.extern\.\w+\.)?(?:Slf4j|Log4j2?|CommonsLog|Log|XSlf4j|JBossLog|Flogger)\b`)
+)
+
+// 기본 옵션값
+var (
+	defaultLoggerFactories    = []string{"LoggerFactory.getLogger"}
+	defaultBusinessExceptions = []string{
+		"ValidationException", "BusinessException", "IllegalArgumentException",
+		"MethodArgumentNotValidException", "BindException", "ConstraintViolationException",
+	}
+)
+
+// LoggingConventionRule 로깅 규약 검사
+// 승인된 팩토리로 로거 생성, 로거 필드의 private static final, 업무 검증 실패의 error 레벨 사용,
+// 로그 메시지의 문자열 연결을 확인합니다
+type LoggingConventionRule struct {
+	config             config.RuleConfig
+	factories          []string // 승인된 로거 팩토리 호출 (logger_factories, 기본값 LoggerFactory.getLogger)
+	businessExceptions []string // 업무 검증 예외 이름 접미사 (business_exceptions)
+}
+
+func NewLoggingConventionRule(cfg config.RuleConfig) Rule {
+	return &LoggingConventionRule{
+		config:             cfg,
+		factories:          listOrDefault(cfg.Custom["logger_factories"], defaultLoggerFactories),
+		businessExceptions: listOrDefault(cfg.Custom["business_exceptions"], defaultBusinessExceptions),
+	}
+}
+
+func (r *LoggingConventionRule) ID() string   { return r.config.ID }
+func (r *LoggingConventionRule) Name() string { return r.config.Name }
+func (r *LoggingConventionRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *LoggingConventionRule) Category() string    { return r.config.Category }
+func (r *LoggingConventionRule) Description() string { return r.config.Description }
+func (r *LoggingConventionRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *LoggingConventionRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok {
+		return issues
+	}
+
+	// 로거 이름 (Lombok 어노테이션이 만드는 log 포함)
+	loggers := make(map[string]bool)
+	for _, annotation := range javaClass.Annotations {
+		if lombokLogRegex.MatchString(annotation) {
+			loggers["log"] = true
+		}
+	}
+
+	for _, match := range loggerFieldRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		modifiers := strings.Fields(file.Content[match[2]:match[3]])
+		name := file.Content[match[4]:match[5]]
+		initializer := file.Content[match[6]:match[7]]
+		loggers[name] = true
+
+		if !r.approvedFactory(initializer) {
+			issues = append(issues, r.newIssue(file, match[4],
+				i18n.T("logging.factory.message", name, strings.TrimSpace(initializer)),
+				i18n.T("logging.factory.description"),
+				i18n.T("logging.factory.suggestion", strings.Join(r.factories, i18n.T("logging.factory.separator")))))
+		}
+
+		var missing []string
+		for _, modifier := range []string{"private", "static", "final"} {
+			if !containsType(modifiers, modifier) {
+				missing = append(missing, modifier)
+			}
+		}
+		if len(missing) > 0 {
+			issues = append(issues, r.newIssue(file, match[4],
+				i18n.T("logging.modifiers.message", name, strings.Join(missing, " ")),
+				i18n.T("logging.modifiers.description"),
+				i18n.T("logging.modifiers.suggestion", name)))
+		}
+	}
+
+	if len(loggers) == 0 {
+		return issues
+	}
+
+	businessCatches := r.businessCatchBlocks(file)
+	for _, match := range logCallRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		if !loggers[file.Content[match[2]:match[3]]] {
+			continue
+		}
+		line := file.LineAt(match[0])
+		if strings.HasPrefix(strings.TrimSpace(getLineContent(file, line)), "//") {
+			continue
+		}
+
+		level := file.Content[match[4]:match[5]]
+		if level == "error" && (inRanges(businessCatches, match[0]) || r.throwsBusinessAfter(file, line)) {
+			issues = append(issues, r.newIssue(file, match[0],
+				i18n.T("logging.business-error.message"),
+				i18n.T("logging.business-error.description"),
+				i18n.T("logging.business-error.suggestion")))
+		}
+
+		if args := logArguments(file.Content[match[1]:]); logConcatRegex.MatchString(args) {
+			issues = append(issues, r.newIssue(file, match[0],
+				i18n.T("logging.concat.message"),
+				i18n.T("logging.concat.description"),
+				i18n.T("logging.concat.suggestion", level)))
+		}
+	}
+
+	return issues
+}
+
+// approvedFactory 로거 초기화식이 승인된 팩토리 호출인지 확인
+func (r *LoggingConventionRule) approvedFactory(initializer string) bool {
+	compact := strings.Join(strings.Fields(initializer), "")
+	for _, factory := range r.factories {
+		if strings.Contains(compact, factory+"(") {
+			return true
+		}
+	}
+	return false
+}
+
+// businessCatchBlocks 업무 검증 예외를 잡는 catch 블록의 위치 범위
+func (r *LoggingConventionRule) businessCatchBlocks(file *parser.ParsedFile) [][2]int {
+	var ranges [][2]int
+	for _, match := range catchTypeRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		if !r.isBusinessException(file.Content[match[2]:match[3]]) {
+			continue
+		}
+		block := extractBlockFromLine(file, file.LineAt(match[1]))
+		start := strings.Index(file.Content[match[1]:], block) + match[1]
+		ranges = append(ranges, [2]int{start, start + len(block)})
+	}
+	return ranges
+}
+
+// throwsBusinessAfter 로그 다음 두 라인 안에서 업무 검증 예외를 던지는지 확인
+func (r *LoggingConventionRule) throwsBusinessAfter(file *parser.ParsedFile, line int) bool {
+	for next := line + 1; next <= line+2; next++ {
+		if match := throwNewRegex.FindStringSubmatch(getLineContent(file, next)); match != nil {
+			return r.isBusinessException(match[1])
+		}
+	}
+	return false
+}
+
+// isBusinessException 예외 타입(multi-catch 포함)이 업무 검증 예외인지 확인
+func (r *LoggingConventionRule) isBusinessException(typeNames string) bool {
+	for _, typeName := range strings.Split(typeNames, "|") {
+		typeName = genericBase(strings.TrimSpace(typeName))
+		for _, suffix := range r.businessExceptions {
+			if strings.HasSuffix(typeName, suffix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *LoggingConventionRule) newIssue(file *parser.ParsedFile, offset int, message, description, suggestion string) types.Issue {
+	line := file.LineAt(offset)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      file.ColumnAt(offset),
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, line),
+	}
+}
+
+// logArguments 로그 호출의 인자 부분 (여는 괄호 다음부터 짝이 맞는 닫는 괄호까지)
+func logArguments(rest string) string {
+	depth := 1
+	for i, c := range rest {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return rest[:i]
+			}
+		case ';':
+			return rest[:i]
+		}
+	}
+	return rest
+}
+
+// inRanges 위치가 범위 중 하나에 속하는지 확인
+func inRanges(ranges [][2]int, offset int) bool {
+	for _, r := range ranges {
+		if offset >= r[0] && offset < r[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,206 @@
+package rules
+
+import (
+	"strconv"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// DependencyPolicyRule 매니페스트 의존성의 금지 버전/라이선스 검사 (설정의 banned 목록 기반, CVE 조회는 하지 않음)
+type DependencyPolicyRule struct {
+	config config.RuleConfig
+}
+
+func NewDependencyPolicyRule(cfg config.RuleConfig) Rule {
+	return &DependencyPolicyRule{config: cfg}
+}
+
+func (r *DependencyPolicyRule) ID() string   { return r.config.ID }
+func (r *DependencyPolicyRule) Name() string { return r.config.Name }
+func (r *DependencyPolicyRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *DependencyPolicyRule) Category() string    { return r.config.Category }
+func (r *DependencyPolicyRule) Description() string { return r.config.Description }
+func (r *DependencyPolicyRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *DependencyPolicyRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	manifest, ok := file.AST.(*parser.Manifest)
+	if !ok {
+		return issues
+	}
+
+	for _, dependency := range manifest.Dependencies {
+		license := r.config.Licenses[dependency.Name]
+		for _, entry := range r.config.Banned {
+			if !r.matches(entry, dependency, license) {
+				continue
+			}
+
+			message := i18n.T("dependency.banned.message", dependency.Name)
+			switch {
+			case entry.License != "":
+				message = i18n.T("dependency.license.message", license, dependency.Name)
+			case entry.Versions != "":
+				message = i18n.T("dependency.version.message", dependency.Name, dependency.Version, entry.Versions)
+			}
+
+			issues = append(issues, types.Issue{
+				RuleID:      r.ID(),
+				File:        file.Path,
+				Line:        dependency.Line,
+				Column:      1,
+				Severity:    r.Severity(),
+				Category:    r.Category(),
+				Message:     message,
+				Description: r.describe(entry),
+				Suggestion:  r.suggest(entry),
+				CodeSnippet: getCodeSnippet(file, dependency.Line),
+				Params: map[string]string{
+					"value":       dependency.Name,
+					"version":     dependency.Version,
+					"license":     license,
+					"replacement": entry.Replacement,
+				},
+			})
+			break
+		}
+	}
+
+	return issues
+}
+
+// matches 금지 항목의 의존성/버전/라이선스 조건을 모두 만족하는지 확인 (의존성이나 라이선스 중 하나는 지정해야 함)
+func (r *DependencyPolicyRule) matches(entry config.BannedEntry, dependency parser.Dependency, license string) bool {
+	if entry.Dependency == "" && entry.License == "" {
+		return false
+	}
+	if entry.Dependency != "" && !matchesDependency(entry.Dependency, dependency.Name) {
+		return false
+	}
+	if entry.Versions != "" && !matchesVersions(entry.Versions, dependency.Version) {
+		return false
+	}
+	if entry.License != "" && !matchesLicense(entry.License, license) {
+		return false
+	}
+	return true
+}
+
+func (r *DependencyPolicyRule) describe(entry config.BannedEntry) string {
+	if entry.Reason != "" {
+		return entry.Reason
+	}
+	return r.Description()
+}
+
+func (r *DependencyPolicyRule) suggest(entry config.BannedEntry) string {
+	if entry.Replacement != "" {
+		return i18n.T("banned.replacement", entry.Replacement)
+	}
+	if entry.Versions != "" {
+		return i18n.T("dependency.version.suggestion")
+	}
+	return i18n.T("dependency.banned.suggestion")
+}
+
+// matchesDependency 금지 항목과 의존성 이름 비교 (끝이 ':' 또는 '/'이면 접두사 매칭)
+func matchesDependency(banned, name string) bool {
+	if strings.HasSuffix(banned, ":") || strings.HasSuffix(banned, "/") {
+		return strings.HasPrefix(name, banned)
+	}
+	return name == banned
+}
+
+// matchesLicense 금지 라이선스와 의존성 라이선스 비교 (대소문자 무시, 끝이 '*'이면 접두사 매칭)
+func matchesLicense(banned, license string) bool {
+	if license == "" {
+		return false
+	}
+	if prefix, ok := strings.CutSuffix(banned, "*"); ok {
+		return strings.HasPrefix(strings.ToLower(license), strings.ToLower(prefix))
+	}
+	return strings.EqualFold(banned, license)
+}
+
+// matchesVersions 버전이 쉼표로 구분된 조건(<, <=, >, >=, =)을 모두 만족하는지 확인
+// 속성이 치환되지 않았거나 버전이 없으면 판단할 수 없으므로 false
+func matchesVersions(constraints, version string) bool {
+	version = strings.TrimLeft(strings.TrimSpace(version), "^~=v")
+	if version == "" || strings.Contains(version, "${") || strings.ContainsAny(version, "* ") || strings.HasSuffix(version, ".x") {
+		return false
+	}
+
+	for _, constraint := range strings.Split(constraints, ",") {
+		constraint = strings.TrimSpace(constraint)
+		if constraint == "" {
+			continue
+		}
+		op := strings.TrimRight(constraint[:len(constraint)-len(strings.TrimLeft(constraint, "<>="))], " ")
+		target := strings.TrimSpace(constraint[len(op):])
+
+		cmp := compareVersions(version, target)
+		var ok bool
+		switch op {
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		default:
+			ok = cmp == 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compareVersions 점/하이픈으로 나눈 버전 비교 (숫자 부분은 숫자로, 나머지는 문자열로, 1.0 == 1.0.0)
+func compareVersions(a, b string) int {
+	split := func(version string) []string {
+		return strings.FieldsFunc(version, func(r rune) bool { return r == '.' || r == '-' || r == '+' })
+	}
+	partsA, partsB := split(a), split(b)
+
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		partA, partB := "0", "0"
+		if i < len(partsA) {
+			partA = partsA[i]
+		}
+		if i < len(partsB) {
+			partB = partsB[i]
+		}
+
+		numA, errA := strconv.Atoi(partA)
+		numB, errB := strconv.Atoi(partB)
+		switch {
+		case errA == nil && errB == nil:
+			if numA != numB {
+				if numA < numB {
+					return -1
+				}
+				return 1
+			}
+		case errA == nil: // 1.0 > 1.0-RC1
+			return 1
+		case errB == nil:
+			return -1
+		default:
+			if cmp := strings.Compare(partA, partB); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,165 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/parser"
+)
+
+// 길이/복잡도 계산에서 제외할 메소드 종류 (custom의 exclude_methods)
+const (
+	excludeAccessors     = "accessors"      // 필드를 읽거나 쓰기만 하는 getter/setter
+	excludeObjectMethods = "object-methods" // equals, hashCode, toString
+	excludeBuilders      = "builders"       // 빌더 체인을 반환하거나 값을 넣고 this를 반환하는 메소드
+)
+
+// 메소드 길이 계산 방식 (custom의 count)
+const (
+	countLines      = "lines"      // 본문이 차지하는 라인 수
+	countStatements = "statements" // 빈 라인, 주석, 중괄호만 있는 라인을 뺀 코드 라인 수
+)
+
+var (
+	accessorNameRegex   = regexp.MustCompile(`^(?:get|is|set)[A-Z0-9_]\w*$`)
+	getterBodyRegex     = regexp.MustCompile(`^return\s+(?:this\.)?\w+\s*;$`)
+	setterBodyRegex     = regexp.MustCompile(`^(?:this\.)?\w+\s*=\s*\w+\s*;$`)
+	returnThisRegex     = regexp.MustCompile(`^return\s+this\s*;$`)
+	chainCallRegex      = regexp.MustCompile(`\.\s*\w+\s*\(`)
+	objectMethodNames   = map[string]bool{"equals": true, "hashCode": true, "toString": true}
+	minBuilderChainCall = 3
+)
+
+// methodFilter DTO 위주 코드에서 잡음이 되는 메소드를 길이/복잡도 계산에서 제외
+type methodFilter struct {
+	accessors     bool
+	objectMethods bool
+	builders      bool
+}
+
+func newMethodFilter(custom map[string]string) methodFilter {
+	var filter methodFilter
+	for _, kind := range splitList(custom["exclude_methods"]) {
+		switch kind {
+		case excludeAccessors:
+			filter.accessors = true
+		case excludeObjectMethods:
+			filter.objectMethods = true
+		case excludeBuilders:
+			filter.builders = true
+		}
+	}
+	return filter
+}
+
+// skip 제외 대상 메소드인지 확인 (body는 extractBlockFromLine으로 추출한 본문)
+func (f methodFilter) skip(method parser.JavaMethod, body string) bool {
+	if f.objectMethods && objectMethodNames[method.Name] {
+		return true
+	}
+	if !f.accessors && !f.builders {
+		return false
+	}
+
+	statements := methodStatements(body)
+	if f.accessors && accessorNameRegex.MatchString(method.Name) && isAccessorBody(statements) {
+		return true
+	}
+	return f.builders && isBuilderBody(statements)
+}
+
+// isAccessorBody 필드 하나를 반환하거나 대입만 하는 본문
+func isAccessorBody(statements []string) bool {
+	if len(statements) != 1 {
+		return false
+	}
+	return getterBodyRegex.MatchString(statements[0]) || setterBodyRegex.MatchString(statements[0])
+}
+
+// isBuilderBody 메소드 체인 하나를 반환하거나 (return X.builder().a(a).build();) 대입 후 this를 반환하는 본문
+func isBuilderBody(statements []string) bool {
+	switch len(statements) {
+	case 1:
+		statement := statements[0]
+		return strings.HasPrefix(statement, "return ") && len(chainCallRegex.FindAllStringIndex(statement, -1)) >= minBuilderChainCall
+	case 2:
+		return setterBodyRegex.MatchString(statements[0]) && returnThisRegex.MatchString(statements[1])
+	default:
+		return false
+	}
+}
+
+// methodStatements 본문 중괄호 안의 코드를 ';' 단위 문장으로 분리 (주석 제거, 여러 줄 체인은 한 문장으로 합침)
+func methodStatements(body string) []string {
+	open, end := strings.IndexByte(body, '{'), strings.LastIndexByte(body, '}')
+	if open == -1 || end <= open {
+		return nil
+	}
+	code := strings.Join(strings.Fields(stripJavaComments(body[open+1:end])), " ")
+
+	var statements []string
+	for _, part := range strings.SplitAfter(code, ";") {
+		if part = strings.TrimSpace(part); part != "" {
+			statements = append(statements, part)
+		}
+	}
+	return statements
+}
+
+// countCodeLines 본문에서 빈 라인, 주석, 중괄호만 있는 라인을 제외한 라인 수 (여는 중괄호가 있는 첫 라인 제외)
+func countCodeLines(body string) int {
+	lines := strings.Split(stripJavaComments(body), "\n")
+	count := 0
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.Trim(trimmed, "{}") == "" {
+			continue
+		}
+		count++
+	}
+	return count
+}
+
+// stripJavaComments 주석을 공백으로 바꿔 제거 (문자열/문자 리터럴 안의 //, /*는 유지, 라인 수는 그대로)
+func stripJavaComments(code string) string {
+	var out strings.Builder
+	out.Grow(len(code))
+
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case c == '"' || c == '\'':
+			// 리터럴은 닫는 따옴표까지 그대로 복사
+			out.WriteByte(c)
+			for i++; i < len(code) && code[i] != c && code[i] != '\n'; i++ {
+				if code[i] == '\\' && i+1 < len(code) {
+					out.WriteByte(code[i])
+					i++
+				}
+				out.WriteByte(code[i])
+			}
+			if i < len(code) {
+				out.WriteByte(code[i])
+			}
+		case c == '/' && i+1 < len(code) && code[i+1] == '/':
+			for i < len(code) && code[i] != '\n' {
+				i++
+			}
+			if i < len(code) {
+				out.WriteByte('\n')
+			}
+		case c == '/' && i+1 < len(code) && code[i+1] == '*':
+			i += 2
+			for i < len(code) && !(code[i] == '*' && i+1 < len(code) && code[i+1] == '/') {
+				if code[i] == '\n' {
+					out.WriteByte('\n')
+				}
+				i++
+			}
+			i++ // 닫는 '/'
+		default:
+			out.WriteByte(c)
+		}
+	}
+	return out.String()
+}
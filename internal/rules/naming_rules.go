@@ -0,0 +1,148 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+var cssClassSelectorRegex = regexp.MustCompile(`\.(-?[_a-zA-Z][_a-zA-Z0-9-]*)`)
+
+// 언어별 기본 명명 규칙 (custom의 <유형>_pattern으로 변경 가능)
+var (
+	defaultCSSNamingPatterns = map[string]string{
+		"class": `^[a-z][a-z0-9]*(?:-[a-z0-9]+)*(?:__[a-z0-9]+(?:-[a-z0-9]+)*)?(?:--[a-z0-9]+(?:-[a-z0-9]+)*)?$`,
+	}
+	defaultJSNamingPatterns = map[string]string{
+		"function": `^(?:[a-z_$][A-Za-z0-9$]*|[A-Z][A-Za-z0-9]*)$`,
+	}
+)
+
+// compileNamingPatterns 설정값(<유형>_pattern) 또는 기본값으로 명명 규칙 컴파일
+func compileNamingPatterns(cfg config.RuleConfig, defaults map[string]string) map[string]*regexp.Regexp {
+	patterns := make(map[string]*regexp.Regexp)
+	for kind, fallback := range defaults {
+		if custom, ok := cfg.Custom[kind+"_pattern"]; ok {
+			// 잘못된 정규식은 설정 로드 시 오류로 보고됨
+			if regex, err := regexp.Compile(custom); err == nil {
+				patterns[kind] = regex
+				continue
+			}
+		}
+		patterns[kind] = regexp.MustCompile(fallback)
+	}
+	return patterns
+}
+
+// NamingConventionRule CSS 클래스/JS 함수 명명 규칙 검사
+type NamingConventionRule struct {
+	config config.RuleConfig
+	naming map[string]*regexp.Regexp
+}
+
+func NewNamingConventionRule(cfg config.RuleConfig) Rule {
+	defaults := defaultJSNamingPatterns
+	if strings.HasPrefix(cfg.ID, "css-") {
+		defaults = defaultCSSNamingPatterns
+	}
+	return &NamingConventionRule{
+		config: cfg,
+		naming: compileNamingPatterns(cfg, defaults),
+	}
+}
+
+func (r *NamingConventionRule) ID() string   { return r.config.ID }
+func (r *NamingConventionRule) Name() string { return r.config.Name }
+func (r *NamingConventionRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *NamingConventionRule) Category() string    { return r.config.Category }
+func (r *NamingConventionRule) Description() string { return r.config.Description }
+func (r *NamingConventionRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *NamingConventionRule) Check(file *parser.ParsedFile) []types.Issue {
+	switch file.Language {
+	case "css":
+		return r.checkCSSClasses(file)
+	case "javascript", "typescript":
+		return r.checkJSFunctions(file)
+	}
+	return nil
+}
+
+// checkCSSClasses 셀렉터의 클래스명 검사
+func (r *NamingConventionRule) checkCSSClasses(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	cssData, ok := file.AST.(map[string]interface{})
+	if !ok {
+		return issues
+	}
+	selectors, _ := cssData["selectors"].([]string)
+
+	reported := make(map[string]bool)
+	for _, selector := range selectors {
+		for _, match := range cssClassSelectorRegex.FindAllStringSubmatch(selector, -1) {
+			className := match[1]
+			if reported[className] || r.naming["class"].MatchString(className) {
+				continue
+			}
+			reported[className] = true
+
+			lineNum := 1
+			for i, line := range file.Lines {
+				if strings.Contains(line, "."+className) {
+					lineNum = i + 1
+					break
+				}
+			}
+			issues = append(issues, r.namingIssue(file, lineNum, "naming.label.css-class", className, "class"))
+		}
+	}
+
+	return issues
+}
+
+// checkJSFunctions 함수명 검사
+func (r *NamingConventionRule) checkJSFunctions(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	functions, ok := file.AST.([]parser.JSFunction)
+	if !ok {
+		return issues
+	}
+
+	for _, function := range functions {
+		if !r.naming["function"].MatchString(function.Name) {
+			issues = append(issues, r.namingIssue(file, function.Line, "naming.label.function", function.Name, "function"))
+		}
+	}
+
+	return issues
+}
+
+// namingIssue 명명 규칙 위반 이슈 생성 (labelKey는 요소 유형 이름의 메시지 키)
+func (r *NamingConventionRule) namingIssue(file *parser.ParsedFile, line int, labelKey, name, kind string) types.Issue {
+	pattern := r.naming[kind].String()
+	label := i18n.T(labelKey)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      1,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     i18n.T("naming.message", label, name),
+		Description: i18n.T("naming.description", kind, pattern),
+		Suggestion:  i18n.T("naming.suggestion", label, pattern),
+		CodeSnippet: strings.TrimSpace(getLineContent(file, line)),
+		Params: map[string]string{
+			"value":   name,
+			"pattern": pattern,
+		},
+	}
+}
@@ -0,0 +1,266 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// null 안전성 규칙에서 사용하는 정규식
+var (
+	optionalDeclRegex = regexp.MustCompile(`\bOptional\s*<[^;=()]*>\s+(\w+)\b`)
+	optionalGetRegex  = regexp.MustCompile(`\b(\w+)\s*\.\s*get\s*\(\s*\)`)
+	// Optional을 반환하는 호출 결과에 바로 get()을 붙인 경우 (repository.findById(id).get())
+	chainedOptionalGetRegex = regexp.MustCompile(`\.\s*(?:findById|findOne|findFirst|findAny|max|min|ofNullable)\s*\([^;{}]*?\)\s*\.\s*get\s*\(\s*\)`)
+	returnNullRegex         = regexp.MustCompile(`\breturn\s+null\s*;`)
+	collectionReturnRegex   = regexp.MustCompile(`^(?:List|Set|Map|Collection|Iterable|Queue|Deque|ArrayList|LinkedList|HashMap|HashSet|TreeMap|TreeSet)$`)
+	// 이름을 캡처해 비교하는 정규식 (변수/메소드마다 정규식을 만들지 않도록)
+	optionalCheckRegex = regexp.MustCompile(`\b(\w+)\s*\.\s*(?:isPresent|isEmpty)\s*\(`)
+	nullCheckRegex     = regexp.MustCompile(`\b(\w+)\s*[!=]=\s*null\b|\bnull\s*[!=]=\s*(\w+)\b|\b(?:isNull|nonNull|requireNonNull\w*|ofNullable)\s*\(\s*(\w+)\b`)
+	memberAccessRegex  = regexp.MustCompile(`\b(\w+)\s*\.`)
+	callAccessRegex    = regexp.MustCompile(`\b(\w+)\s*\([^()]*\)\s*\.`)
+)
+
+// 기본 옵션값
+var (
+	defaultNullableAnnotations = []string{"Nullable", "CheckForNull"}
+	defaultNonNullAnnotations  = []string{"NonNull", "Nonnull", "NotNull"}
+)
+
+// NullSafetyRule NPE로 이어지기 쉬운 코드 검사
+// java-optional-get-unchecked, java-null-collection-return, java-nullable-inconsistency 세 규칙 ID로 등록됩니다
+type NullSafetyRule struct {
+	config              config.RuleConfig
+	nullableAnnotations []string // null을 허용하는 어노테이션 (nullable_annotations)
+	nonNullAnnotations  []string // null을 허용하지 않는 어노테이션 (nonnull_annotations)
+}
+
+func NewNullSafetyRule(cfg config.RuleConfig) Rule {
+	return &NullSafetyRule{
+		config:              cfg,
+		nullableAnnotations: listOrDefault(cfg.Custom["nullable_annotations"], defaultNullableAnnotations),
+		nonNullAnnotations:  listOrDefault(cfg.Custom["nonnull_annotations"], defaultNonNullAnnotations),
+	}
+}
+
+func (r *NullSafetyRule) ID() string                { return r.config.ID }
+func (r *NullSafetyRule) Name() string              { return r.config.Name }
+func (r *NullSafetyRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *NullSafetyRule) Category() string          { return r.config.Category }
+func (r *NullSafetyRule) Description() string       { return r.config.Description }
+func (r *NullSafetyRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *NullSafetyRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok {
+		return issues
+	}
+
+	for _, method := range javaClass.Methods {
+		body, offset := methodBlock(file, method.Line)
+		if body == "" {
+			continue
+		}
+
+		switch r.ID() {
+		case "java-optional-get-unchecked":
+			issues = append(issues, r.checkOptionalGet(file, javaClass, method, body, offset)...)
+		case "java-null-collection-return":
+			issues = append(issues, r.checkCollectionReturn(file, method, body, offset)...)
+		case "java-nullable-inconsistency":
+			issues = append(issues, r.checkAnnotations(file, method, body, offset)...)
+		}
+	}
+
+	return issues
+}
+
+// checkOptionalGet isPresent()/isEmpty() 확인 없이 Optional.get()을 호출하는 코드 검사
+func (r *NullSafetyRule) checkOptionalGet(file *parser.ParsedFile, class *parser.JavaClass, method parser.JavaMethod, body string, offset int) []types.Issue {
+	var issues []types.Issue
+
+	// 필드, 파라미터, 지역 변수 중 Optional 타입인 이름
+	optionals := make(map[string]bool)
+	for _, field := range class.Fields {
+		if genericBase(field.Type) == "Optional" {
+			optionals[field.Name] = true
+		}
+	}
+	for _, declared := range []string{strings.Join(method.Parameters, ", "), body} {
+		for _, match := range optionalDeclRegex.FindAllStringSubmatch(declared, -1) {
+			optionals[match[1]] = true
+		}
+	}
+
+	checked := capturedNames(optionalCheckRegex, body)
+	for _, match := range optionalGetRegex.FindAllStringSubmatchIndex(body, -1) {
+		name := body[match[2]:match[3]]
+		if !optionals[name] || checked[name] {
+			continue
+		}
+		issues = append(issues, r.newIssue(file, offset+match[0],
+			i18n.T("null-safety.optional-get.message", name),
+			i18n.T("null-safety.optional-get.description"),
+			i18n.T("null-safety.optional-get.suggestion")))
+	}
+	for _, match := range chainedOptionalGetRegex.FindAllStringIndex(body, -1) {
+		issues = append(issues, r.newIssue(file, offset+match[0],
+			i18n.T("null-safety.optional-chain-get.message"),
+			i18n.T("null-safety.optional-chain-get.description"),
+			i18n.T("null-safety.optional-chain-get.suggestion")))
+	}
+
+	return issues
+}
+
+// checkCollectionReturn 컬렉션을 반환하는 메소드의 return null 검사
+func (r *NullSafetyRule) checkCollectionReturn(file *parser.ParsedFile, method parser.JavaMethod, body string, offset int) []types.Issue {
+	var issues []types.Issue
+
+	returnType := genericBase(method.ReturnType)
+	if !collectionReturnRegex.MatchString(returnType) {
+		return issues
+	}
+
+	suggestion := i18n.T("null-safety.collection-null.suggestion")
+	switch returnType {
+	case "List", "Set", "Map":
+		suggestion = i18n.T("null-safety.collection-null.empty-suggestion", returnType)
+	}
+
+	for _, match := range returnNullRegex.FindAllStringIndex(body, -1) {
+		issues = append(issues, r.newIssue(file, offset+match[0],
+			i18n.T("null-safety.collection-null.message", returnType, method.Name),
+			i18n.T("null-safety.collection-null.description"),
+			suggestion))
+	}
+
+	return issues
+}
+
+// checkAnnotations @Nullable/@NonNull 선언과 실제 코드가 어긋나는 경우 검사
+// @NonNull 메소드의 return null, @Nullable 파라미터의 null 확인 없는 역참조, @Nullable 메소드 결과의 바로 역참조
+func (r *NullSafetyRule) checkAnnotations(file *parser.ParsedFile, method parser.JavaMethod, body string, offset int) []types.Issue {
+	var issues []types.Issue
+
+	if r.hasAny(method.Annotations, r.nonNullAnnotations) {
+		for _, match := range returnNullRegex.FindAllStringIndex(body, -1) {
+			issues = append(issues, r.newIssue(file, offset+match[0],
+				i18n.T("null-safety.nonnull-return.message", method.Name),
+				i18n.T("null-safety.nonnull-return.description"),
+				i18n.T("null-safety.nonnull-return.suggestion")))
+		}
+	}
+
+	var nullChecked map[string]bool
+	for _, param := range method.Parameters {
+		fields := strings.Fields(param)
+		if len(fields) < 2 || !r.hasAny(fields[:len(fields)-1], r.nullableAnnotations) {
+			continue
+		}
+		name := fields[len(fields)-1]
+		if nullChecked == nil {
+			nullChecked = capturedNames(nullCheckRegex, body)
+		}
+		if nullChecked[name] {
+			continue
+		}
+		if accesses := memberAccesses(memberAccessRegex, body, name); len(accesses) > 0 {
+			issues = append(issues, r.newIssue(file, offset+accesses[0],
+				i18n.T("null-safety.nullable-param.message", name),
+				i18n.T("null-safety.nullable-param.description"),
+				i18n.T("null-safety.nullable-param.suggestion")))
+		}
+	}
+
+	if r.hasAny(method.Annotations, r.nullableAnnotations) {
+		for _, start := range memberAccesses(callAccessRegex, file.Content, method.Name) {
+			if file.LineAt(start) == method.Line {
+				continue
+			}
+			issues = append(issues, r.newIssue(file, start,
+				i18n.T("null-safety.nullable-result.message", method.Name),
+				i18n.T("null-safety.nullable-result.description"),
+				i18n.T("null-safety.nullable-result.suggestion")))
+		}
+	}
+
+	return issues
+}
+
+// capturedNames 정규식이 캡처한 이름 집합 (매치마다 비어있지 않은 첫 그룹)
+func capturedNames(regex *regexp.Regexp, text string) map[string]bool {
+	names := make(map[string]bool)
+	for _, match := range regex.FindAllStringSubmatch(text, -1) {
+		for _, group := range match[1:] {
+			if group != "" {
+				names[group] = true
+				break
+			}
+		}
+	}
+	return names
+}
+
+// memberAccesses 첫 그룹이 name인 매치 뒤에 멤버 이름이 이어지는 위치 목록 (name.field, name(...).call 등)
+// 매치를 '.'에서 끝내므로 a.b.c처럼 이어진 접근도 이름마다 찾습니다
+func memberAccesses(regex *regexp.Regexp, text, name string) []int {
+	var starts []int
+	for _, match := range regex.FindAllStringSubmatchIndex(text, -1) {
+		if text[match[2]:match[3]] != name {
+			continue
+		}
+		rest := strings.TrimLeft(text[match[1]:], " \t\r\n")
+		if rest != "" && isWordByte(rest[0]) {
+			starts = append(starts, match[0])
+		}
+	}
+	return starts
+}
+
+// isWordByte 정규식 \w에 해당하는 문자인지
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// hasAny 어노테이션 목록에 이름 중 하나가 있는지 확인
+func (r *NullSafetyRule) hasAny(annotations, names []string) bool {
+	for _, name := range names {
+		if hasAnnotationNamed(annotations, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *NullSafetyRule) newIssue(file *parser.ParsedFile, offset int, message, description, suggestion string) types.Issue {
+	line := file.LineAt(offset)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      file.ColumnAt(offset),
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, line),
+	}
+}
+
+// methodBlock 메소드 본문과 파일 내 시작 위치
+func methodBlock(file *parser.ParsedFile, line int) (string, int) {
+	body := extractBlockFromLine(file, line)
+	if body == "" {
+		return "", 0
+	}
+	start := file.LineStart(line)
+	return body, start + strings.Index(file.Content[start:], body)
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// PatternRule 설정의 정규식으로 동작하는 범용 규칙 (규칙 팩 등)
+type PatternRule struct {
+	config config.RuleConfig
+	regex  *regexp.Regexp
+}
+
+func NewPatternRule(cfg config.RuleConfig) (Rule, error) {
+	regex, err := regexp.Compile(cfg.Pattern.Regex)
+	if err != nil {
+		return nil, err
+	}
+	return &PatternRule{config: cfg, regex: regex}, nil
+}
+
+func (r *PatternRule) ID() string                { return r.config.ID }
+func (r *PatternRule) Name() string              { return r.config.Name }
+func (r *PatternRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *PatternRule) Category() string          { return r.config.Category }
+func (r *PatternRule) Description() string       { return r.config.Description }
+func (r *PatternRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *PatternRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	matches := r.regex.FindAllStringIndex(file.Content, -1)
+	for _, match := range matches {
+		lineNum := file.LineAt(match[0])
+		issues = append(issues, r.newIssue(file, lineNum, file.ColumnAt(match[0]), getLineContent(file, lineNum)))
+	}
+
+	return issues
+}
+
+// CheckLine 한 줄 검사 (여러 줄에 걸친 패턴은 매칭되지 않음)
+func (r *PatternRule) CheckLine(file *parser.ParsedFile, lineNum int, line string) []types.Issue {
+	var issues []types.Issue
+
+	for _, match := range r.regex.FindAllStringIndex(line, -1) {
+		issues = append(issues, r.newIssue(file, lineNum, match[0]+1, line))
+	}
+
+	return issues
+}
+
+func (r *PatternRule) newIssue(file *parser.ParsedFile, lineNum, column int, line string) types.Issue {
+	message := r.config.Custom["message"]
+	if message == "" {
+		message = r.config.Name
+	}
+
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        lineNum,
+		Column:      column,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: r.Description(),
+		Suggestion:  r.config.Custom["suggestion"],
+		CodeSnippet: strings.TrimSpace(line),
+	}
+}
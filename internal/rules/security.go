@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// OWASP Top 10 (2021) 분류
+const (
+	OWASPBrokenAccessControl = "A01:2021-Broken Access Control"
+	OWASPInjection           = "A03:2021-Injection"
+)
+
+// classification 보안 규칙의 표준 분류 (CWE ID, OWASP Top 10 카테고리)
+type classification struct {
+	cwe   []string
+	owasp string
+}
+
+// securityClassifications 기본 제공 보안 규칙의 분류 (규칙 설정의 cwe/owasp로 재정의 가능)
+var securityClassifications = map[string]classification{
+	"java-input-validation":          {cwe: []string{"CWE-20"}, owasp: OWASPInjection},
+	"spring-validation-missing":      {cwe: []string{"CWE-20"}, owasp: OWASPInjection},
+	"spring-security-missing":        {cwe: []string{"CWE-862"}, owasp: OWASPBrokenAccessControl},
+	"js-innerHTML-xss":               {cwe: []string{"CWE-79"}, owasp: OWASPInjection},
+	"html-template-unescaped-output": {cwe: []string{"CWE-79"}, owasp: OWASPInjection},
+}
+
+// applyClassification 규칙의 CWE/OWASP 분류를 이슈에 기록 (설정 값이 기본 분류보다 우선)
+func applyClassification(issues []types.Issue, ruleID string, ruleConfig config.RuleConfig) {
+	class := securityClassifications[ruleID]
+	if len(ruleConfig.CWE) > 0 {
+		class.cwe = ruleConfig.CWE
+	}
+	if ruleConfig.OWASP != "" {
+		class.owasp = ruleConfig.OWASP
+	}
+	if len(class.cwe) == 0 && class.owasp == "" {
+		return
+	}
+
+	for i := range issues {
+		issues[i].CWE = class.cwe
+		issues[i].OWASP = class.owasp
+	}
+}
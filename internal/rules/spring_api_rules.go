@@ -0,0 +1,378 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// REST API 규칙에서 사용하는 정규식
+var (
+	requestMethodRegex = regexp.MustCompile(`RequestMethod\.(\w+)`)
+	// 201/202 응답을 만드는 코드 (ResponseEntity.created(...), HttpStatus.CREATED, status(201) 등)
+	createdStatusRegex = regexp.MustCompile(`\.\s*(?:created|accepted)\s*\(|HttpStatus\.(?:CREATED|ACCEPTED)\b|\bstatus\s*\(\s*20[12]\s*\)`)
+	// 본문 없는 200 응답 (ResponseEntity.ok().build())
+	emptyOkRegex = regexp.MustCompile(`\.\s*ok\s*\(\s*\)\s*\.\s*build\s*\(\s*\)`)
+
+	// 컬렉션 반환 타입 (ResponseEntity<List<T>>처럼 감싼 경우 포함)
+	collectionTypeRegex = regexp.MustCompile(`\b(?:List|Collection|Set|Iterable)\s*<`)
+	// 메소드 호출 (receiver.method(...)의 메소드 이름)
+	queryCallRegex = regexp.MustCompile(`\.\s*(\w+)\s*\(`)
+	// 조회 건수를 제한하는 코드 (PageRequest.of(...), stream().limit(...), setMaxResults(...))
+	boundedQueryRegex = regexp.MustCompile(`\bPageRequest\s*\.\s*of\s*\(|\.\s*limit\s*\(|\.\s*setMaxResults\s*\(`)
+)
+
+// mappingAnnotations 핸들러 메소드 어노테이션과 HTTP 메소드
+var mappingAnnotations = map[string]string{
+	"GetMapping":    "GET",
+	"PostMapping":   "POST",
+	"PutMapping":    "PUT",
+	"PatchMapping":  "PATCH",
+	"DeleteMapping": "DELETE",
+}
+
+// 기본 옵션값
+var (
+	defaultWrapperTypes   = []string{"ApiResponse"}
+	defaultForbiddenTypes = []string{"Map", "HashMap", "LinkedHashMap", "Object", "JSONObject", "JsonNode"}
+	defaultAllowedTypes   = []string{"Void", "Resource", "InputStreamResource", "StreamingResponseBody", "SseEmitter", "ResponseBodyEmitter"}
+
+	defaultListCalls       = []string{"findAll", "selectAll", "selectList"}
+	defaultBoundedCalls    = []string{"findAllById"}
+	defaultPageableTypes   = []string{"Pageable", "PageRequest"}
+	defaultPaginatedLayers = []string{"Controller", "RestController", "Service"}
+)
+
+// SpringResponseConventionRule REST 컨트롤러 응답 규약 검사
+// 핸들러 메소드가 팀 표준 응답 타입(ApiResponse<T> 등)을 반환하는지, ResponseEntity를 반환하는 POST/DELETE가
+// 알맞은 상태 코드를 쓰는지 확인합니다
+type SpringResponseConventionRule struct {
+	config         config.RuleConfig
+	wrapperTypes   []string        // 표준 응답 타입 (wrapper_types, 기본값 ApiResponse)
+	forbiddenTypes []string        // 표준 응답 대신 쓰면 안 되는 타입 (forbidden_types)
+	allowedTypes   []string        // 표준 응답이 아니어도 허용하는 타입 (allowed_types, 파일 다운로드/스트리밍 등)
+	statusChecks   map[string]bool // 상태 코드를 검사할 HTTP 메소드 (status_checks, 기본값 post,delete)
+}
+
+func NewSpringResponseConventionRule(cfg config.RuleConfig) Rule {
+	rule := &SpringResponseConventionRule{
+		config:         cfg,
+		wrapperTypes:   listOrDefault(cfg.Custom["wrapper_types"], defaultWrapperTypes),
+		forbiddenTypes: listOrDefault(cfg.Custom["forbidden_types"], defaultForbiddenTypes),
+		allowedTypes:   listOrDefault(cfg.Custom["allowed_types"], defaultAllowedTypes),
+		statusChecks:   map[string]bool{"POST": true, "DELETE": true},
+	}
+	if checks, ok := cfg.Custom["status_checks"]; ok {
+		rule.statusChecks = make(map[string]bool)
+		for _, method := range splitList(checks) {
+			rule.statusChecks[strings.ToUpper(method)] = true
+		}
+	}
+	return rule
+}
+
+func (r *SpringResponseConventionRule) ID() string   { return r.config.ID }
+func (r *SpringResponseConventionRule) Name() string { return r.config.Name }
+func (r *SpringResponseConventionRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *SpringResponseConventionRule) Category() string    { return r.config.Category }
+func (r *SpringResponseConventionRule) Description() string { return r.config.Description }
+func (r *SpringResponseConventionRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *SpringResponseConventionRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok {
+		return issues
+	}
+
+	restController := hasAnnotationNamed(javaClass.Annotations, "RestController") ||
+		(hasAnnotationNamed(javaClass.Annotations, "Controller") && hasAnnotationNamed(javaClass.Annotations, "ResponseBody"))
+	if !restController && !hasAnnotationNamed(javaClass.Annotations, "Controller") {
+		return issues
+	}
+
+	wrapper := strings.Join(r.wrapperTypes, ", ")
+	for _, method := range javaClass.Methods {
+		httpMethod, ok := handlerHTTPMethod(method.Annotations)
+		if !ok {
+			continue
+		}
+		// @Controller의 뷰 반환 메소드는 제외
+		if !restController && !hasAnnotationNamed(method.Annotations, "ResponseBody") {
+			continue
+		}
+
+		returnType := strings.TrimSpace(method.ReturnType)
+		responseEntity := genericBase(returnType) == "ResponseEntity"
+		body := returnType
+		if responseEntity {
+			body = genericArgument(returnType)
+		}
+
+		switch {
+		case returnType == "void":
+			if !hasAnnotationNamed(method.Annotations, "ResponseStatus") {
+				issues = append(issues, r.newIssue(file, method,
+					i18n.T("spring.response-void.message", method.Name),
+					i18n.T("spring.response-void.description"),
+					i18n.T("spring.response-void.suggestion", wrapper)))
+			}
+		case containsType(r.forbiddenTypes, genericBase(body)):
+			issues = append(issues, r.newIssue(file, method,
+				i18n.T("spring.response-forbidden.message", method.Name, genericBase(body)),
+				i18n.T("spring.response-forbidden.description"),
+				i18n.T("spring.response-forbidden.suggestion", wrapper)))
+		case responseEntity && body == "":
+			issues = append(issues, r.newIssue(file, method,
+				i18n.T("spring.response-raw.message", method.Name),
+				i18n.T("spring.response-raw.description"),
+				i18n.T("spring.response-raw.suggestion", r.wrapperTypes[0])))
+		case !containsType(r.wrapperTypes, genericBase(body)) && !containsType(r.allowedTypes, genericBase(body)):
+			issues = append(issues, r.newIssue(file, method,
+				i18n.T("spring.response-type.message", method.Name, body, wrapper),
+				i18n.T("spring.response-type.description"),
+				i18n.T("spring.response-type.suggestion", body, r.wrapperTypes[0])))
+		}
+
+		if responseEntity && r.statusChecks[httpMethod] {
+			if issue, ok := r.checkStatus(file, method, httpMethod); ok {
+				issues = append(issues, issue)
+			}
+		}
+	}
+
+	return issues
+}
+
+// checkStatus ResponseEntity를 반환하는 POST/DELETE의 상태 코드 확인
+// POST는 201/202를, DELETE는 본문이 없으면 204를 기대합니다
+func (r *SpringResponseConventionRule) checkStatus(file *parser.ParsedFile, method parser.JavaMethod, httpMethod string) (types.Issue, bool) {
+	body := stripJavaComments(extractBlockFromLine(file, method.Line))
+
+	switch httpMethod {
+	case "POST":
+		if createdStatusRegex.MatchString(body) || createdStatusRegex.MatchString(strings.Join(method.Annotations, " ")) {
+			return types.Issue{}, false
+		}
+		return r.newIssue(file, method,
+			i18n.T("spring.response-created.message", method.Name),
+			i18n.T("spring.response-created.description"),
+			i18n.T("spring.response-created.suggestion")), true
+	case "DELETE":
+		if !emptyOkRegex.MatchString(body) {
+			return types.Issue{}, false
+		}
+		return r.newIssue(file, method,
+			i18n.T("spring.response-no-content.message", method.Name),
+			i18n.T("spring.response-no-content.description"),
+			i18n.T("spring.response-no-content.suggestion")), true
+	}
+	return types.Issue{}, false
+}
+
+func (r *SpringResponseConventionRule) newIssue(file *parser.ParsedFile, method parser.JavaMethod, message, description, suggestion string) types.Issue {
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        method.Line,
+		Column:      method.Column,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, method.Line),
+		Params: map[string]string{
+			"method":      method.Name,
+			"return_type": method.ReturnType,
+		},
+	}
+}
+
+// handlerHTTPMethod 매핑 어노테이션으로 핸들러 메소드의 HTTP 메소드 확인
+// @RequestMapping에 method가 없으면 모든 메소드를 받으므로 GET으로 취급합니다
+func handlerHTTPMethod(annotations []string) (string, bool) {
+	for name, httpMethod := range mappingAnnotations {
+		if hasAnnotationNamed(annotations, name) {
+			return httpMethod, true
+		}
+	}
+	for _, annotation := range annotations {
+		if !hasAnnotationNamed([]string{annotation}, "RequestMapping") {
+			continue
+		}
+		if match := requestMethodRegex.FindStringSubmatch(annotation); match != nil {
+			return strings.ToUpper(match[1]), true
+		}
+		return "GET", true
+	}
+	return "", false
+}
+
+// genericBase 제네릭 타입의 기본 타입 이름 (java.util.Map<K, V> → Map)
+func genericBase(typeName string) string {
+	if i := strings.Index(typeName, "<"); i >= 0 {
+		typeName = typeName[:i]
+	}
+	typeName = strings.TrimSpace(typeName)
+	if i := strings.LastIndex(typeName, "."); i >= 0 {
+		typeName = typeName[i+1:]
+	}
+	return typeName
+}
+
+// genericArgument 가장 바깥 제네릭의 타입 인자 (ResponseEntity<ApiResponse<T>> → ApiResponse<T>)
+// 타입 인자가 없거나 와일드카드면 빈 문자열
+func genericArgument(typeName string) string {
+	start := strings.Index(typeName, "<")
+	end := strings.LastIndex(typeName, ">")
+	if start < 0 || end <= start {
+		return ""
+	}
+	argument := strings.TrimSpace(typeName[start+1 : end])
+	if argument == "?" {
+		return ""
+	}
+	return argument
+}
+
+// containsType 타입 이름 목록에 포함되는지 확인
+func containsType(names []string, typeName string) bool {
+	for _, name := range names {
+		if name == typeName {
+			return true
+		}
+	}
+	return false
+}
+
+// listOrDefault 쉼표 구분 옵션값 (비어 있으면 기본값)
+func listOrDefault(value string, fallback []string) []string {
+	if items := splitList(value); len(items) > 0 {
+		return items
+	}
+	return fallback
+}
+
+// SpringPaginationRule 페이지네이션 없는 목록 조회 검사
+// 컨트롤러/서비스 메소드가 Pageable 없이 findAll 계열 조회 결과를 List로 반환하면 보고합니다
+type SpringPaginationRule struct {
+	config        config.RuleConfig
+	listCalls     []string // 전체 조회로 볼 메소드 이름 접두사 (list_calls, 기본값 findAll,selectAll,selectList)
+	boundedCalls  []string // 접두사가 같아도 건수가 제한되는 메소드 (bounded_calls, 기본값 findAllById)
+	pageableTypes []string // 페이지네이션 파라미터 타입 (pageable_types, 기본값 Pageable,PageRequest)
+	layers        []string // 검사할 클래스 어노테이션 (layers, 기본값 Controller,RestController,Service)
+}
+
+func NewSpringPaginationRule(cfg config.RuleConfig) Rule {
+	return &SpringPaginationRule{
+		config:        cfg,
+		listCalls:     listOrDefault(cfg.Custom["list_calls"], defaultListCalls),
+		boundedCalls:  listOrDefault(cfg.Custom["bounded_calls"], defaultBoundedCalls),
+		pageableTypes: listOrDefault(cfg.Custom["pageable_types"], defaultPageableTypes),
+		layers:        listOrDefault(cfg.Custom["layers"], defaultPaginatedLayers),
+	}
+}
+
+func (r *SpringPaginationRule) ID() string   { return r.config.ID }
+func (r *SpringPaginationRule) Name() string { return r.config.Name }
+func (r *SpringPaginationRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *SpringPaginationRule) Category() string    { return r.config.Category }
+func (r *SpringPaginationRule) Description() string { return r.config.Description }
+func (r *SpringPaginationRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *SpringPaginationRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok || !r.inLayer(javaClass) {
+		return issues
+	}
+
+	for _, method := range javaClass.Methods {
+		if !collectionTypeRegex.MatchString(method.ReturnType) || r.hasPageable(method) {
+			continue
+		}
+
+		body := stripJavaComments(extractBlockFromLine(file, method.Line))
+		if boundedQueryRegex.MatchString(body) {
+			continue
+		}
+		call, ok := r.unboundedCall(body)
+		if !ok {
+			continue
+		}
+
+		issues = append(issues, types.Issue{
+			RuleID:      r.ID(),
+			File:        file.Path,
+			Line:        method.Line,
+			Column:      method.Column,
+			Severity:    r.Severity(),
+			Category:    r.Category(),
+			Message:     i18n.T("spring.pagination.message", method.Name, call),
+			Description: i18n.T("spring.pagination.description"),
+			Suggestion:  i18n.T("spring.pagination.suggestion"),
+			CodeSnippet: getCodeSnippet(file, method.Line),
+			Params: map[string]string{
+				"method": method.Name,
+				"call":   call,
+			},
+		})
+	}
+
+	return issues
+}
+
+// inLayer 검사 대상 계층(컨트롤러/서비스)의 클래스인지 확인
+func (r *SpringPaginationRule) inLayer(class *parser.JavaClass) bool {
+	for _, name := range r.layers {
+		if hasAnnotationNamed(class.Annotations, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasPageable 파라미터에 페이지네이션 타입이 있는지 확인
+func (r *SpringPaginationRule) hasPageable(method parser.JavaMethod) bool {
+	for _, param := range method.Parameters {
+		for _, field := range strings.Fields(param) {
+			if containsType(r.pageableTypes, genericBase(field)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// unboundedCall 본문에서 건수 제한 없는 전체 조회 호출을 찾아 메소드 이름 반환
+func (r *SpringPaginationRule) unboundedCall(body string) (string, bool) {
+	for _, match := range queryCallRegex.FindAllStringSubmatch(body, -1) {
+		name := match[1]
+		if containsType(r.boundedCalls, name) || !r.isListCall(name) {
+			continue
+		}
+		return name, true
+	}
+	return "", false
+}
+
+// isListCall 전체 조회 메소드 접두사와 일치하는지 확인 (findAll, findAllByStatus 등)
+func (r *SpringPaginationRule) isListCall(name string) bool {
+	for _, prefix := range r.listCalls {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 스케줄/배치 작업 규칙에서 사용하는 정규식
+var (
+	annotationNamePrefixRegex = regexp.MustCompile(`^@([\w.]+)`)
+	catchBlockRegex           = regexp.MustCompile(`\bcatch\s*\(`)
+	// Step 빌더 시작 (Spring Batch 4의 stepBuilderFactory.get(...), 5의 new StepBuilder(...))
+	stepBuilderRegex = regexp.MustCompile(`\b\w*[sS]tepBuilderFactory\s*\.\s*get\s*\(|\bnew\s+StepBuilder\s*\(`)
+	stepChunkRegex   = regexp.MustCompile(`\.\s*(?:<[^>]*>\s*)?chunk\s*\(`)
+	stepBuildRegex   = regexp.MustCompile(`\.\s*build\s*\(\s*\)`)
+	stepPolicyRegex  = regexp.MustCompile(`\.\s*(?:skip|skipPolicy|skipLimit|retry|retryPolicy|retryLimit|noSkip|noRetry)\s*\(`)
+)
+
+// SpringScheduledRule @Scheduled 메소드의 예외 처리와 분산 락 검사
+// 같은 구현이 spring-scheduled-error-handling, spring-scheduled-lock-missing 두 규칙 ID로 등록됩니다
+type SpringScheduledRule struct {
+	config           config.RuleConfig
+	lockAnnotations  []string // 분산 락으로 인정할 어노테이션 (lock_annotations, 기본값 SchedulerLock)
+	errorAnnotations []string // 예외 처리로 인정할 어노테이션 (error_annotations, 예: Retryable)
+}
+
+func NewSpringScheduledRule(cfg config.RuleConfig) Rule {
+	lockAnnotations := splitList(cfg.Custom["lock_annotations"])
+	if len(lockAnnotations) == 0 {
+		lockAnnotations = []string{"SchedulerLock"}
+	}
+	return &SpringScheduledRule{
+		config:           cfg,
+		lockAnnotations:  lockAnnotations,
+		errorAnnotations: splitList(cfg.Custom["error_annotations"]),
+	}
+}
+
+func (r *SpringScheduledRule) ID() string   { return r.config.ID }
+func (r *SpringScheduledRule) Name() string { return r.config.Name }
+func (r *SpringScheduledRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *SpringScheduledRule) Category() string    { return r.config.Category }
+func (r *SpringScheduledRule) Description() string { return r.config.Description }
+func (r *SpringScheduledRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *SpringScheduledRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	javaClass, ok := file.AST.(*parser.JavaClass)
+	if !ok {
+		return issues
+	}
+
+	for _, method := range javaClass.Methods {
+		if !hasAnnotationNamed(method.Annotations, "Scheduled") {
+			continue
+		}
+
+		if r.ID() != "spring-scheduled-lock-missing" && !r.handlesErrors(file, method) {
+			issues = append(issues, r.newIssue(file, method,
+				i18n.T("spring.scheduled-error.message", method.Name),
+				i18n.T("spring.scheduled-error.description"),
+				i18n.T("spring.scheduled-error.suggestion")))
+		}
+		if r.ID() != "spring-scheduled-error-handling" && !r.hasLock(javaClass, method) {
+			issues = append(issues, r.newIssue(file, method,
+				i18n.T("spring.scheduled-lock.message", method.Name, strings.Join(r.lockAnnotations, ", @")),
+				i18n.T("spring.scheduled-lock.description"),
+				i18n.T("spring.scheduled-lock.suggestion", r.lockAnnotations[0])))
+		}
+	}
+
+	return issues
+}
+
+// handlesErrors 본문에 catch가 있거나 예외 처리 어노테이션이 있는지 확인
+func (r *SpringScheduledRule) handlesErrors(file *parser.ParsedFile, method parser.JavaMethod) bool {
+	for _, name := range r.errorAnnotations {
+		if hasAnnotationNamed(method.Annotations, name) {
+			return true
+		}
+	}
+	body := stripJavaComments(extractBlockFromLine(file, method.Line))
+	return catchBlockRegex.MatchString(body)
+}
+
+// hasLock 메소드나 클래스에 분산 락 어노테이션이 있는지 확인
+func (r *SpringScheduledRule) hasLock(class *parser.JavaClass, method parser.JavaMethod) bool {
+	for _, name := range r.lockAnnotations {
+		if hasAnnotationNamed(method.Annotations, name) || hasAnnotationNamed(class.Annotations, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *SpringScheduledRule) newIssue(file *parser.ParsedFile, method parser.JavaMethod, message, description, suggestion string) types.Issue {
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        method.Line,
+		Column:      method.Column,
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, method.Line),
+		Params: map[string]string{
+			"method": method.Name,
+		},
+	}
+}
+
+// SpringBatchStepRule chunk 기반 Spring Batch Step의 skip/retry 정책 누락 검사
+type SpringBatchStepRule struct {
+	config config.RuleConfig
+}
+
+func NewSpringBatchStepRule(cfg config.RuleConfig) Rule {
+	return &SpringBatchStepRule{config: cfg}
+}
+
+func (r *SpringBatchStepRule) ID() string   { return r.config.ID }
+func (r *SpringBatchStepRule) Name() string { return r.config.Name }
+func (r *SpringBatchStepRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *SpringBatchStepRule) Category() string    { return r.config.Category }
+func (r *SpringBatchStepRule) Description() string { return r.config.Description }
+func (r *SpringBatchStepRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *SpringBatchStepRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	content := stripJavaComments(file.Content)
+	for _, start := range stepBuilderRegex.FindAllStringIndex(content, -1) {
+		// 빌더 시작부터 첫 build()까지를 Step 정의 하나로 봄
+		end := stepBuildRegex.FindStringIndex(content[start[0]:])
+		if end == nil {
+			continue
+		}
+		chain := content[start[0] : start[0]+end[1]]
+		if !stepChunkRegex.MatchString(chain) {
+			continue // tasklet Step
+		}
+
+		faultTolerant := strings.Contains(chain, "faultTolerant")
+		if faultTolerant && stepPolicyRegex.MatchString(chain) {
+			continue
+		}
+
+		message := i18n.T("spring.batch-fault-tolerance.policy-message")
+		if !faultTolerant {
+			message = i18n.T("spring.batch-fault-tolerance.message")
+		}
+		lineNum := file.LineAt(start[0])
+		issues = append(issues, types.Issue{
+			RuleID:      r.ID(),
+			File:        file.Path,
+			Line:        lineNum,
+			Column:      file.ColumnAt(start[0]),
+			Severity:    r.Severity(),
+			Category:    r.Category(),
+			Message:     message,
+			Description: i18n.T("spring.batch-fault-tolerance.description"),
+			Suggestion:  i18n.T("spring.batch-fault-tolerance.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
+		})
+	}
+
+	return issues
+}
+
+// hasAnnotationNamed 어노테이션 목록에 이름이 같은 어노테이션이 있는지 확인 (패키지 경로를 붙인 이름도 인정)
+func hasAnnotationNamed(annotations []string, name string) bool {
+	name = strings.TrimPrefix(name, "@")
+	for _, annotation := range annotations {
+		match := annotationNamePrefixRegex.FindStringSubmatch(annotation)
+		if match == nil {
+			continue
+		}
+		if match[1] == name || strings.HasSuffix(match[1], "."+name) {
+			return true
+		}
+	}
+	return false
+}
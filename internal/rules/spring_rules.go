@@ -5,10 +5,22 @@ import (
 	"strings"
 
 	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
 	"code-quality-checker/internal/parser"
 	"code-quality-checker/internal/types"
 )
 
+// Spring 규칙에서 사용하는 정규식
+var (
+	requestBodyRegex          = regexp.MustCompile(`@RequestBody\s+(\w+\s+\w+)`)
+	privateTransactionalRegex = regexp.MustCompile(`@Transactional[^\n]*\n[^\n]*private\s+\w+\s+(\w+)\s*\(`)
+	transactionalRegex        = regexp.MustCompile(`@Transactional`)
+	sensitiveMethodRegex      = regexp.MustCompile(`public\s+\w+\s+(delete|remove|admin|update|modify|create|add)\w*\s*\([^)]*\)\s*(?:throws[^{]*)?\{`)
+	securedAnnotationRegex    = regexp.MustCompile(`@Secured`)
+	autowiredFieldRegex       = regexp.MustCompile(`@Autowired\s+private\s+\w+\s+(\w+);`)
+	throwsExceptionRegex      = regexp.MustCompile(`public\s+\w+\s+\w+\s*\([^)]*\)\s+throws\s+Exception`)
+)
+
 // SpringValidationRule @Valid 어노테이션 누락 검사
 type SpringValidationRule struct {
 	config config.RuleConfig
@@ -23,37 +35,38 @@ func (r *SpringValidationRule) Name() string               { return r.config.Nam
 func (r *SpringValidationRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SpringValidationRule) Category() string          { return r.config.Category }
 func (r *SpringValidationRule) Description() string       { return r.config.Description }
+func (r *SpringValidationRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SpringValidationRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// Controller 클래스인지 확인
-	if !r.isController(file.Content) {
+	if !isSpringController(file.Content) {
 		return issues
 	}
 
 	// @RequestBody 패턴 찾기
-	requestBodyRegex := regexp.MustCompile(`@RequestBody\s+(\w+\s+\w+)`)
 	matches := requestBodyRegex.FindAllStringSubmatch(file.Content, -1)
 	indices := requestBodyRegex.FindAllStringIndex(file.Content, -1)
 
 	for i, match := range matches {
 		if len(match) > 1 {
-			lineNum := getLineNumberFromPosition(file.Content, indices[i][0])
+			lineNum := file.LineAt(indices[i][0])
 			
 			// 해당 라인 주변에 @Valid가 있는지 확인
-			if !r.hasValidAnnotation(file.Content, lineNum) {
+			if !r.hasValidAnnotation(file.Lines, lineNum) {
 				issues = append(issues, types.Issue{
 					RuleID:      r.ID(),
 					File:        file.Path,
 					Line:        lineNum,
-					Column:      getColumnFromPosition(file.Content, indices[i][0]),
+					Column:      file.ColumnAt(indices[i][0]),
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceLow,
 					Category:    r.Category(),
-					Message:     "@RequestBody 매개변수에 @Valid 어노테이션이 누락되었습니다",
-					Description: "입력값 검증이 없으면 보안 취약점이 발생할 수 있습니다",
-					Suggestion:  "@Valid 어노테이션을 추가하여 입력값을 검증하세요",
-					CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+					Message:     i18n.T("spring.validation.message"),
+					Description: i18n.T("spring.validation.description"),
+					Suggestion:  i18n.T("spring.validation.suggestion"),
+					CodeSnippet: getCodeSnippet(file, lineNum),
 				})
 			}
 		}
@@ -62,22 +75,7 @@ func (r *SpringValidationRule) Check(file *parser.ParsedFile) []types.Issue {
 	return issues
 }
 
-func (r *SpringValidationRule) isController(content string) bool {
-	controllerPatterns := []string{
-		"@Controller",
-		"@RestController",
-	}
-	
-	for _, pattern := range controllerPatterns {
-		if strings.Contains(content, pattern) {
-			return true
-		}
-	}
-	return false
-}
-
-func (r *SpringValidationRule) hasValidAnnotation(content string, lineNum int) bool {
-	lines := strings.Split(content, "\n")
+func (r *SpringValidationRule) hasValidAnnotation(lines []string, lineNum int) bool {
 	start := max(0, lineNum-2)
 	end := min(len(lines), lineNum+2)
 	
@@ -89,13 +87,6 @@ func (r *SpringValidationRule) hasValidAnnotation(content string, lineNum int) b
 	return false
 }
 
-func (r *SpringValidationRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return file.Lines[line-1]
-}
-
 // SpringTransactionalRule @Transactional 관련 검사
 type SpringTransactionalRule struct {
 	config config.RuleConfig
@@ -110,54 +101,74 @@ func (r *SpringTransactionalRule) Name() string               { return r.config.
 func (r *SpringTransactionalRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SpringTransactionalRule) Category() string          { return r.config.Category }
 func (r *SpringTransactionalRule) Description() string       { return r.config.Description }
+func (r *SpringTransactionalRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SpringTransactionalRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
-	// private 메소드에 @Transactional 사용 검사
-	privateTransactionalRegex := regexp.MustCompile(`@Transactional[^\n]*\n[^\n]*private\s+\w+\s+(\w+)\s*\(`)
+	// 같은 구현이 두 규칙 ID로 등록되므로 ID에 해당하는 검사만 실행
+	if r.ID() != "spring-transactional-rollback" {
+		issues = append(issues, r.checkPrivateMethods(file)...)
+	}
+	if r.ID() != "spring-transactional-private" {
+		issues = append(issues, r.checkRollbackFor(file)...)
+	}
+
+	return issues
+}
+
+// checkPrivateMethods private 메소드에 @Transactional 사용 검사
+func (r *SpringTransactionalRule) checkPrivateMethods(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
 	matches := privateTransactionalRegex.FindAllStringSubmatch(file.Content, -1)
 	indices := privateTransactionalRegex.FindAllStringIndex(file.Content, -1)
 
 	for i, match := range matches {
 		if len(match) > 1 {
-			lineNum := getLineNumberFromPosition(file.Content, indices[i][0])
+			lineNum := file.LineAt(indices[i][0])
 			
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, indices[i][0]),
+				Column:      file.ColumnAt(indices[i][0]),
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "private 메소드에 @Transactional 어노테이션이 사용되었습니다",
-				Description: "private 메소드는 프록시가 작동하지 않아 트랜잭션이 적용되지 않습니다",
-				Suggestion:  "메소드를 public으로 변경하거나 클래스 레벨에서 @Transactional을 사용하세요",
-				CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+				Message:     i18n.T("spring.transactional-private.message"),
+				Description: i18n.T("spring.transactional-private.description"),
+				Suggestion:  i18n.T("spring.transactional-private.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 	}
 
-	// rollbackFor 누락 검사
-	transactionalRegex := regexp.MustCompile(`@Transactional`)
+	return issues
+}
+
+// checkRollbackFor 체크드 예외를 던지는 메소드의 rollbackFor 누락 검사
+func (r *SpringTransactionalRule) checkRollbackFor(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
 	rollbackMatches := transactionalRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range rollbackMatches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
-		line := r.getCodeSnippet(file, lineNum)
+		lineNum := file.LineAt(match[0])
+		line := getCodeSnippet(file, lineNum)
 		
 		// rollbackFor가 있는지 확인
-		if !strings.Contains(line, "rollbackFor") && r.hasThrowsException(file.Content, lineNum) {
+		if !strings.Contains(line, "rollbackFor") && r.hasThrowsException(file.Lines, lineNum) {
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, match[0]),
+				Column:      file.ColumnAt(match[0]),
 				Severity:    config.SeverityMedium,
+				Confidence:  config.ConfidenceMedium,
 				Category:    "reliability",
-				Message:     "@Transactional에 rollbackFor 설정이 누락되었습니다",
-				Description: "체크드 예외 발생 시 롤백되지 않을 수 있습니다",
-				Suggestion:  "@Transactional(rollbackFor = Exception.class)를 사용하세요",
+				Message:     i18n.T("spring.transactional-rollback.message"),
+				Description: i18n.T("spring.transactional-rollback.description"),
+				Suggestion:  i18n.T("spring.transactional-rollback.suggestion"),
 				CodeSnippet: strings.TrimSpace(line),
 			})
 		}
@@ -166,9 +177,8 @@ func (r *SpringTransactionalRule) Check(file *parser.ParsedFile) []types.Issue {
 	return issues
 }
 
-func (r *SpringTransactionalRule) hasThrowsException(content string, lineNum int) bool {
+func (r *SpringTransactionalRule) hasThrowsException(lines []string, lineNum int) bool {
 	// 해당 라인 근처에 throws Exception이 있는지 확인
-	lines := strings.Split(content, "\n")
 	start := max(0, lineNum-1)
 	end := min(len(lines), lineNum+5)
 	
@@ -180,13 +190,6 @@ func (r *SpringTransactionalRule) hasThrowsException(content string, lineNum int
 	return false
 }
 
-func (r *SpringTransactionalRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return file.Lines[line-1]
-}
-
 // SpringSecurityRule Spring Security 어노테이션 검사
 type SpringSecurityRule struct {
 	config config.RuleConfig
@@ -201,82 +204,87 @@ func (r *SpringSecurityRule) Name() string               { return r.config.Name
 func (r *SpringSecurityRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SpringSecurityRule) Category() string          { return r.config.Category }
 func (r *SpringSecurityRule) Description() string       { return r.config.Description }
+func (r *SpringSecurityRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SpringSecurityRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// Controller 클래스인지 확인
-	if !r.isController(file.Content) {
+	if !isSpringController(file.Content) {
 		return issues
 	}
 
-	// 민감한 메소드에 보안 어노테이션 누락 검사
-	sensitiveMethodRegex := regexp.MustCompile(`public\s+\w+\s+(delete|remove|admin|update|modify|create|add)\w*\s*\([^)]*\)\s*(?:throws[^{]*)?\{`)
+	// 같은 구현이 두 규칙 ID로 등록되므로 ID에 해당하는 검사만 실행
+	if r.ID() != "spring-secured-deprecated" {
+		issues = append(issues, r.checkSensitiveMethods(file)...)
+	}
+	if r.ID() != "spring-security-missing" {
+		issues = append(issues, r.checkSecuredAnnotation(file)...)
+	}
+
+	return issues
+}
+
+// checkSensitiveMethods 민감한 메소드에 보안 어노테이션 누락 검사
+func (r *SpringSecurityRule) checkSensitiveMethods(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
 	matches := sensitiveMethodRegex.FindAllStringSubmatch(file.Content, -1)
 	indices := sensitiveMethodRegex.FindAllStringIndex(file.Content, -1)
 
 	for i, match := range matches {
 		if len(match) > 1 {
-			lineNum := getLineNumberFromPosition(file.Content, indices[i][0])
+			lineNum := file.LineAt(indices[i][0])
 			
 			// 해당 메소드에 보안 어노테이션이 있는지 확인
-			if !r.hasSecurityAnnotation(file.Content, lineNum) {
+			if !r.hasSecurityAnnotation(file.Lines, lineNum) {
 				issues = append(issues, types.Issue{
 					RuleID:      r.ID(),
 					File:        file.Path,
 					Line:        lineNum,
-					Column:      getColumnFromPosition(file.Content, indices[i][0]),
+					Column:      file.ColumnAt(indices[i][0]),
 					Severity:    r.Severity(),
+					Confidence:  config.ConfidenceLow,
 					Category:    r.Category(),
-					Message:     "민감한 메소드에 보안 어노테이션이 누락되었습니다: " + match[1],
-					Description: "삭제, 수정, 관리자 기능에는 적절한 권한 검사가 필요합니다",
-					Suggestion:  "@PreAuthorize(\"hasRole('ADMIN')\") 등의 보안 어노테이션을 추가하세요",
-					CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+					Message:     i18n.T("spring.security-annotation.message", match[1]),
+					Description: i18n.T("spring.security-annotation.description"),
+					Suggestion:  i18n.T("spring.security-annotation.suggestion"),
+					CodeSnippet: getCodeSnippet(file, lineNum),
 				})
 			}
 		}
 	}
 
-	// @Secured 사용 시 @PreAuthorize 권장
-	securedAnnotationRegex := regexp.MustCompile(`@Secured`)
+	return issues
+}
+
+// checkSecuredAnnotation @Secured 사용 시 @PreAuthorize 권장
+func (r *SpringSecurityRule) checkSecuredAnnotation(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
 	securedMatches := securedAnnotationRegex.FindAllStringIndex(file.Content, -1)
 
 	for _, match := range securedMatches {
-		lineNum := getLineNumberFromPosition(file.Content, match[0])
+		lineNum := file.LineAt(match[0])
 		
 		issues = append(issues, types.Issue{
 			RuleID:      r.ID(),
 			File:        file.Path,
 			Line:        lineNum,
-			Column:      getColumnFromPosition(file.Content, match[0]),
+			Column:      file.ColumnAt(match[0]),
 			Severity:    config.SeverityMedium,
 			Category:    "best-practices",
-			Message:     "@Secured 대신 @PreAuthorize 사용을 권장합니다",
-			Description: "@PreAuthorize는 SpEL을 지원하여 더 유연한 보안 설정이 가능합니다",
-			Suggestion:  "@PreAuthorize(\"hasRole('ROLE_NAME')\")로 변경하세요",
-			CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+			Message:     i18n.T("spring.secured.message"),
+			Description: i18n.T("spring.secured.description"),
+			Suggestion:  i18n.T("spring.secured.suggestion"),
+			CodeSnippet: getCodeSnippet(file, lineNum),
 		})
 	}
 
 	return issues
 }
 
-func (r *SpringSecurityRule) isController(content string) bool {
-	controllerPatterns := []string{
-		"@Controller",
-		"@RestController",
-	}
-	
-	for _, pattern := range controllerPatterns {
-		if strings.Contains(content, pattern) {
-			return true
-		}
-	}
-	return false
-}
-
-func (r *SpringSecurityRule) hasSecurityAnnotation(content string, lineNum int) bool {
-	lines := strings.Split(content, "\n")
+func (r *SpringSecurityRule) hasSecurityAnnotation(lines []string, lineNum int) bool {
 	start := max(0, lineNum-5)
 	end := min(len(lines), lineNum)
 	
@@ -297,13 +305,6 @@ func (r *SpringSecurityRule) hasSecurityAnnotation(content string, lineNum int)
 	return false
 }
 
-func (r *SpringSecurityRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return file.Lines[line-1]
-}
-
 // SpringDependencyInjectionRule 의존성 주입 검사
 type SpringDependencyInjectionRule struct {
 	config config.RuleConfig
@@ -318,30 +319,30 @@ func (r *SpringDependencyInjectionRule) Name() string               { return r.c
 func (r *SpringDependencyInjectionRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SpringDependencyInjectionRule) Category() string          { return r.config.Category }
 func (r *SpringDependencyInjectionRule) Description() string       { return r.config.Description }
+func (r *SpringDependencyInjectionRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SpringDependencyInjectionRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
 
 	// @Autowired 필드 주입 사용 검사
-	autowiredFieldRegex := regexp.MustCompile(`@Autowired\s+private\s+\w+\s+(\w+);`)
 	matches := autowiredFieldRegex.FindAllStringSubmatch(file.Content, -1)
 	indices := autowiredFieldRegex.FindAllStringIndex(file.Content, -1)
 
 	for i, match := range matches {
 		if len(match) > 1 {
-			lineNum := getLineNumberFromPosition(file.Content, indices[i][0])
+			lineNum := file.LineAt(indices[i][0])
 			
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, indices[i][0]),
+				Column:      file.ColumnAt(indices[i][0]),
 				Severity:    r.Severity(),
 				Category:    r.Category(),
-				Message:     "필드 주입 대신 생성자 주입을 사용하세요: " + match[1],
-				Description: "생성자 주입은 불변성을 보장하고 테스트하기 더 쉽습니다",
-				Suggestion:  "final 필드와 생성자를 사용하거나 @RequiredArgsConstructor를 활용하세요",
-				CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+				Message:     i18n.T("spring.field-injection.message", match[1]),
+				Description: i18n.T("spring.field-injection.description"),
+				Suggestion:  i18n.T("spring.field-injection.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 	}
@@ -349,13 +350,6 @@ func (r *SpringDependencyInjectionRule) Check(file *parser.ParsedFile) []types.I
 	return issues
 }
 
-func (r *SpringDependencyInjectionRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return file.Lines[line-1]
-}
-
 // SpringExceptionHandlingRule 예외 처리 검사
 type SpringExceptionHandlingRule struct {
 	config config.RuleConfig
@@ -370,6 +364,7 @@ func (r *SpringExceptionHandlingRule) Name() string               { return r.con
 func (r *SpringExceptionHandlingRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
 func (r *SpringExceptionHandlingRule) Category() string          { return r.config.Category }
 func (r *SpringExceptionHandlingRule) Description() string       { return r.config.Description }
+func (r *SpringExceptionHandlingRule) Doc() RuleDoc              { return DocFor(r.config) }
 
 func (r *SpringExceptionHandlingRule) Check(file *parser.ParsedFile) []types.Issue {
 	var issues []types.Issue
@@ -379,25 +374,25 @@ func (r *SpringExceptionHandlingRule) Check(file *parser.ParsedFile) []types.Iss
 						  strings.Contains(file.Content, "@RestControllerAdvice")
 
 	// Controller 클래스이면서 전역 예외 처리기가 없는 경우
-	if r.isController(file.Content) && !hasControllerAdvice {
+	if isSpringController(file.Content) && !hasControllerAdvice {
 		// try-catch 없이 throws Exception만 있는 메소드 검사
-		throwsExceptionRegex := regexp.MustCompile(`public\s+\w+\s+\w+\s*\([^)]*\)\s+throws\s+Exception`)
 		matches := throwsExceptionRegex.FindAllStringIndex(file.Content, -1)
 
 		if len(matches) > 0 {
-			lineNum := getLineNumberFromPosition(file.Content, matches[0][0])
+			lineNum := file.LineAt(matches[0][0])
 			
 			issues = append(issues, types.Issue{
 				RuleID:      r.ID(),
 				File:        file.Path,
 				Line:        lineNum,
-				Column:      getColumnFromPosition(file.Content, matches[0][0]),
+				Column:      file.ColumnAt(matches[0][0]),
 				Severity:    r.Severity(),
+				Confidence:  config.ConfidenceLow,
 				Category:    r.Category(),
-				Message:     "전역 예외 처리기(@ControllerAdvice)가 없습니다",
-				Description: "일관된 예외 처리를 위해 전역 예외 처리기를 구현하세요",
-				Suggestion:  "@ControllerAdvice를 사용한 전역 예외 처리 클래스를 생성하세요",
-				CodeSnippet: strings.TrimSpace(r.getCodeSnippet(file, lineNum)),
+				Message:     i18n.T("spring.controller-advice.message"),
+				Description: i18n.T("spring.controller-advice.description"),
+				Suggestion:  i18n.T("spring.controller-advice.suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
 			})
 		}
 	}
@@ -405,25 +400,9 @@ func (r *SpringExceptionHandlingRule) Check(file *parser.ParsedFile) []types.Iss
 	return issues
 }
 
-func (r *SpringExceptionHandlingRule) isController(content string) bool {
-	controllerPatterns := []string{
-		"@Controller",
-		"@RestController",
-	}
-	
-	for _, pattern := range controllerPatterns {
-		if strings.Contains(content, pattern) {
-			return true
-		}
-	}
-	return false
-}
-
-func (r *SpringExceptionHandlingRule) getCodeSnippet(file *parser.ParsedFile, line int) string {
-	if line <= 0 || line > len(file.Lines) {
-		return ""
-	}
-	return file.Lines[line-1]
+// isSpringController Controller/RestController 클래스 여부
+func isSpringController(content string) bool {
+	return strings.Contains(content, "@Controller") || strings.Contains(content, "@RestController")
 }
 
 // 헬퍼 함수
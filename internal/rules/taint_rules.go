@@ -0,0 +1,239 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 오염 전파를 따라가는 데 사용하는 정규식
+var (
+	// 대입문의 왼쪽 변수 (==, =>는 제외)
+	taintAssignRegex = regexp.MustCompile(`([A-Za-z_$][\w$]*)\s*(?:\+)?=(?:[^=>]|$)`)
+	// StringBuilder 등에 이어붙이는 호출의 대상 변수
+	taintAppendRegex = regexp.MustCompile(`([A-Za-z_$][\w$]*)\s*\.\s*(?:append|concat|push)\s*\(`)
+	// 어노테이션 소스 뒤의 매개변수 이름 (@RequestParam("q") String q)
+	taintParamRegex = regexp.MustCompile(`^\s*(?:\([^)]*\))?\s*(?:final\s+)?[\w.<>\[\]?, ]*?\s([A-Za-z_$][\w$]*)\s*(?:[,)]|$)`)
+)
+
+// taintCWE 싱크 종류별 CWE
+var taintCWE = map[string]string{
+	config.TaintSQL:      "CWE-89",
+	config.TaintXSS:      "CWE-79",
+	config.TaintRedirect: "CWE-601",
+}
+
+// taintPattern 컴파일한 소스/싱크
+type taintPattern struct {
+	name  string
+	kind  string
+	regex *regexp.Regexp
+}
+
+func compileTaintPatterns(patterns []config.TaintPattern) []taintPattern {
+	var compiled []taintPattern
+	for _, pattern := range patterns {
+		// 설정을 읽을 때 확인하므로 여기서 실패하는 패턴은 건너뜀
+		if regex, err := regexp.Compile(pattern.Pattern); err == nil {
+			compiled = append(compiled, taintPattern{name: pattern.Name, kind: pattern.Kind, regex: regex})
+		}
+	}
+	return compiled
+}
+
+// TaintFlowRule 사용자 입력(소스)이 정제 없이 SQL 실행, HTML 출력, 리다이렉트 등(싱크)으로 전달되는지 검사
+// Java는 메소드 단위, JavaScript는 파일 단위로 대입과 이어붙이기를 따라 오염된 변수를 추적하는 라인 기반 휴리스틱입니다
+// 소스/싱크/정제 함수는 rules.yaml의 languages[].taint로 확장합니다
+type TaintFlowRule struct {
+	config     config.RuleConfig
+	sources    []taintPattern
+	sinks      []taintPattern
+	sanitizers []string
+}
+
+func NewTaintFlowRule(cfg config.RuleConfig, taint config.TaintConfig) Rule {
+	return &TaintFlowRule{
+		config:     cfg,
+		sources:    compileTaintPatterns(taint.Sources),
+		sinks:      compileTaintPatterns(taint.Sinks),
+		sanitizers: taint.Sanitizers,
+	}
+}
+
+func (r *TaintFlowRule) ID() string                { return r.config.ID }
+func (r *TaintFlowRule) Name() string              { return r.config.Name }
+func (r *TaintFlowRule) Severity() config.Severity { return config.ParseSeverity(r.config.Severity) }
+func (r *TaintFlowRule) Category() string          { return r.config.Category }
+func (r *TaintFlowRule) Description() string       { return r.config.Description }
+func (r *TaintFlowRule) Doc() RuleDoc              { return DocFor(r.config) }
+
+func (r *TaintFlowRule) Check(file *parser.ParsedFile) []types.Issue {
+	if len(r.sources) == 0 || len(r.sinks) == 0 {
+		return nil
+	}
+
+	var issues []types.Issue
+	tainted := make(map[string]string) // 변수 이름 -> 소스 이름
+	depth := 0
+
+	for i, rawLine := range file.Lines {
+		lineNum := i + 1
+		line := stripLineComment(rawLine)
+		depth += braceDelta(line)
+		if strings.TrimSpace(line) == "" || r.sanitized(line) {
+			continue
+		}
+
+		// 싱크 검사는 이 라인의 대입을 반영하기 전에 수행 (x = x + ...가 자기 자신을 오염시키지 않도록)
+		for _, sink := range r.sinks {
+			loc := sink.regex.FindStringIndex(line)
+			if loc == nil {
+				continue
+			}
+			if source, ok := r.taintedBy(line[loc[0]:], tainted); ok {
+				issues = append(issues, r.newIssue(file, lineNum, loc[0], source, sink))
+				break
+			}
+		}
+
+		r.propagate(line, tainted)
+
+		// Java는 메소드 단위로 추적 (메소드 본문이 끝나거나 필드 선언이 끝나 클래스 본문 깊이로 돌아오면 초기화)
+		if file.Language == "java" && depth <= 1 && strings.ContainsAny(line, "};") {
+			tainted = make(map[string]string)
+		}
+	}
+	return issues
+}
+
+// taintedBy 코드에 소스 호출이나 오염된 변수가 있으면 소스 이름 반환
+func (r *TaintFlowRule) taintedBy(code string, tainted map[string]string) (string, bool) {
+	for _, source := range r.sources {
+		if source.regex.MatchString(code) {
+			return source.name, true
+		}
+	}
+	for _, match := range identifierRegex.FindAllString(code, -1) {
+		if source, ok := tainted[match]; ok {
+			return source, true
+		}
+	}
+	return "", false
+}
+
+// propagate 소스나 오염된 변수를 대입/이어붙인 변수를 오염된 것으로 기록
+func (r *TaintFlowRule) propagate(line string, tainted map[string]string) {
+	// 어노테이션 소스가 붙은 매개변수 (@RequestParam String q)
+	for _, source := range r.sources {
+		for _, loc := range source.regex.FindAllStringIndex(line, -1) {
+			if !strings.HasPrefix(line[loc[0]:], "@") {
+				continue
+			}
+			if match := taintParamRegex.FindStringSubmatch(line[loc[1]:]); match != nil {
+				tainted[match[1]] = source.name
+			}
+		}
+	}
+
+	if match := taintAssignRegex.FindStringSubmatchIndex(line); match != nil {
+		if source, ok := r.taintedBy(line[match[1]-1:], tainted); ok {
+			tainted[line[match[2]:match[3]]] = source
+		}
+	}
+	for _, match := range taintAppendRegex.FindAllStringSubmatchIndex(line, -1) {
+		if source, ok := r.taintedBy(line[match[1]:], tainted); ok {
+			tainted[line[match[2]:match[3]]] = source
+		}
+	}
+}
+
+// sanitized 정제 함수를 거치는 라인인지
+func (r *TaintFlowRule) sanitized(line string) bool {
+	for _, sanitizer := range r.sanitizers {
+		if sanitizer != "" && strings.Contains(line, sanitizer) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *TaintFlowRule) newIssue(file *parser.ParsedFile, lineNum, column int, source string, sink taintPattern) types.Issue {
+	key := "taint.other"
+	if _, ok := taintCWE[sink.kind]; ok {
+		key = "taint." + sink.kind
+	}
+
+	issue := types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        lineNum,
+		Column:      column + 1,
+		Severity:    r.Severity(),
+		Confidence:  config.ConfidenceMedium,
+		Category:    r.Category(),
+		Message:     i18n.T(key+".message", source, sink.name),
+		Description: r.Description(),
+		Suggestion:  i18n.T(key + ".suggestion"),
+		CodeSnippet: getCodeSnippet(file, lineNum),
+		Params:      map[string]string{"source": source, "sink": sink.name},
+	}
+	if cwe, ok := taintCWE[sink.kind]; ok {
+		issue.CWE = []string{cwe}
+		issue.OWASP = OWASPInjection
+	}
+	return issue
+}
+
+// stripLineComment 라인 끝의 // 주석 제거 (문자열 안의 //는 유지)
+func stripLineComment(line string) string {
+	end := len(line)
+	scanCode(line, func(i int, ch byte) bool {
+		if ch == '/' && i+1 < len(line) && line[i+1] == '/' {
+			end = i
+			return false
+		}
+		return true
+	})
+	return line[:end]
+}
+
+// braceDelta 문자열 밖의 '{' 수에서 '}' 수를 뺀 값
+func braceDelta(line string) int {
+	delta := 0
+	scanCode(line, func(i int, ch byte) bool {
+		switch ch {
+		case '{':
+			delta++
+		case '}':
+			delta--
+		}
+		return true
+	})
+	return delta
+}
+
+// scanCode 문자열 리터럴 밖의 문자마다 visit 호출 (false를 반환하면 중단)
+func scanCode(line string, visit func(i int, ch byte) bool) {
+	inString := byte(0)
+	for i := 0; i < len(line); i++ {
+		ch := line[i]
+		switch {
+		case inString != 0:
+			if ch == '\\' {
+				i++
+			} else if ch == inString {
+				inString = 0
+			}
+		case ch == '"' || ch == '\'' || ch == '`':
+			inString = ch
+		default:
+			if !visit(i, ch) {
+				return
+			}
+		}
+	}
+}
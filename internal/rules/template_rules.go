@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"regexp"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 서버 사이드 템플릿 규칙에서 사용하는 정규식
+var (
+	jspCommentRegex = regexp.MustCompile(`(?s)<%--.*?--%>`)
+	// th:utext 값 (메시지 번들 키만 쓰는 #{...}는 신뢰할 수 있는 문구라 제외)
+	thUtextRegex       = regexp.MustCompile(`\s(?:th|data-th)[:-]utext\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	thInlineRawRegex   = regexp.MustCompile(`\[\(\s*[$*]\{`)
+	cOutUnescapedRegex = regexp.MustCompile(`(?i)<c:out\b[^>]*\bescapeXml\s*=\s*["']false["']`)
+	jspExpressionRegex = regexp.MustCompile(`<%=`)
+)
+
+// unescapedOutput 이스케이프 없이 출력하는 템플릿 구문 종류
+type unescapedOutput struct {
+	regex  *regexp.Regexp
+	key    string // 메시지 키 접두사 (<key>.message, <key>.suggestion)
+	engine string
+}
+
+var unescapedOutputs = []unescapedOutput{
+	{thUtextRegex, "template.th-utext", parser.TemplateThymeleaf},
+	{thInlineRawRegex, "template.th-inline-raw", parser.TemplateThymeleaf},
+	{cOutUnescapedRegex, "template.c-out-unescaped", parser.TemplateJSP},
+	{jspExpressionRegex, "template.jsp-expression", parser.TemplateJSP},
+}
+
+// TemplateOutputRule Thymeleaf/JSP 템플릿에서 이스케이프 없이 데이터를 출력하는 구문 검사 (XSS)
+type TemplateOutputRule struct {
+	config config.RuleConfig
+}
+
+func NewTemplateOutputRule(cfg config.RuleConfig) Rule {
+	return &TemplateOutputRule{config: cfg}
+}
+
+func (r *TemplateOutputRule) ID() string   { return r.config.ID }
+func (r *TemplateOutputRule) Name() string { return r.config.Name }
+func (r *TemplateOutputRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *TemplateOutputRule) Category() string    { return r.config.Category }
+func (r *TemplateOutputRule) Description() string { return r.config.Description }
+func (r *TemplateOutputRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *TemplateOutputRule) Check(file *parser.ParsedFile) []types.Issue {
+	var issues []types.Issue
+
+	engine := htmlTemplateOf(file).Engine
+	if engine == "" {
+		return issues
+	}
+
+	var comments [][2]int
+	for _, regex := range []*regexp.Regexp{htmlCommentRegex, jspCommentRegex} {
+		for _, comment := range regex.FindAllStringIndex(file.Content, -1) {
+			comments = append(comments, [2]int{comment[0], comment[1]})
+		}
+	}
+
+	for _, output := range unescapedOutputs {
+		if output.engine != engine {
+			continue
+		}
+		for _, match := range output.regex.FindAllStringSubmatchIndex(file.Content, -1) {
+			if inRanges(comments, match[0]) || (output.regex == thUtextRegex && messageOnly(file.Content, match)) {
+				continue
+			}
+			lineNum := file.LineAt(match[0])
+			issues = append(issues, types.Issue{
+				RuleID:      r.ID(),
+				File:        file.Path,
+				Line:        lineNum,
+				Column:      file.ColumnAt(match[0]),
+				Severity:    r.Severity(),
+				Category:    r.Category(),
+				Message:     i18n.T(output.key + ".message"),
+				Description: r.Description(),
+				Suggestion:  i18n.T(output.key + ".suggestion"),
+				CodeSnippet: getCodeSnippet(file, lineNum),
+			})
+		}
+	}
+
+	return issues
+}
+
+// messageOnly th:utext 값이 데이터 표현식 없이 메시지 번들 키(#{...})만 쓰는지
+func messageOnly(content string, match []int) bool {
+	value := ""
+	for i := 2; i+1 < len(match); i += 2 {
+		if match[i] >= 0 {
+			value = content[match[i]:match[i+1]]
+		}
+	}
+	return !strings.Contains(value, "${") && !strings.Contains(value, "*{")
+}
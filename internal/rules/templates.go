@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"strconv"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// applyMessageTemplates 규칙별 메시지 템플릿으로 이슈 문구 재정의
+func applyMessageTemplates(issues []types.Issue, templates config.MessageTemplates) {
+	if templates.IsEmpty() {
+		return
+	}
+
+	for i := range issues {
+		replacer := templateReplacer(&issues[i])
+		if templates.Message != "" {
+			issues[i].Message = replacer.Replace(templates.Message)
+		}
+		if templates.Description != "" {
+			issues[i].Description = replacer.Replace(templates.Description)
+		}
+		if templates.Suggestion != "" {
+			issues[i].Suggestion = replacer.Replace(templates.Suggestion)
+		}
+	}
+}
+
+// templateReplacer 기본 치환값({{rule}}, {{file}}, {{line}}, {{message}} 등)과 규칙별 Params로 치환기 생성
+func templateReplacer(issue *types.Issue) *strings.Replacer {
+	values := map[string]string{
+		"rule":        issue.RuleID,
+		"file":        issue.File,
+		"line":        strconv.Itoa(issue.Line),
+		"column":      strconv.Itoa(issue.Column),
+		"category":    issue.Category,
+		"severity":    issue.Severity.String(),
+		"message":     issue.Message,
+		"description": issue.Description,
+		"suggestion":  issue.Suggestion,
+		"snippet":     strings.TrimSpace(issue.CodeSnippet),
+	}
+	for key, value := range issue.Params {
+		values[key] = value
+	}
+
+	var pairs []string
+	for key, value := range values {
+		pairs = append(pairs, "{{"+key+"}}", value)
+	}
+	return strings.NewReplacer(pairs...)
+}
+
+// attachExamples 규칙의 위반/수정 코드 예시를 이슈에 첨부
+func attachExamples(issues []types.Issue, examples []config.RuleExample) {
+	if len(examples) == 0 {
+		return
+	}
+	for i := range issues {
+		issues[i].Examples = examples
+	}
+}
@@ -0,0 +1,228 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/i18n"
+	"code-quality-checker/internal/parser"
+	"code-quality-checker/internal/types"
+)
+
+// 웹 성능 규칙에서 사용하는 정규식
+var (
+	htmlCommentRegex  = regexp.MustCompile(`(?s)<!--.*?-->`)
+	headBlockRegex    = regexp.MustCompile(`(?is)<head\b[^>]*>(.*?)</head\s*>`)
+	scriptTagRegex    = regexp.MustCompile(`(?i)<script\b[^>]*>`)
+	imgTagRegex       = regexp.MustCompile(`(?i)<img\b[^>]*>`)
+	stylesheetRegex   = regexp.MustCompile(`(?i)<link\b[^>]*\brel\s*=\s*["']?stylesheet\b[^>]*>`)
+	srcAttrRegex      = regexp.MustCompile(`(?i)\s(?:th:)?src\s*=`)
+	asyncDeferRegex   = regexp.MustCompile(`(?i)\s(?:async|defer)\b`)
+	moduleScriptRegex = regexp.MustCompile(`(?i)\stype\s*=\s*["']?module\b`)
+	widthAttrRegex    = regexp.MustCompile(`(?i)\s(?:th:)?width\s*=`)
+	heightAttrRegex   = regexp.MustCompile(`(?i)\s(?:th:)?height\s*=`)
+	// 인라인 <style>/<script> 블록 (src가 있는 스크립트는 본문이 비어 있어 크기 0)
+	inlineCodeRegex = regexp.MustCompile(`(?is)<(style|script)\b[^>]*>(.*?)</(?:style|script)\s*>`)
+)
+
+// WebPerformanceRule 페이지 렌더링을 늦추는 HTML 코드 검사
+// html-render-blocking-script, html-img-dimensions, html-external-resource-limit, html-inline-code-budget 네 규칙 ID로 등록됩니다
+type WebPerformanceRule struct {
+	config          config.RuleConfig
+	maxResources    int // 페이지당 외부 CSS/JS 파일 수 상한 (max_external_resources, 기본값 10)
+	maxInlineStyle  int // 인라인 <style> 블록 하나의 바이트 상한 (max_inline_style_bytes, 기본값 2048)
+	maxInlineScript int // 인라인 <script> 블록 하나의 바이트 상한 (max_inline_script_bytes, 기본값 4096)
+	maxInlineTotal  int // 페이지 전체 인라인 CSS/JS의 바이트 상한 (max_inline_total_bytes, 기본값 10240)
+}
+
+func NewWebPerformanceRule(cfg config.RuleConfig) Rule {
+	return &WebPerformanceRule{
+		config:          cfg,
+		maxResources:    customInt(cfg.Custom, "max_external_resources", 10),
+		maxInlineStyle:  customInt(cfg.Custom, "max_inline_style_bytes", 2048),
+		maxInlineScript: customInt(cfg.Custom, "max_inline_script_bytes", 4096),
+		maxInlineTotal:  customInt(cfg.Custom, "max_inline_total_bytes", 10240),
+	}
+}
+
+func (r *WebPerformanceRule) ID() string   { return r.config.ID }
+func (r *WebPerformanceRule) Name() string { return r.config.Name }
+func (r *WebPerformanceRule) Severity() config.Severity {
+	return config.ParseSeverity(r.config.Severity)
+}
+func (r *WebPerformanceRule) Category() string    { return r.config.Category }
+func (r *WebPerformanceRule) Description() string { return r.config.Description }
+func (r *WebPerformanceRule) Doc() RuleDoc        { return DocFor(r.config) }
+
+func (r *WebPerformanceRule) Check(file *parser.ParsedFile) []types.Issue {
+	comments := htmlCommentRegex.FindAllStringIndex(file.Content, -1)
+	ranges := make([][2]int, 0, len(comments))
+	for _, comment := range comments {
+		ranges = append(ranges, [2]int{comment[0], comment[1]})
+	}
+
+	switch r.ID() {
+	case "html-render-blocking-script":
+		return r.checkRenderBlocking(file, ranges)
+	case "html-img-dimensions":
+		return r.checkImgDimensions(file, ranges)
+	case "html-external-resource-limit":
+		return r.checkResourceCount(file, ranges)
+	case "html-inline-code-budget":
+		return r.checkInlineBudget(file, ranges)
+	}
+	return nil
+}
+
+// checkRenderBlocking <head> 안에서 defer/async 없이 외부 스크립트를 불러오는 태그 검사
+func (r *WebPerformanceRule) checkRenderBlocking(file *parser.ParsedFile, comments [][2]int) []types.Issue {
+	var issues []types.Issue
+
+	head := headBlockRegex.FindStringSubmatchIndex(file.Content)
+	if head == nil {
+		return issues
+	}
+
+	for _, match := range scriptTagRegex.FindAllStringIndex(file.Content[head[2]:head[3]], -1) {
+		offset := head[2] + match[0]
+		tag := file.Content[offset : head[2]+match[1]]
+		if inRanges(comments, offset) || !srcAttrRegex.MatchString(tag) || asyncDeferRegex.MatchString(tag) || moduleScriptRegex.MatchString(tag) {
+			continue
+		}
+		issues = append(issues, r.newIssue(file, offset,
+			i18n.T("web-performance.blocking-script.message"),
+			i18n.T("web-performance.blocking-script.description"),
+			i18n.T("web-performance.blocking-script.suggestion")))
+	}
+
+	return issues
+}
+
+// checkImgDimensions width/height 속성이 없는 img 태그 검사
+func (r *WebPerformanceRule) checkImgDimensions(file *parser.ParsedFile, comments [][2]int) []types.Issue {
+	var issues []types.Issue
+
+	for _, match := range imgTagRegex.FindAllStringIndex(file.Content, -1) {
+		tag := file.Content[match[0]:match[1]]
+		if inRanges(comments, match[0]) {
+			continue
+		}
+
+		var missing []string
+		if !widthAttrRegex.MatchString(tag) {
+			missing = append(missing, "width")
+		}
+		if !heightAttrRegex.MatchString(tag) {
+			missing = append(missing, "height")
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		issues = append(issues, r.newIssue(file, match[0],
+			i18n.T("web-performance.img-size.message", strings.Join(missing, "/")),
+			i18n.T("web-performance.img-size.description"),
+			i18n.T("web-performance.img-size.suggestion")))
+	}
+
+	return issues
+}
+
+// checkResourceCount 페이지에서 불러오는 외부 CSS/JS 파일 수가 상한을 넘는지 검사 (상한을 넘긴 첫 태그 위치에 한 번 보고)
+func (r *WebPerformanceRule) checkResourceCount(file *parser.ParsedFile, comments [][2]int) []types.Issue {
+	var issues []types.Issue
+
+	var stylesheets, scripts []int
+	for _, match := range stylesheetRegex.FindAllStringIndex(file.Content, -1) {
+		if !inRanges(comments, match[0]) {
+			stylesheets = append(stylesheets, match[0])
+		}
+	}
+	for _, match := range scriptTagRegex.FindAllStringIndex(file.Content, -1) {
+		if !inRanges(comments, match[0]) && srcAttrRegex.MatchString(file.Content[match[0]:match[1]]) {
+			scripts = append(scripts, match[0])
+		}
+	}
+
+	total := len(stylesheets) + len(scripts)
+	if total <= r.maxResources {
+		return issues
+	}
+
+	// 문서 순서로 상한을 넘긴 태그 위치
+	offsets := append(append([]int{}, stylesheets...), scripts...)
+	sort.Ints(offsets)
+
+	issues = append(issues, r.newIssue(file, offsets[r.maxResources],
+		i18n.T("web-performance.resource-count.message", total, len(stylesheets), len(scripts), r.maxResources),
+		i18n.T("web-performance.resource-count.description"),
+		i18n.T("web-performance.resource-count.suggestion")))
+
+	return issues
+}
+
+// checkInlineBudget 인라인 <style>/<script> 블록과 페이지 전체 인라인 코드가 바이트 예산을 넘는지 검사
+func (r *WebPerformanceRule) checkInlineBudget(file *parser.ParsedFile, comments [][2]int) []types.Issue {
+	var issues []types.Issue
+
+	total, blocks := 0, 0
+	for _, match := range inlineCodeRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+		if inRanges(comments, match[0]) {
+			continue
+		}
+		tag := strings.ToLower(file.Content[match[2]:match[3]])
+		size := len(strings.TrimSpace(file.Content[match[4]:match[5]]))
+		if size == 0 {
+			continue
+		}
+		total += size
+		blocks++
+
+		budget, extension := r.maxInlineScript, "js"
+		if tag == "style" {
+			budget, extension = r.maxInlineStyle, "css"
+		}
+		if size > budget {
+			issues = append(issues, r.newIssue(file, match[0],
+				i18n.T("web-performance.inline-block.message", tag, formatBytes(size), formatBytes(budget)),
+				i18n.T("web-performance.inline-block.description"),
+				i18n.T("web-performance.inline-block.suggestion", extension)))
+		}
+	}
+
+	if total > r.maxInlineTotal {
+		issues = append(issues, r.newIssue(file, 0,
+			i18n.T("web-performance.inline-total.message", formatBytes(total), formatBytes(r.maxInlineTotal), blocks),
+			i18n.T("web-performance.inline-total.description"),
+			i18n.T("web-performance.inline-total.suggestion")))
+	}
+
+	return issues
+}
+
+// formatBytes 바이트 수를 읽기 쉬운 단위로 표시
+func formatBytes(size int) string {
+	if size < 1024 {
+		return fmt.Sprintf("%dB", size)
+	}
+	return fmt.Sprintf("%.1fKB", float64(size)/1024)
+}
+
+func (r *WebPerformanceRule) newIssue(file *parser.ParsedFile, offset int, message, description, suggestion string) types.Issue {
+	line := file.LineAt(offset)
+	return types.Issue{
+		RuleID:      r.ID(),
+		File:        file.Path,
+		Line:        line,
+		Column:      file.ColumnAt(offset),
+		Severity:    r.Severity(),
+		Category:    r.Category(),
+		Message:     message,
+		Description: description,
+		Suggestion:  suggestion,
+		CodeSnippet: getCodeSnippet(file, line),
+	}
+}
@@ -0,0 +1,325 @@
+package symbols
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"code-quality-checker/internal/endpoints"
+	"code-quality-checker/internal/parser"
+)
+
+// formatVersion 색인 형식 버전 (심볼 추출 방식이 바뀌면 올려서 기존 색인을 다시 생성)
+const formatVersion = "1"
+
+// indexFileName 색인 디렉토리 안의 색인 파일 이름
+const indexFileName = "symbols.json"
+
+// 심볼 종류
+const (
+	KindClass    = "class"
+	KindMethod   = "method"
+	KindFunction = "function"
+	KindSelector = "selector"
+	KindID       = "id"
+	KindEndpoint = "endpoint"
+)
+
+// DetailUserData 템플릿에서 서버/사용자 데이터로 렌더링되는 요소 id의 Detail 값
+const DetailUserData = "user-data"
+
+var (
+	// 템플릿에서 서버/사용자 데이터를 출력하는 표현식 (Thymeleaf, JSP/EL, Mustache 계열, Vue, Angular)
+	templateDataRegex = regexp.MustCompile(`\$\{[^}]+\}|\{\{[^}]+\}\}|<%=|th:u?text\s*=|v-html\s*=|\[innerHTML\]\s*=`)
+	elementIDRegex    = regexp.MustCompile(`\bid\s*=\s*["']([\w-]+)["']`)
+	cssRuleRegex      = regexp.MustCompile(`([^{}]+)\{`)
+	classDeclRegex    = regexp.MustCompile(`\b(?:class|interface|enum|record)\s+(\w+)`)
+)
+
+// Symbol 색인된 심볼 하나
+type Symbol struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Detail string `json:"detail,omitempty"` // 메소드의 클래스, 엔드포인트 핸들러, 사용자 데이터 요소 표시 등
+}
+
+// fileEntry 파일 하나의 심볼과 내용 해시
+type fileEntry struct {
+	Hash    string   `json:"hash"`
+	Symbols []Symbol `json:"symbols"`
+}
+
+// indexFile 디스크에 저장하는 색인 구조
+type indexFile struct {
+	Version string                `json:"version"`
+	Files   map[string]*fileEntry `json:"files"`
+}
+
+// Stats 색인 갱신 통계
+type Stats struct {
+	Indexed int // 새로 색인한 파일
+	Reused  int // 내용이 바뀌지 않아 기존 심볼을 재사용한 파일
+	Removed int // 삭제되었거나 분석 대상에서 빠진 파일
+	Errors  int
+}
+
+// Index 프로젝트 전체의 심볼 색인 (.cqc/index)
+// 파일 내용 해시가 바뀐 파일만 다시 파싱하므로 실행마다 프로젝트 정보를 처음부터 만들지 않아도 됩니다
+type Index struct {
+	dir   string
+	data  indexFile
+	dirty bool
+	Stats Stats
+}
+
+// Load 색인 디렉토리에서 색인을 읽음
+// 색인이 없거나 형식 버전이 다르면 빈 색인을, 파일이 깨졌으면 빈 색인과 오류를 함께 반환합니다
+func Load(dir string) (*Index, error) {
+	idx := &Index{dir: dir, data: indexFile{Version: formatVersion, Files: make(map[string]*fileEntry)}}
+
+	data, err := os.ReadFile(filepath.Join(dir, indexFileName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return idx, nil
+		}
+		return idx, err
+	}
+
+	var stored indexFile
+	if err := json.Unmarshal(data, &stored); err != nil {
+		idx.dirty = true
+		return idx, fmt.Errorf("색인 파일 파싱 실패: %w", err)
+	}
+	if stored.Version != formatVersion || stored.Files == nil {
+		idx.dirty = true
+		return idx, nil
+	}
+	idx.data = stored
+	return idx, nil
+}
+
+// key 색인에 기록하는 파일 경로
+func key(filePath string) string {
+	return filepath.ToSlash(filepath.Clean(filePath))
+}
+
+// Update 파일 내용이 바뀌었으면 다시 파싱하여 심볼 갱신 (바뀌지 않았으면 기존 심볼 유지)
+func (idx *Index) Update(filePath, language string) error {
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		idx.Stats.Errors++
+		return err
+	}
+	sum := sha256.Sum256(append([]byte(language+"\x00"), content...))
+	hash := hex.EncodeToString(sum[:])
+
+	if entry, ok := idx.data.Files[key(filePath)]; ok && entry.Hash == hash {
+		idx.Stats.Reused++
+		return nil
+	}
+
+	file, err := parser.ParseFile(filePath, language)
+	if err != nil {
+		idx.Stats.Errors++
+		return err
+	}
+	idx.data.Files[key(filePath)] = &fileEntry{Hash: hash, Symbols: extract(file)}
+	idx.dirty = true
+	idx.Stats.Indexed++
+	return nil
+}
+
+// Prune 삭제된 파일과, root 아래에 있지만 이번에 색인하지 않은 파일의 심볼 제거
+// root가 비어있으면 삭제된 파일만 제거합니다 (표본 분석처럼 일부 파일만 본 경우)
+func (idx *Index) Prune(root string, seen map[string]bool) {
+	rootKey := ""
+	if root != "" {
+		rootKey = key(root)
+	}
+	for path := range idx.data.Files {
+		_, err := os.Stat(path)
+		missing := os.IsNotExist(err)
+		if !missing && rootKey != "" && !seen[path] && within(rootKey, path) {
+			missing = true
+		}
+		if missing {
+			delete(idx.data.Files, path)
+			idx.dirty = true
+			idx.Stats.Removed++
+		}
+	}
+}
+
+// Seen Prune에 넘길 수 있도록 색인 키 형식으로 경로 기록
+func Seen(seen map[string]bool, filePath string) {
+	seen[key(filePath)] = true
+}
+
+// within path가 root 디렉토리(또는 파일) 아래에 있는지
+func within(root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, "../")
+}
+
+// Save 바뀐 내용이 있으면 색인 저장 (읽는 쪽이 깨진 파일을 보지 않도록 임시 파일에 쓴 뒤 교체)
+func (idx *Index) Save() error {
+	if !idx.dirty {
+		return nil
+	}
+	data, err := json.Marshal(idx.data)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(idx.dir, 0755); err != nil {
+		return err
+	}
+
+	path := filepath.Join(idx.dir, indexFileName)
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return err
+	}
+	idx.dirty = false
+	return nil
+}
+
+// Symbols 종류가 kind인 심볼을 파일, 라인 순으로 반환 (kind가 비어있으면 전체)
+func (idx *Index) Symbols(kind string) []Symbol {
+	var symbols []Symbol
+	for _, entry := range idx.data.Files {
+		for _, symbol := range entry.Symbols {
+			if kind == "" || symbol.Kind == kind {
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	sort.Slice(symbols, func(i, j int) bool {
+		if symbols[i].File != symbols[j].File {
+			return symbols[i].File < symbols[j].File
+		}
+		if symbols[i].Line != symbols[j].Line {
+			return symbols[i].Line < symbols[j].Line
+		}
+		return symbols[i].Name < symbols[j].Name
+	})
+	return symbols
+}
+
+// Find 이름이 name인 심볼 검색 (kind가 비어있으면 모든 종류, 대소문자 구분)
+func (idx *Index) Find(kind, name string) []Symbol {
+	var found []Symbol
+	for _, symbol := range idx.Symbols(kind) {
+		if symbol.Name == name {
+			found = append(found, symbol)
+		}
+	}
+	return found
+}
+
+// Counts 종류별 심볼 수
+func (idx *Index) Counts() map[string]int {
+	counts := make(map[string]int)
+	for _, entry := range idx.data.Files {
+		for _, symbol := range entry.Symbols {
+			counts[symbol.Kind]++
+		}
+	}
+	return counts
+}
+
+// Files 색인된 파일 수
+func (idx *Index) Files() int {
+	return len(idx.data.Files)
+}
+
+// UserDataIDs 템플릿 라인에서 서버/사용자 데이터와 함께 렌더링되는 요소 id 목록
+func UserDataIDs(line string) []string {
+	if !templateDataRegex.MatchString(line) {
+		return nil
+	}
+	var ids []string
+	for _, match := range elementIDRegex.FindAllStringSubmatch(line, -1) {
+		ids = append(ids, match[1])
+	}
+	return ids
+}
+
+// extract 파싱된 파일에서 언어별 심볼 추출
+func extract(file *parser.ParsedFile) []Symbol {
+	path := key(file.Path)
+	var symbols []Symbol
+
+	switch file.Language {
+	case "java":
+		class, ok := file.AST.(*parser.JavaClass)
+		if !ok || class == nil || class.Name == "" {
+			break
+		}
+		line := 1
+		for i, content := range file.Lines {
+			if match := classDeclRegex.FindStringSubmatch(content); match != nil && match[1] == class.Name {
+				line = i + 1
+				break
+			}
+		}
+		symbols = append(symbols, Symbol{Kind: KindClass, Name: class.Name, File: path, Line: line, Detail: class.Package})
+		for _, method := range class.Methods {
+			symbols = append(symbols, Symbol{Kind: KindMethod, Name: method.Name, File: path, Line: method.Line, Detail: class.Name})
+		}
+		for _, endpoint := range endpoints.Extract(file) {
+			symbols = append(symbols, Symbol{Kind: KindEndpoint, Name: endpoint.Method + " " + endpoint.Path, File: path, Line: endpoint.Line, Detail: endpoint.Handler})
+		}
+
+	case "javascript", "typescript":
+		functions, _ := file.AST.([]parser.JSFunction)
+		for _, function := range functions {
+			if function.Name != "" {
+				symbols = append(symbols, Symbol{Kind: KindFunction, Name: function.Name, File: path, Line: function.Line})
+			}
+		}
+
+	case "css":
+		for _, match := range cssRuleRegex.FindAllStringSubmatchIndex(file.Content, -1) {
+			start := match[2]
+			for _, selector := range strings.Split(file.Content[match[2]:match[3]], ",") {
+				offset := start
+				start += len(selector) + 1
+				// 셀렉터 앞의 주석과 공백은 이름과 라인 계산에서 제외
+				if end := strings.LastIndex(selector, "*/"); end >= 0 {
+					offset += end + 2
+					selector = selector[end+2:]
+				}
+				offset += len(selector) - len(strings.TrimLeft(selector, " \t\r\n"))
+				selector = strings.TrimSpace(selector)
+				if selector == "" || strings.HasPrefix(selector, "@") || strings.HasSuffix(selector, ";") {
+					continue
+				}
+				symbols = append(symbols, Symbol{Kind: KindSelector, Name: selector, File: path, Line: file.LineAt(offset)})
+			}
+		}
+
+	case "html":
+		for i, line := range file.Lines {
+			userData := templateDataRegex.MatchString(line)
+			for _, match := range elementIDRegex.FindAllStringSubmatch(line, -1) {
+				symbol := Symbol{Kind: KindID, Name: match[1], File: path, Line: i + 1}
+				if userData {
+					symbol.Detail = DetailUserData
+				}
+				symbols = append(symbols, symbol)
+			}
+		}
+	}
+	return symbols
+}
@@ -0,0 +1,104 @@
+package telemetry
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"code-quality-checker/internal/badge"
+	"code-quality-checker/internal/config"
+	"code-quality-checker/internal/types"
+)
+
+// Summary 플랫폼 팀 대시보드로 보내는 집계 지표 (파일 경로, 코드, 메시지는 포함하지 않음)
+type Summary struct {
+	Project        string         `json:"project,omitempty"` // 설정한 프로젝트 이름 (분석 경로는 보내지 않음)
+	Timestamp      time.Time      `json:"timestamp"`
+	Files          int            `json:"files"`
+	Issues         int            `json:"issues"`
+	Grade          string         `json:"grade"`
+	DurationMS     int64          `json:"duration_ms"`
+	FilesPerSecond float64        `json:"files_per_second"`
+	Severity       map[string]int `json:"severity"`
+	Category       map[string]int `json:"category"`
+	Language       map[string]int `json:"language"`
+	Rules          map[string]int `json:"rules"` // 규칙 ID별 이슈 수
+	Suppressed     int            `json:"suppressed"`
+	Degraded       int            `json:"degraded"`
+	GatesFailed    int            `json:"gates_failed"`
+	Sampled        bool           `json:"sampled,omitempty"`
+}
+
+// Build 분석 결과에서 집계 지표만 추출
+func Build(result *types.AnalysisResult, project string) *Summary {
+	summary := &Summary{
+		Project:        project,
+		Timestamp:      result.EndTime,
+		Files:          result.Summary.TotalFiles,
+		Issues:         result.Summary.TotalIssues,
+		Grade:          badge.Grade(result.Summary),
+		DurationMS:     result.Duration.Milliseconds(),
+		FilesPerSecond: result.Summary.Performance.FilesPerSecond,
+		Severity:       make(map[string]int),
+		Category:       make(map[string]int),
+		Language:       make(map[string]int),
+		Rules:          make(map[string]int),
+		Suppressed:     result.ActiveSuppressions(),
+		Degraded:       len(result.Degraded),
+		GatesFailed:    len(result.FailedGates()),
+		Sampled:        result.Sampling != nil,
+	}
+
+	for severity, count := range result.Summary.SeverityCount {
+		summary.Severity[severity.String()] = count
+	}
+	for category, count := range result.Summary.CategoryCount {
+		summary.Category[category] = count
+	}
+	for language, count := range result.Summary.LanguageCount {
+		summary.Language[language] = count
+	}
+	for _, issue := range result.Issues {
+		summary.Rules[issue.RuleID]++
+	}
+	return summary
+}
+
+// Send 집계 지표를 설정한 엔드포인트로 POST (토큰 환경 변수가 있으면 Bearer 인증)
+func Send(cfg config.TelemetryConfig, summary *Summary) error {
+	data, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, cfg.Endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if cfg.TokenEnv != "" {
+		token := os.Getenv(cfg.TokenEnv)
+		if token == "" {
+			return fmt.Errorf("텔레메트리 토큰 환경 변수(%s)가 설정되지 않았습니다", cfg.TokenEnv)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Delta 지난 실행 대비 이슈 변화
+type Delta struct {
+	PreviousTime time.Time   `json:"previous_time"`
+	New          int         `json:"new"`
+	Fixed        int         `json:"fixed"`
+	Unchanged    int         `json:"unchanged"`
+	Rules        []RuleDelta `json:"rules"`
+}
+
+// RuleDelta 규칙별 이슈 변화
+type RuleDelta struct {
+	RuleID    string `json:"rule_id"`
+	New       int    `json:"new"`
+	Fixed     int    `json:"fixed"`
+	Unchanged int    `json:"unchanged"`
+}
+
+// Fingerprint 실행 간 같은 이슈를 가리키는 식별자 (규칙, 정규화한 파일 경로, 코드의 해시 앞 16자리)
+// 라인 번호와 메시지는 넣지 않으므로 위쪽 코드가 바뀌어 라인이 밀리거나 로케일이 바뀌어도 같은 값이 나옵니다
+// 코드 스니펫이 없는 파일 단위 이슈만 메시지로 구분합니다
+// 결과 파일을 비교할 때는 기록된 fingerprint 대신 다시 계산하므로 이전 버전이 만든 결과와도 비교할 수 있습니다
+func Fingerprint(issue Issue) string {
+	code := normalizeCode(issue.CodeSnippet)
+	if code == "" {
+		code = issue.Message
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{issue.RuleID, NormalizePath(issue.File), code}, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+// NormalizePath 식별자용 파일 경로 (작업 디렉토리 아래의 절대 경로는 상대 경로로, 구분자는 /)
+// 같은 저장소를 다른 위치에 체크아웃한 CI 작업끼리도 같은 식별자가 나오도록 합니다
+func NormalizePath(path string) string {
+	path = filepath.Clean(path)
+	if filepath.IsAbs(path) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, path); err == nil && !strings.HasPrefix(rel, "..") {
+				path = rel
+			}
+		}
+	}
+	return filepath.ToSlash(path)
+}
+
+// normalizeCode 들여쓰기와 공백 차이를 무시하도록 연속된 공백을 하나로 합침
+func normalizeCode(code string) string {
+	return strings.Join(strings.Fields(code), " ")
+}
+
+// IssueSet 실행 간 같은 이슈를 찾기 위한 이슈 집합 (베이스라인 비교용)
+type IssueSet map[string]int
+
+// NewIssueSet 이슈 목록으로 집합 생성
+func NewIssueSet(issues []Issue) IssueSet {
+	set := make(IssueSet)
+	for _, issue := range issues {
+		set[Fingerprint(issue)]++
+	}
+	return set
+}
+
+// Take 같은 이슈가 남아 있으면 하나를 소비하고 true 반환
+func (s IssueSet) Take(issue Issue) bool {
+	key := Fingerprint(issue)
+	if s[key] == 0 {
+		return false
+	}
+	s[key]--
+	return true
+}
+
+// TrackPersistence 이전 결과에도 있던 이슈의 최초 발견 시각과 연속 발견 횟수를 이어받아 기록
+// 새로 발견된 이슈는 현재 실행 시각부터 1회로 기록합니다
+func TrackPersistence(previous, current *AnalysisResult) {
+	seen := make(map[string][]Issue)
+	for _, issue := range previous.Issues {
+		key := Fingerprint(issue)
+		seen[key] = append(seen[key], issue)
+	}
+
+	for i := range current.Issues {
+		issue := &current.Issues[i]
+		firstSeen, runs := current.StartTime, 1
+
+		key := Fingerprint(*issue)
+		if matches := seen[key]; len(matches) > 0 {
+			match := matches[0]
+			seen[key] = matches[1:]
+
+			firstSeen, runs = previous.StartTime, 2
+			if match.FirstSeen != nil {
+				firstSeen = *match.FirstSeen
+			}
+			if match.Runs > 0 {
+				runs = match.Runs + 1
+			}
+		}
+
+		issue.FirstSeen = &firstSeen
+		issue.Runs = runs
+	}
+}
+
+// Persistence 실행 기록에서 조회한 이슈의 연속 발견 정보 (이번 실행 전까지)
+type Persistence struct {
+	FirstSeen time.Time // 연속으로 발견되기 시작한 실행의 시작 시각
+	Runs      int       // 마지막 실행까지 연속으로 발견된 횟수
+}
+
+// ApplyPersistence 실행 기록의 연속 발견 정보로 현재 이슈의 처음 발견 시각과 연속 발견 횟수 기록 (키는 Fingerprint)
+// 마지막 기록 실행에 없던 이슈는 이번 실행에서 처음 발견된 것으로 봅니다
+func ApplyPersistence(history map[string]Persistence, current *AnalysisResult) {
+	for i := range current.Issues {
+		issue := &current.Issues[i]
+		firstSeen, runs := current.StartTime, 1
+		if seen, ok := history[Fingerprint(*issue)]; ok {
+			firstSeen, runs = seen.FirstSeen, seen.Runs+1
+		}
+		issue.FirstSeen = &firstSeen
+		issue.Runs = runs
+	}
+}
+
+// CompareResults 이전 결과와 현재 결과의 이슈를 비교하여 신규/해결/유지 개수 계산
+func CompareResults(previous, current *AnalysisResult) *Delta {
+	remaining := make(map[string]int)
+	ruleIDs := make(map[string]string)
+	for _, issue := range previous.Issues {
+		key := Fingerprint(issue)
+		remaining[key]++
+		ruleIDs[key] = issue.RuleID
+	}
+
+	rules := make(map[string]*RuleDelta)
+	ruleDelta := func(ruleID string) *RuleDelta {
+		if rules[ruleID] == nil {
+			rules[ruleID] = &RuleDelta{RuleID: ruleID}
+		}
+		return rules[ruleID]
+	}
+
+	delta := &Delta{PreviousTime: previous.EndTime}
+	for _, issue := range current.Issues {
+		key := Fingerprint(issue)
+		if remaining[key] > 0 {
+			remaining[key]--
+			delta.Unchanged++
+			ruleDelta(issue.RuleID).Unchanged++
+		} else {
+			delta.New++
+			ruleDelta(issue.RuleID).New++
+		}
+	}
+	for key, count := range remaining {
+		if count > 0 {
+			delta.Fixed += count
+			ruleDelta(ruleIDs[key]).Fixed += count
+		}
+	}
+
+	for _, rule := range rules {
+		delta.Rules = append(delta.Rules, *rule)
+	}
+	sort.Slice(delta.Rules, func(i, j int) bool {
+		return delta.Rules[i].RuleID < delta.Rules[j].RuleID
+	})
+	return delta
+}
+
+// ResultDiff 두 분석 결과 사이의 이슈 목록 비교 (cqc diff)
+type ResultDiff struct {
+	New        []Issue     `json:"new"`
+	Fixed      []Issue     `json:"fixed"`
+	Persisting []Issue     `json:"persisting"`
+	Rules      []RuleDelta `json:"rules"`
+}
+
+// DiffResults 핑거프린트가 같은 이슈를 짝지어 신규/해결/유지 이슈 목록 계산
+// 같은 핑거프린트가 여러 개면 개수만큼 짝지으며, 남는 이슈는 신규 또는 해결로 분류합니다
+func DiffResults(previous, current *AnalysisResult) *ResultDiff {
+	remaining := make(map[string][]Issue)
+	for _, issue := range previous.Issues {
+		fingerprint := Fingerprint(issue)
+		remaining[fingerprint] = append(remaining[fingerprint], issue)
+	}
+
+	rules := make(map[string]*RuleDelta)
+	ruleDelta := func(ruleID string) *RuleDelta {
+		if rules[ruleID] == nil {
+			rules[ruleID] = &RuleDelta{RuleID: ruleID}
+		}
+		return rules[ruleID]
+	}
+
+	diff := &ResultDiff{}
+	for _, issue := range current.Issues {
+		fingerprint := Fingerprint(issue)
+		if matches := remaining[fingerprint]; len(matches) > 0 {
+			remaining[fingerprint] = matches[1:]
+			diff.Persisting = append(diff.Persisting, issue)
+			ruleDelta(issue.RuleID).Unchanged++
+		} else {
+			diff.New = append(diff.New, issue)
+			ruleDelta(issue.RuleID).New++
+		}
+	}
+	// 이전 결과 순서를 유지하도록 previous.Issues를 다시 훑으며 남은 이슈 수집
+	for _, issue := range previous.Issues {
+		fingerprint := Fingerprint(issue)
+		if matches := remaining[fingerprint]; len(matches) > 0 {
+			remaining[fingerprint] = matches[1:]
+			diff.Fixed = append(diff.Fixed, issue)
+			ruleDelta(issue.RuleID).Fixed++
+		}
+	}
+
+	for _, rule := range rules {
+		diff.Rules = append(diff.Rules, *rule)
+	}
+	sort.Slice(diff.Rules, func(i, j int) bool {
+		return diff.Rules[i].RuleID < diff.Rules[j].RuleID
+	})
+	return diff
+}
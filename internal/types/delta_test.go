@@ -0,0 +1,94 @@
+package types
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"code-quality-checker/internal/config"
+)
+
+func TestFingerprintStable(t *testing.T) {
+	base := Issue{
+		RuleID:      "java-system-out",
+		File:        "src/main/java/OrderService.java",
+		Line:        42,
+		Column:      8,
+		Severity:    config.SeverityMedium,
+		Message:     "System.out 대신 로거를 사용하세요",
+		CodeSnippet: `System.out.println("order " + id);`,
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*Issue)
+		same   bool
+	}{
+		{"그대로", func(*Issue) {}, true},
+		{"라인 이동", func(i *Issue) { i.Line, i.Column = 57, 12 }, true},
+		{"다른 로케일의 메시지", func(i *Issue) { i.Message = "Use a logger instead of System.out" }, true},
+		{"심각도 변경", func(i *Issue) { i.Severity = config.SeverityHigh }, true},
+		{"들여쓰기와 공백 차이", func(i *Issue) { i.CodeSnippet = "\t\tSystem.out.println(\"order \"  +  id);\n" }, true},
+		{"중복 구분자가 있는 경로", func(i *Issue) { i.File = "src/main//java/./OrderService.java" }, true},
+		{"작업 디렉토리 아래 절대 경로", func(i *Issue) { i.File = filepath.Join(wd, "src/main/java/OrderService.java") }, true},
+		{"다른 규칙", func(i *Issue) { i.RuleID = "java-logging-convention" }, false},
+		{"다른 파일", func(i *Issue) { i.File = "src/main/java/PaymentService.java" }, false},
+		{"다른 코드", func(i *Issue) { i.CodeSnippet = `System.out.println("payment " + id);` }, false},
+	}
+
+	want := Fingerprint(base)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := base
+			tt.modify(&issue)
+			if got := Fingerprint(issue); (got == want) != tt.same {
+				t.Errorf("Fingerprint = %s, 기준 %s (같아야 함: %v)", got, want, tt.same)
+			}
+		})
+	}
+}
+
+// 결과 파일과 실행 기록에 저장된 값과 비교하므로 버전이 바뀌어도 같은 값이 나와야 함
+func TestFingerprintGolden(t *testing.T) {
+	tests := []struct {
+		name  string
+		issue Issue
+		want  string
+	}{
+		{
+			name:  "코드 스니펫",
+			issue: Issue{RuleID: "java-system-out", File: "src/main/java/OrderService.java", CodeSnippet: `System.out.println("order " + id);`},
+			want:  "2a1b51e1eec0524c",
+		},
+		{
+			name:  "파일 단위 이슈 (메시지로 구분)",
+			issue: Issue{RuleID: "html-seo", File: "web/index.html", Message: "<title>이 없습니다"},
+			want:  "18798aeacb270fc6",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fingerprint(tt.issue)
+			if len(got) != 16 {
+				t.Errorf("Fingerprint 길이 %d, want 16", len(got))
+			}
+			if got != tt.want {
+				t.Errorf("Fingerprint = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFingerprintMessageWithoutSnippet(t *testing.T) {
+	a := Issue{RuleID: "html-seo", File: "web/index.html", Message: "<title>이 없습니다"}
+	b := a
+	b.Message = "meta description이 없습니다"
+	if Fingerprint(a) == Fingerprint(b) {
+		t.Error("코드 스니펫이 없는 이슈는 메시지가 다르면 식별자도 달라야 합니다")
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"sort"
+
+	"code-quality-checker/internal/config"
+)
+
+// HotspotCount 요약에 기록하는 핫스팟 파일 수
+const HotspotCount = 10
+
+// hotspotWeights 핫스팟 점수 계산 시 심각도별 가중치
+var hotspotWeights = map[config.Severity]int{
+	config.SeverityCritical: 10,
+	config.SeverityHigh:     5,
+	config.SeverityMedium:   2,
+	config.SeverityLow:      1,
+}
+
+// Hotspot 심각도 가중 점수가 높은 파일 (리팩터링을 시작할 위치)
+type Hotspot struct {
+	File     string `json:"file"`
+	Score    int    `json:"score"` // critical 10, high 5, medium 2, low 1점의 합
+	Issues   int    `json:"issues"`
+	Critical int    `json:"critical,omitempty"`
+	High     int    `json:"high,omitempty"`
+	Medium   int    `json:"medium,omitempty"`
+	Low      int    `json:"low,omitempty"`
+}
+
+// RankHotspots 파일별 심각도 가중 점수 상위 limit개 (점수가 같으면 이슈 수, 파일 경로 순)
+func RankHotspots(issues []Issue, limit int) []Hotspot {
+	var tally HotspotTally
+	for _, issue := range issues {
+		tally.Add(issue)
+	}
+	return tally.Rank(limit)
+}
+
+// HotspotTally 파일별 핫스팟 점수 누적 (이슈 목록을 보관하지 않고 순위를 매길 때 사용)
+type HotspotTally struct {
+	indexByFile map[string]int
+	hotspots    []Hotspot
+}
+
+// Add 이슈 하나를 해당 파일 점수에 반영
+func (t *HotspotTally) Add(issue Issue) {
+	if issue.File == "" {
+		return
+	}
+	if t.indexByFile == nil {
+		t.indexByFile = make(map[string]int)
+	}
+	i, ok := t.indexByFile[issue.File]
+	if !ok {
+		i = len(t.hotspots)
+		t.indexByFile[issue.File] = i
+		t.hotspots = append(t.hotspots, Hotspot{File: issue.File})
+	}
+
+	hotspot := &t.hotspots[i]
+	hotspot.Score += hotspotWeights[issue.Severity]
+	hotspot.Issues++
+	switch issue.Severity {
+	case config.SeverityCritical:
+		hotspot.Critical++
+	case config.SeverityHigh:
+		hotspot.High++
+	case config.SeverityMedium:
+		hotspot.Medium++
+	default:
+		hotspot.Low++
+	}
+}
+
+// Rank 누적한 점수 상위 limit개 (0이면 전체)
+func (t *HotspotTally) Rank(limit int) []Hotspot {
+	hotspots := append([]Hotspot(nil), t.hotspots...)
+	sort.Slice(hotspots, func(i, j int) bool {
+		if hotspots[i].Score != hotspots[j].Score {
+			return hotspots[i].Score > hotspots[j].Score
+		}
+		if hotspots[i].Issues != hotspots[j].Issues {
+			return hotspots[i].Issues > hotspots[j].Issues
+		}
+		return hotspots[i].File < hotspots[j].File
+	})
+	if limit > 0 && len(hotspots) > limit {
+		hotspots = hotspots[:limit]
+	}
+	return hotspots
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// SignatureAlgorithm 결과 서명 알고리즘
+const SignatureAlgorithm = "hmac-sha256"
+
+// Metadata 리포트를 만든 도구와 규칙 세트, 분석한 코드 정보 (감사 시 재현/검증용)
+type Metadata struct {
+	ToolVersion        string `json:"tool_version"`
+	RulesetHash        string `json:"ruleset_hash"`                  // 필터링까지 적용된 규칙 설정의 SHA-256
+	Commit             string `json:"commit,omitempty"`              // 분석한 트리의 git 커밋 (알 수 없으면 비어있음)
+	SignatureAlgorithm string `json:"signature_algorithm,omitempty"` // 서명 키가 있을 때만 설정
+	Signature          string `json:"signature,omitempty"`           // signature를 비운 결과 JSON의 HMAC
+}
+
+// signaturePayload 서명 대상 (signature 필드만 비운 결과의 JSON)
+func (r *AnalysisResult) signaturePayload() ([]byte, error) {
+	unsigned := *r
+	metadata := *r.Metadata
+	metadata.Signature = ""
+	unsigned.Metadata = &metadata
+	return json.Marshal(&unsigned)
+}
+
+// Sign 결과 전체에 HMAC-SHA256 서명 (Metadata가 설정된 뒤, 결과를 더 바꾸지 않을 때 호출)
+func (r *AnalysisResult) Sign(key []byte) error {
+	if r.Metadata == nil {
+		return fmt.Errorf("서명할 메타데이터가 없습니다")
+	}
+	r.Metadata.SignatureAlgorithm = SignatureAlgorithm
+	payload, err := r.signaturePayload()
+	if err != nil {
+		return fmt.Errorf("서명 대상 마샬링 실패: %w", err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	r.Metadata.Signature = hex.EncodeToString(mac.Sum(nil))
+	return nil
+}
+
+// VerifySignature 서명이 키와 결과 내용에 맞는지 확인 (서명이 없거나 내용이 바뀌었으면 오류)
+func (r *AnalysisResult) VerifySignature(key []byte) error {
+	if r.Metadata == nil || r.Metadata.Signature == "" {
+		return fmt.Errorf("서명이 없는 결과입니다")
+	}
+	if r.Metadata.SignatureAlgorithm != SignatureAlgorithm {
+		return fmt.Errorf("지원하지 않는 서명 알고리즘: %s", r.Metadata.SignatureAlgorithm)
+	}
+	expected, err := hex.DecodeString(r.Metadata.Signature)
+	if err != nil {
+		return fmt.Errorf("서명 형식 오류: %w", err)
+	}
+	payload, err := r.signaturePayload()
+	if err != nil {
+		return fmt.Errorf("서명 대상 마샬링 실패: %w", err)
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(payload)
+	if !hmac.Equal(mac.Sum(nil), expected) {
+		return fmt.Errorf("서명이 일치하지 않습니다 (결과가 변경되었거나 키가 다릅니다)")
+	}
+	return nil
+}
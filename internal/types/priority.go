@@ -0,0 +1,16 @@
+package types
+
+import "code-quality-checker/internal/config"
+
+// effortMinutes 심각도별 예상 수정 시간 (분)
+var effortMinutes = map[config.Severity]int{
+	config.SeverityCritical: 60,
+	config.SeverityHigh:     30,
+	config.SeverityMedium:   15,
+	config.SeverityLow:      5,
+}
+
+// EstimateEffort 심각도로 추정한 예상 수정 시간 (분)
+func EstimateEffort(severity config.Severity) int {
+	return effortMinutes[severity]
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/json"
+	"fmt"
+	"os"
 	"time"
 
 	"code-quality-checker/internal/config"
@@ -8,16 +11,61 @@ import (
 
 // Issue 코드 품질 이슈
 type Issue struct {
-	RuleID      string           `json:"rule_id"`
-	File        string           `json:"file"`
-	Line        int              `json:"line"`
-	Column      int              `json:"column"`
-	Severity    config.Severity  `json:"severity"`
-	Category    string           `json:"category"`
-	Message     string           `json:"message"`
-	Description string           `json:"description"`
-	Suggestion  string           `json:"suggestion,omitempty"`
-	CodeSnippet string           `json:"code_snippet,omitempty"`
+	RuleID        string               `json:"rule_id"`
+	File          string               `json:"file"`
+	Line          int                  `json:"line"`
+	Column        int                  `json:"column"`
+	Severity      config.Severity      `json:"severity"`
+	Confidence    config.Confidence    `json:"confidence,omitempty"` // 규칙이 지정하지 않으면 high
+	Category      string               `json:"category"`
+	Message       string               `json:"message"`
+	Description   string               `json:"description"`
+	Suggestion    string               `json:"suggestion,omitempty"`
+	CodeSnippet   string               `json:"code_snippet,omitempty"`
+	Fix           *Fix                 `json:"fix,omitempty"`
+	CWE           []string             `json:"cwe,omitempty"`            // 보안 규칙의 CWE ID
+	OWASP         string               `json:"owasp,omitempty"`          // OWASP Top 10 카테고리
+	FirstSeen     *time.Time           `json:"first_seen,omitempty"`     // 처음 발견된 실행 시각 (이전 결과와 비교한 경우)
+	Runs          int                  `json:"runs,omitempty"`           // 연속으로 발견된 실행 횟수
+	EscalatedFrom *config.Severity     `json:"escalated_from,omitempty"` // 오래 방치되어 심각도가 상향된 경우 원래 심각도
+	Fingerprint   string               `json:"fingerprint,omitempty"`    // 실행 간 같은 이슈를 가리키는 식별자 (규칙, 정규화한 경로, 코드의 해시, 라인 번호와 무관)
+	ID            string               `json:"id,omitempty"`             // 식별자-순번 형식의 고유 ID (같은 코드가 반복된 이슈도 하나씩 구분)
+	Triage        *Triage              `json:"triage,omitempty"`         // 트리아지 파일에 기록된 상태
+	URL           string               `json:"url,omitempty"`            // 코드 호스팅의 해당 라인 링크 (repository.url_template 설정 시)
+	Redacted      bool                 `json:"redacted,omitempty"`       // 코드 스니펫을 가렸거나 생략함 (리포트에 원문을 싣지 않음)
+	Maturity      string               `json:"maturity,omitempty"`       // 규칙이 experimental/deprecated일 때만 기록
+	Advisory      bool                 `json:"advisory,omitempty"`       // 종료 코드와 품질 게이트에 반영하지 않는 이슈 (실험 규칙)
+	Priority      int                  `json:"priority,omitempty"`       // 우선순위 점수 (0~100, 심각도/신뢰도/카테고리/변경 빈도)
+	EffortMinutes int                  `json:"effort_minutes,omitempty"` // 심각도로 추정한 예상 수정 시간 (분)
+	Examples      []config.RuleExample `json:"examples,omitempty"`
+	Params        map[string]string    `json:"-"` // 메시지 템플릿 치환값 ({{method}}, {{value}} 등)
+}
+
+// 트리아지 상태
+const (
+	TriageOpen          = "open"           // 담당자만 지정 (그대로 보고)
+	TriageAccepted      = "accepted"       // 위험을 인지하고 수용 (그대로 보고)
+	TriageFalsePositive = "false-positive" // 오탐 (보고에서 제외)
+	TriageWontFix       = "wontfix"        // 수정하지 않음 (보고에서 제외)
+)
+
+// Triage 이슈 트리아지 정보 (.cqc-triage.yaml)
+type Triage struct {
+	State   string `json:"state" yaml:"state"`
+	Owner   string `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Comment string `json:"comment,omitempty" yaml:"comment,omitempty"`
+}
+
+// Excluded 보고에서 제외되는 상태인지 확인
+func (t *Triage) Excluded() bool {
+	return t != nil && (t.State == TriageFalsePositive || t.State == TriageWontFix)
+}
+
+// Fix 자동 수정 정보 (StartLine~EndLine 라인을 Replacement로 교체)
+type Fix struct {
+	StartLine   int    `json:"start_line"`
+	EndLine     int    `json:"end_line"`
+	Replacement string `json:"replacement"`
 }
 
 // Summary 분석 요약 정보
@@ -27,19 +75,166 @@ type Summary struct {
 	SeverityCount  map[config.Severity]int    `json:"severity_count"`
 	CategoryCount  map[string]int             `json:"category_count"`
 	LanguageCount  map[string]int             `json:"language_count"`
+	Hotspots       []Hotspot                  `json:"hotspots,omitempty"` // 심각도 가중 점수 상위 파일
+	Performance    Performance                `json:"performance"`
+}
+
+// Performance 분석 성능 통계 (릴리즈 간 성능 회귀 추적용, 파일은 한 번에 하나씩 순차 분석)
+type Performance struct {
+	FilesPerSecond  float64                  `json:"files_per_second"`
+	TotalBytes      int64                    `json:"total_bytes"`
+	LanguageTimeMS  map[string]int64         `json:"language_time_ms"`           // 언어별 분석 소요 시간 (밀리초)
+	StageTime       map[string]time.Duration `json:"stage_time,omitempty"`       // 단계별 소요 시간 (collect, parse, rules)
+	PeakMemoryBytes uint64                   `json:"peak_memory_bytes"`          // 분석 중 확보한 메모리의 최대값 (RSS 근사치)
+	LowMemoryFiles  int                      `json:"low_memory_files,omitempty"` // 메모리 상한을 넘어 라인 단위 규칙만 검사한 파일 수
 }
 
+// 분석 단계 (StageTime 키, report는 cqc bench에서만 측정)
+const (
+	StageCollect = "collect" // 파일 수집과 표본 선택
+	StageParse   = "parse"   // 구조 파싱 (텍스트 분석 대체 포함)
+	StageRules   = "rules"   // 규칙 검사
+	StageReport  = "report"  // 리포트 생성
+)
+
 // AnalysisResult 분석 결과
 type AnalysisResult struct {
-	Summary   Summary       `json:"summary"`
-	Issues    []Issue       `json:"issues"`
-	StartTime time.Time     `json:"start_time"`
-	EndTime   time.Time     `json:"end_time"`
-	Duration  time.Duration `json:"duration"`
-	Config    interface{}   `json:"config,omitempty"`
+	Summary    Summary           `json:"summary"`
+	Issues     []Issue           `json:"issues"`
+	StartTime  time.Time         `json:"start_time"`
+	EndTime    time.Time         `json:"end_time"`
+	Duration   time.Duration     `json:"duration"`
+	Config     interface{}       `json:"config,omitempty"`
+	Warnings   []string          `json:"warnings,omitempty"`
+	Gates      []GateResult      `json:"gates,omitempty"`
+	Delta      *Delta            `json:"delta,omitempty"`         // 이전 결과가 주어졌을 때만 계산
+	Suppressed []SuppressedIssue `json:"suppressed,omitempty"`    // 인라인 주석이나 베이스라인으로 억제된 이슈 (만료되어 다시 보고된 이슈 포함)
+	Sampling   *Sampling         `json:"sampling,omitempty"`      // 표본 분석일 때만 설정
+	Degraded   []DegradedFile    `json:"degraded,omitempty"`      // 구조 파싱에 실패해 텍스트 분석으로 대체한 파일
+	Flags      []FeatureFlag     `json:"feature_flags,omitempty"` // 코드에서 사용 중인 기능 플래그 (기능 플래그 규칙이 켜진 경우만)
+	Preview    *Preview          `json:"preview,omitempty"`       // 미리보기 모드일 때만 설정 (Issues에는 규칙별 대표 이슈만 남음)
+	Stream     *Stream           `json:"stream,omitempty"`        // 이슈를 분석 중에 내보냈을 때만 설정 (Issues는 비어있음)
+	Metadata   *Metadata         `json:"metadata,omitempty"`      // 도구 버전, 규칙 세트 해시, 커밋, 서명
+}
+
+// FeatureFlag 코드에서 사용 중인 기능 플래그 (오래된 플래그 정리용 목록)
+type FeatureFlag struct {
+	Name          string   `json:"name"`
+	Usages        int      `json:"usages"`
+	LiteralUsages int      `json:"literal_usages,omitempty"` // 상수 대신 문자열로 전달한 사용 수
+	Files         []string `json:"files"`
+}
+
+// Stream 스트리밍 분석 정보 (이슈는 파일 분석이 끝날 때마다 내보내고 결과에는 요약만 남김)
+type Stream struct {
+	Issues   int `json:"issues"`   // 내보낸 이슈 수
+	Critical int `json:"critical"` // 종료 코드에 반영되는 critical 이슈 수 (Advisory 제외)
+}
+
+// Preview 미리보기(온보딩) 모드 정보 (요약의 이슈 수는 줄이기 전 전체 기준)
+type Preview struct {
+	PerRule int           `json:"per_rule"` // 규칙별로 남긴 대표 이슈 최대 수
+	Rules   []PreviewRule `json:"rules"`    // 이슈가 많은 순
+}
+
+// PreviewRule 미리보기의 규칙별 이슈 수
+type PreviewRule struct {
+	RuleID   string          `json:"rule_id"`
+	Category string          `json:"category"`
+	Severity config.Severity `json:"severity"` // 규칙 이슈 중 가장 높은 심각도
+	Total    int             `json:"total"`
+	Files    int             `json:"files"`
+	Shown    int             `json:"shown"`
+}
+
+// DegradedFile 구조 파싱 대신 텍스트 분석으로 검사한 파일 (AST가 필요한 규칙은 검사되지 않음)
+type DegradedFile struct {
+	File     string `json:"file"`
+	Language string `json:"language"`
+	Reason   string `json:"reason"`
+}
+
+// Sampling 표본 분석 정보 (추정치는 표본의 이슈 수를 전체 파일 수 비율로 환산한 값)
+type Sampling struct {
+	TotalFiles        int                     `json:"total_files"`   // 수집된 전체 파일 수
+	SampledFiles      int                     `json:"sampled_files"` // 실제 분석한 파일 수
+	Ratio             float64                 `json:"ratio"`
+	Seed              int64                   `json:"seed"`
+	EstimatedIssues   int                     `json:"estimated_issues"`
+	EstimatedSeverity map[config.Severity]int `json:"estimated_severity,omitempty"`
+	EstimatedCategory map[string]int          `json:"estimated_category,omitempty"`
+}
+
+// 억제 출처
+const (
+	SuppressionInline   = "inline"
+	SuppressionBaseline = "baseline"
+	SuppressionTriage   = "triage"
+)
+
+// SuppressedIssue 억제된 이슈 (Expired면 만료되어 Issues에도 다시 보고됨)
+type SuppressedIssue struct {
+	Issue   Issue      `json:"issue"`
+	Source  string     `json:"source"`          // inline, baseline 또는 triage
+	Line    int        `json:"line,omitempty"`  // 인라인 억제 주석의 라인
+	Until   *time.Time `json:"until,omitempty"` // 만료일 (없으면 영구 억제)
+	Expired bool       `json:"expired,omitempty"`
+}
+
+// ActiveSuppressions 만료되지 않은 억제 수
+func (r *AnalysisResult) ActiveSuppressions() int {
+	count := 0
+	for _, suppressed := range r.Suppressed {
+		if !suppressed.Expired {
+			count++
+		}
+	}
+	return count
+}
+
+// GateResult 카테고리 품질 게이트 평가 결과
+type GateResult struct {
+	Category    string          `json:"category"`
+	Count       int             `json:"count"`
+	Max         int             `json:"max"`
+	MinSeverity config.Severity `json:"min_severity"`
+	Passed      bool            `json:"passed"`
+}
+
+// FailedGates 실패한 품질 게이트 목록
+func (r *AnalysisResult) FailedGates() []GateResult {
+	var failed []GateResult
+	for _, gate := range r.Gates {
+		if !gate.Passed {
+			failed = append(failed, gate)
+		}
+	}
+	return failed
+}
+
+// LoadResult JSON 분석 결과 파일 로드 (cqc -o json 출력)
+func LoadResult(path string) (*AnalysisResult, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var result AnalysisResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("JSON 파싱 실패: %w", err)
+	}
+	return &result, nil
 }
 
-// HasCriticalIssues 심각한 이슈가 있는지 확인
+// HasCriticalIssues 심각한 이슈가 있는지 확인 (종료 코드에 반영하지 않는 Advisory 이슈 제외)
 func (r *AnalysisResult) HasCriticalIssues() bool {
-	return r.Summary.SeverityCount[config.SeverityCritical] > 0
+	if r.Stream != nil {
+		return r.Stream.Critical > 0
+	}
+	for _, issue := range r.Issues {
+		if issue.Severity == config.SeverityCritical && !issue.Advisory {
+			return true
+		}
+	}
+	return false
 }
\ No newline at end of file